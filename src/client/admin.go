@@ -0,0 +1,131 @@
+package client
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+)
+
+// Extract calls f with each op of a backup of the cluster's metadata, in
+// order. If objects is true, the backup also includes the content of every
+// object.
+func (c APIClient) Extract(objects bool, f func(op *admin.Op) error) error {
+	extractClient, err := c.Admin.Extract(
+		c.Ctx(),
+		&admin.ExtractRequest{
+			Objects: objects,
+		},
+	)
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	for {
+		op, err := extractClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		if err := f(op); err != nil {
+			return err
+		}
+	}
+}
+
+// ExtractWriter writes a backup of the cluster to w, in the format read by
+// RestoreReader.
+func (c APIClient) ExtractWriter(objects bool, w io.Writer) error {
+	return c.Extract(objects, func(op *admin.Op) error {
+		return writeOp(w, op)
+	})
+}
+
+// Restore replays the ops of a backup, as returned by Extract, into an empty
+// cluster.
+func (c APIClient) Restore(ops []*admin.Op) error {
+	return c.restore(func() (*admin.Op, error) {
+		if len(ops) == 0 {
+			return nil, io.EOF
+		}
+		op := ops[0]
+		ops = ops[1:]
+		return op, nil
+	})
+}
+
+// RestoreReader restores a backup written by ExtractWriter into an empty
+// cluster.
+func (c APIClient) RestoreReader(r io.Reader) error {
+	bufioR := bufio.NewReader(r)
+	return c.restore(func() (*admin.Op, error) {
+		return readOp(bufioR)
+	})
+}
+
+// restore sends each op returned by next to pachd, until next returns io.EOF
+func (c APIClient) restore(next func() (*admin.Op, error)) (retErr error) {
+	restoreClient, err := c.Admin.Restore(c.Ctx())
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	defer func() {
+		if _, err := restoreClient.CloseAndRecv(); err != nil && retErr == nil {
+			retErr = sanitizeErr(err)
+		}
+	}()
+	for {
+		op, err := next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := restoreClient.Send(&admin.RestoreRequest{Op: op}); err != nil {
+			if err == io.EOF {
+				// pachd has returned an error, which CloseAndRecv returns
+				return nil
+			}
+			return sanitizeErr(err)
+		}
+	}
+}
+
+// writeOp writes op to w as its length, encoded as a uvarint, followed by the
+// marshalled op
+func writeOp(w io.Writer, op *admin.Op) error {
+	data, err := op.Marshal()
+	if err != nil {
+		return err
+	}
+	var size [binary.MaxVarintLen64]byte
+	if _, err := w.Write(size[:binary.PutUvarint(size[:], uint64(len(data)))]); err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// readOp reads an op written by writeOp from r. It returns io.EOF if r has no
+// more ops.
+func readOp(r *bufio.Reader) (*admin.Op, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	op := &admin.Op{}
+	if err := op.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
@@ -0,0 +1,1863 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: client/admin/admin.proto
+
+/*
+	Package admin is a generated protocol buffer package.
+
+	It is generated from these files:
+		client/admin/admin.proto
+
+	It has these top-level messages:
+		Header
+		Object
+		ACL
+		Op
+		ExtractRequest
+		RestoreRequest
+*/
+package admin
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/gogo/protobuf/types"
+import auth "github.com/pachyderm/pachyderm/src/client/auth"
+import pfs "github.com/pachyderm/pachyderm/src/client/pfs"
+import pps "github.com/pachyderm/pachyderm/src/client/pps"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Header is the first op of every backup
+type Header struct {
+	// version is the version of the backup format, which is incremented
+	// whenever the meaning of an existing op changes
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// pachd_version is the version of the pachd that extracted the backup
+	PachdVersion string `protobuf:"bytes,2,opt,name=pachd_version,json=pachdVersion,proto3" json:"pachd_version,omitempty"`
+}
+
+func (m *Header) Reset()                    { *m = Header{} }
+func (m *Header) String() string            { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()               {}
+func (*Header) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{0} }
+
+func (m *Header) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Header) GetPachdVersion() string {
+	if m != nil {
+		return m.PachdVersion
+	}
+	return ""
+}
+
+// Object is a chunk of the content of an object. Large objects are split
+// across consecutive ops with the same object, whose values are concatenated.
+type Object struct {
+	Object *pfs.Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
+	Value  []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Object) Reset()                    { *m = Object{} }
+func (m *Object) String() string            { return proto.CompactTextString(m) }
+func (*Object) ProtoMessage()               {}
+func (*Object) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{1} }
+
+func (m *Object) GetObject() *pfs.Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *Object) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// ACL is the access control list of a repo
+type ACL struct {
+	Repo *pfs.Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Acl  *auth.ACL `protobuf:"bytes,2,opt,name=acl" json:"acl,omitempty"`
+}
+
+func (m *ACL) Reset()                    { *m = ACL{} }
+func (m *ACL) String() string            { return proto.CompactTextString(m) }
+func (*ACL) ProtoMessage()               {}
+func (*ACL) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{2} }
+
+func (m *ACL) GetRepo() *pfs.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *ACL) GetAcl() *auth.ACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+// Op is a single operation in a backup. Exactly one field is set.
+type Op struct {
+	Header   *Header               `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Object   *Object               `protobuf:"bytes,2,opt,name=object" json:"object,omitempty"`
+	Tag      *pfs.TagObjectRequest `protobuf:"bytes,3,opt,name=tag" json:"tag,omitempty"`
+	Repo     *pfs.RepoInfo         `protobuf:"bytes,4,opt,name=repo" json:"repo,omitempty"`
+	Commit   *pfs.CommitInfo       `protobuf:"bytes,5,opt,name=commit" json:"commit,omitempty"`
+	Branch   *pfs.BranchInfo       `protobuf:"bytes,6,opt,name=branch" json:"branch,omitempty"`
+	Acl      *ACL                  `protobuf:"bytes,7,opt,name=acl" json:"acl,omitempty"`
+	Job      *pps.JobInfo          `protobuf:"bytes,8,opt,name=job" json:"job,omitempty"`
+	Pipeline *pps.PipelineInfo     `protobuf:"bytes,9,opt,name=pipeline" json:"pipeline,omitempty"`
+	// quota is the quota of a repo, or the quota and the usage of a user
+	Quota *pfs.QuotaInfo `protobuf:"bytes,10,opt,name=quota" json:"quota,omitempty"`
+}
+
+func (m *Op) Reset()                    { *m = Op{} }
+func (m *Op) String() string            { return proto.CompactTextString(m) }
+func (*Op) ProtoMessage()               {}
+func (*Op) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{3} }
+
+func (m *Op) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Op) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *Op) GetTag() *pfs.TagObjectRequest {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *Op) GetRepo() *pfs.RepoInfo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *Op) GetCommit() *pfs.CommitInfo {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *Op) GetBranch() *pfs.BranchInfo {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *Op) GetAcl() *ACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *Op) GetJob() *pps.JobInfo {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *Op) GetPipeline() *pps.PipelineInfo {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *Op) GetQuota() *pfs.QuotaInfo {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type ExtractRequest struct {
+	// If objects is set, the content of every object is included in the backup.
+	// Otherwise, the backup can only be restored into a cluster that uses the
+	// same object storage.
+	Objects bool `protobuf:"varint,1,opt,name=objects,proto3" json:"objects,omitempty"`
+}
+
+func (m *ExtractRequest) Reset()                    { *m = ExtractRequest{} }
+func (m *ExtractRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExtractRequest) ProtoMessage()               {}
+func (*ExtractRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{4} }
+
+func (m *ExtractRequest) GetObjects() bool {
+	if m != nil {
+		return m.Objects
+	}
+	return false
+}
+
+type RestoreRequest struct {
+	Op *Op `protobuf:"bytes,1,opt,name=op" json:"op,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()               {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{5} }
+
+func (m *RestoreRequest) GetOp() *Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Header)(nil), "admin.Header")
+	proto.RegisterType((*Object)(nil), "admin.Object")
+	proto.RegisterType((*ACL)(nil), "admin.ACL")
+	proto.RegisterType((*Op)(nil), "admin.Op")
+	proto.RegisterType((*ExtractRequest)(nil), "admin.ExtractRequest")
+	proto.RegisterType((*RestoreRequest)(nil), "admin.RestoreRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for API service
+
+type APIClient interface {
+	// Extract returns the metadata of the cluster (repos, commits, branches,
+	// ACLs, quotas, jobs and pipelines) as a sequence of ops, starting with a
+	// header.
+	// If auth is activated, only cluster admins may call it.
+	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error)
+	// Restore replays the ops returned by Extract into an empty cluster. ACLs
+	// are only restored if auth is activated in the cluster. If auth is
+	// activated, only cluster admins may call it.
+	Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[0], c.cc, "/admin.API/Extract", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIExtractClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ExtractClient interface {
+	Recv() (*Op, error)
+	grpc.ClientStream
+}
+
+type aPIExtractClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIExtractClient) Recv() (*Op, error) {
+	m := new(Op)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/admin.API/Restore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRestoreClient{stream}
+	return x, nil
+}
+
+type API_RestoreClient interface {
+	Send(*RestoreRequest) error
+	CloseAndRecv() (*google_protobuf.Empty, error)
+	grpc.ClientStream
+}
+
+type aPIRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRestoreClient) Send(m *RestoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIRestoreClient) CloseAndRecv() (*google_protobuf.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(google_protobuf.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for API service
+
+type APIServer interface {
+	// Extract returns the metadata of the cluster (repos, commits, branches,
+	// ACLs, quotas, jobs and pipelines) as a sequence of ops, starting with a
+	// header.
+	// If auth is activated, only cluster admins may call it.
+	Extract(*ExtractRequest, API_ExtractServer) error
+	// Restore replays the ops returned by Extract into an empty cluster. ACLs
+	// are only restored if auth is activated in the cluster. If auth is
+	// activated, only cluster admins may call it.
+	Restore(API_RestoreServer) error
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_Extract_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExtractRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Extract(m, &aPIExtractServer{stream})
+}
+
+type API_ExtractServer interface {
+	Send(*Op) error
+	grpc.ServerStream
+}
+
+type aPIExtractServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIExtractServer) Send(m *Op) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).Restore(&aPIRestoreServer{stream})
+}
+
+type API_RestoreServer interface {
+	SendAndClose(*google_protobuf.Empty) error
+	Recv() (*RestoreRequest, error)
+	grpc.ServerStream
+}
+
+type aPIRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRestoreServer) SendAndClose(m *google_protobuf.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIRestoreServer) Recv() (*RestoreRequest, error) {
+	m := new(RestoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.API",
+	HandlerType: (*APIServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Extract",
+			Handler:       _API_Extract_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Restore",
+			Handler:       _API_Restore_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "client/admin/admin.proto",
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Version))
+	}
+	if len(m.PachdVersion) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PachdVersion)))
+		i += copy(dAtA[i:], m.PachdVersion)
+	}
+	return i, nil
+}
+
+func (m *Object) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Object) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
+		n1, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *ACL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ACL) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
+		n2, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Acl != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Acl.Size()))
+		n3, err := m.Acl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *Op) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Op) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Header.Size()))
+		n4, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
+		n5, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Tag != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Tag.Size()))
+		n6, err := m.Tag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Repo != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
+		n7, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Commit.Size()))
+		n8, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Branch != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Branch.Size()))
+		n9, err := m.Branch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Acl != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Acl.Size()))
+		n10, err := m.Acl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Job != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Job.Size()))
+		n11, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
+		n12, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Quota.Size()))
+		n13, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+
+func (m *ExtractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Objects {
+		dAtA[i] = 0x8
+		i++
+		if m.Objects {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Op != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Op.Size()))
+		n14, err := m.Op.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func encodeFixed64Admin(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Admin(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Header) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovAdmin(uint64(m.Version))
+	}
+	l = len(m.PachdVersion)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *Object) Size() (n int) {
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ACL) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *Op) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtractRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Objects {
+		n += 2
+	}
+	return n
+}
+
+func (m *RestoreRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Op != nil {
+		l = m.Op.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PachdVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PachdVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &pfs.Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ACL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ACL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ACL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &auth.ACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Op) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Op: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tag == nil {
+				m.Tag = &pfs.TagObjectRequest{}
+			}
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.RepoInfo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.CommitInfo{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &pfs.BranchInfo{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &ACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &pps.JobInfo{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &pps.PipelineInfo{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &pfs.QuotaInfo{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Objects = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Op == nil {
+				m.Op = &Op{}
+			}
+			if err := m.Op.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipAdmin(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthAdmin = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("client/admin/admin.proto", fileDescriptorAdmin) }
+
+var fileDescriptorAdmin = []byte{
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xd1, 0x6e, 0xd3, 0x3c,
+	0x14, 0xc7, 0x9b, 0x64, 0x4d, 0xdb, 0xb3, 0xb6, 0xdf, 0x87, 0xb5, 0x4d, 0xa6, 0x83, 0x6a, 0x64,
+	0xa0, 0x55, 0x20, 0x52, 0x34, 0x6e, 0xb9, 0xe9, 0xaa, 0x09, 0x86, 0x26, 0x6d, 0x58, 0x88, 0x5b,
+	0xe4, 0xa4, 0x6e, 0x9b, 0xa9, 0x8d, 0xbd, 0xc4, 0x9d, 0xe0, 0x9a, 0x97, 0xe0, 0x91, 0xb8, 0xe4,
+	0x11, 0x50, 0x79, 0x11, 0xe4, 0x63, 0xa7, 0xd0, 0x5d, 0xc4, 0xaa, 0xff, 0xff, 0xdf, 0x39, 0x3e,
+	0x3e, 0xa7, 0x06, 0x9a, 0x2e, 0x32, 0x91, 0xeb, 0x21, 0x9f, 0x2c, 0xb3, 0xdc, 0xae, 0xb1, 0x2a,
+	0xa4, 0x96, 0xa4, 0x8e, 0x9b, 0xde, 0xe1, 0x4c, 0xca, 0xd9, 0x42, 0x0c, 0x51, 0x4c, 0x56, 0xd3,
+	0xa1, 0x58, 0x2a, 0xfd, 0xd5, 0x32, 0xbd, 0x83, 0x2a, 0x7a, 0xa5, 0xe7, 0xb8, 0x38, 0x7d, 0xcf,
+	0xe9, 0x6a, 0x5a, 0x9a, 0xef, 0xbe, 0xaa, 0x4a, 0xf3, 0x59, 0x35, 0x7a, 0x0b, 0xe1, 0x3b, 0xc1,
+	0x27, 0xa2, 0x20, 0x14, 0x1a, 0x77, 0xa2, 0x28, 0x33, 0x99, 0x53, 0xef, 0xc8, 0x1b, 0x74, 0x58,
+	0xb5, 0x25, 0xc7, 0xd0, 0x51, 0x3c, 0x9d, 0x4f, 0x3e, 0x57, 0xbe, 0x7f, 0xe4, 0x0d, 0x5a, 0xac,
+	0x8d, 0xe2, 0x27, 0xab, 0x45, 0x63, 0x08, 0xaf, 0x92, 0x1b, 0x91, 0x6a, 0x72, 0x0c, 0xa1, 0xc4,
+	0x5f, 0x98, 0x67, 0xf7, 0x74, 0x37, 0x36, 0x45, 0x58, 0x93, 0x39, 0x8b, 0xec, 0x41, 0xfd, 0x8e,
+	0x2f, 0x56, 0x02, 0x73, 0xb5, 0x99, 0xdd, 0x44, 0x23, 0x08, 0x46, 0xe3, 0x4b, 0xf2, 0x18, 0x76,
+	0x0a, 0xa1, 0xa4, 0x8b, 0x6f, 0x61, 0x3c, 0x13, 0x4a, 0x32, 0x94, 0xc9, 0x21, 0x04, 0x3c, 0x5d,
+	0x50, 0xdf, 0xb9, 0x78, 0xf3, 0xd1, 0xf8, 0x92, 0x19, 0x35, 0xfa, 0x16, 0x80, 0x7f, 0xa5, 0xc8,
+	0x33, 0x08, 0xe7, 0x78, 0x2f, 0x97, 0xa4, 0x13, 0xdb, 0xee, 0xda, 0xcb, 0x32, 0x67, 0x1a, 0xcc,
+	0xd5, 0xea, 0x6f, 0x61, 0xf7, 0xaa, 0x3d, 0x81, 0x40, 0xf3, 0x19, 0x0d, 0x90, 0xd9, 0xc7, 0x7a,
+	0x3e, 0xf2, 0x99, 0x83, 0xc4, 0xed, 0x4a, 0x94, 0x9a, 0x19, 0x82, 0x3c, 0x71, 0x95, 0xef, 0xb8,
+	0x6c, 0x55, 0xe5, 0x17, 0xf9, 0xb4, 0xaa, 0xfe, 0x04, 0xc2, 0x54, 0x2e, 0x97, 0x99, 0xa6, 0x75,
+	0x84, 0xfe, 0x43, 0x68, 0x8c, 0x12, 0x62, 0xce, 0x36, 0x60, 0x52, 0xf0, 0x3c, 0x9d, 0xd3, 0xf0,
+	0x1f, 0xf0, 0x0c, 0x25, 0x0b, 0x5a, 0x9b, 0x3c, 0xb2, 0xfd, 0x68, 0x20, 0x05, 0xee, 0x06, 0x55,
+	0x43, 0x48, 0x1f, 0x82, 0x1b, 0x99, 0xd0, 0x26, 0xba, 0xed, 0xd8, 0x8c, 0xfe, 0xbd, 0x4c, 0x30,
+	0x81, 0x31, 0xc8, 0x4b, 0x68, 0xaa, 0x4c, 0x89, 0x45, 0x96, 0x0b, 0xda, 0x42, 0xe8, 0x01, 0x42,
+	0xd7, 0x4e, 0x44, 0x72, 0x83, 0x90, 0xa7, 0x50, 0xbf, 0x5d, 0x49, 0xcd, 0x29, 0x20, 0xdb, 0xc5,
+	0xa2, 0x3e, 0x18, 0x05, 0x41, 0x6b, 0x46, 0xcf, 0xa1, 0x7b, 0xfe, 0x45, 0x17, 0x7c, 0xd3, 0x1e,
+	0xf3, 0xf7, 0xb2, 0xcd, 0x2c, 0x71, 0x22, 0x4d, 0x56, 0x6d, 0xa3, 0x17, 0xd0, 0x65, 0xa2, 0xd4,
+	0xb2, 0x10, 0x15, 0xfb, 0x10, 0x7c, 0xa9, 0x36, 0xd3, 0x77, 0x13, 0x51, 0xcc, 0x97, 0xea, 0x54,
+	0x43, 0x30, 0xba, 0xbe, 0x20, 0x43, 0x68, 0xb8, 0xfc, 0x64, 0xdf, 0x01, 0xdb, 0xe7, 0xf5, 0xfe,
+	0xc6, 0x45, 0xb5, 0x57, 0x1e, 0x79, 0x03, 0x0d, 0x77, 0xc8, 0x26, 0x60, 0xfb, 0xd0, 0xde, 0x41,
+	0x6c, 0xdf, 0x5a, 0x5c, 0xbd, 0xb5, 0xf8, 0xdc, 0xbc, 0xb5, 0xa8, 0x36, 0xf0, 0xce, 0xfe, 0xff,
+	0xb1, 0xee, 0x7b, 0x3f, 0xd7, 0x7d, 0xef, 0xd7, 0xba, 0xef, 0x7d, 0xff, 0xdd, 0xaf, 0x25, 0x21,
+	0x52, 0xaf, 0xff, 0x0c, 0x00, 0x3e, 0x72, 0x04, 0xd9, 0xc2, 0x03, 0x00, 0x00,
+}
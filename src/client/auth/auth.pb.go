@@ -220,6 +220,9 @@ func (*ModifyAdminsResponse) Descriptor() ([]byte, []int) { return fileDescripto
 type User struct {
 	Username string        `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Type     User_UserType `protobuf:"varint,2,opt,name=type,proto3,enum=auth.User_UserType" json:"type,omitempty"`
+	// If type is PIPELINE, the name of the pipeline that this user's capability
+	// was issued to
+	Pipeline string `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 }
 
 func (m *User) Reset()                    { *m = User{} }
@@ -241,6 +244,13 @@ func (m *User) GetType() User_UserType {
 	return User_INVALID
 }
 
+func (m *User) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
+	}
+	return ""
+}
+
 type AuthenticateRequest struct {
 	// If set, Pachyderm will compare this username to the GitHub account that
 	// issued the access token 'github_token'. For now, this is not required
@@ -294,6 +304,8 @@ func (*WhoAmIRequest) Descriptor() ([]byte, []int) { return fileDescriptorAuth,
 
 type WhoAmIResponse struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// If the caller is a pipeline, the name of the pipeline
+	Pipeline string `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 }
 
 func (m *WhoAmIResponse) Reset()                    { *m = WhoAmIResponse{} }
@@ -308,6 +320,13 @@ func (m *WhoAmIResponse) GetUsername() string {
 	return ""
 }
 
+func (m *WhoAmIResponse) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
+	}
+	return ""
+}
+
 type ACLEntry struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Scope    Scope  `protobuf:"varint,2,opt,name=scope,proto3,enum=auth.Scope" json:"scope,omitempty"`
@@ -534,6 +553,8 @@ func (*SetACLResponse) ProtoMessage()               {}
 func (*SetACLResponse) Descriptor() ([]byte, []int) { return fileDescriptorAuth, []int{24} }
 
 type GetCapabilityRequest struct {
+	// The pipeline that the capability is for
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 }
 
 func (m *GetCapabilityRequest) Reset()                    { *m = GetCapabilityRequest{} }
@@ -541,6 +562,13 @@ func (m *GetCapabilityRequest) String() string            { return proto.Compact
 func (*GetCapabilityRequest) ProtoMessage()               {}
 func (*GetCapabilityRequest) Descriptor() ([]byte, []int) { return fileDescriptorAuth, []int{25} }
 
+func (m *GetCapabilityRequest) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
+	}
+	return ""
+}
+
 type GetCapabilityResponse struct {
 	Capability string `protobuf:"bytes,1,opt,name=capability,proto3" json:"capability,omitempty"`
 }
@@ -1323,6 +1351,12 @@ func (m *User) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintAuth(dAtA, i, uint64(m.Type))
 	}
+	if len(m.Pipeline) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Pipeline)))
+		i += copy(dAtA[i:], m.Pipeline)
+	}
 	return i, nil
 }
 
@@ -1419,6 +1453,12 @@ func (m *WhoAmIResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
 		i += copy(dAtA[i:], m.Username)
 	}
+	if len(m.Pipeline) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Pipeline)))
+		i += copy(dAtA[i:], m.Pipeline)
+	}
 	return i, nil
 }
 
@@ -1788,6 +1828,12 @@ func (m *GetCapabilityRequest) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Pipeline) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Pipeline)))
+		i += copy(dAtA[i:], m.Pipeline)
+	}
 	return i, nil
 }
 
@@ -1966,6 +2012,10 @@ func (m *User) Size() (n int) {
 	if m.Type != 0 {
 		n += 1 + sovAuth(uint64(m.Type))
 	}
+	l = len(m.Pipeline)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -2006,6 +2056,10 @@ func (m *WhoAmIResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	l = len(m.Pipeline)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -2153,6 +2207,10 @@ func (m *SetACLResponse) Size() (n int) {
 func (m *GetCapabilityRequest) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Pipeline)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -2788,6 +2846,35 @@ func (m *User) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -3104,6 +3191,35 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -4297,6 +4413,35 @@ func (m *GetCapabilityRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GetCapabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -4634,66 +4779,67 @@ var (
 func init() { proto.RegisterFile("client/auth/auth.proto", fileDescriptorAuth) }
 
 var fileDescriptorAuth = []byte{
-	// 970 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdb, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x75, 0xa1, 0xa5, 0x91, 0x2d, 0xd1, 0x6b, 0x45, 0x76, 0xd8, 0x46, 0x49, 0x36, 0x05,
-	0xe2, 0x5e, 0xa0, 0x04, 0x4e, 0xdd, 0x14, 0x0d, 0xd0, 0x82, 0x91, 0x09, 0x95, 0x85, 0xa2, 0x18,
-	0xa4, 0x5d, 0x3f, 0x06, 0xb4, 0xb4, 0xb5, 0x08, 0xcb, 0xa4, 0x2a, 0x51, 0x36, 0x54, 0xa0, 0x40,
-	0x3f, 0xa3, 0x9f, 0xd4, 0xc7, 0x3e, 0xf4, 0x39, 0x28, 0xd4, 0x1f, 0x29, 0xf6, 0x46, 0x93, 0x14,
-	0xad, 0x36, 0x2f, 0xc2, 0xee, 0x99, 0x99, 0x33, 0xb3, 0x87, 0x3b, 0xb3, 0x82, 0xe6, 0x60, 0xec,
-	0x11, 0x3f, 0x7c, 0xe6, 0xce, 0xc3, 0x11, 0xfb, 0x69, 0x4f, 0xa6, 0x41, 0x18, 0xa0, 0x22, 0x5d,
-	0xeb, 0x8d, 0x8b, 0xe0, 0x22, 0x60, 0xc0, 0x33, 0xba, 0xe2, 0x36, 0xfc, 0x29, 0xd4, 0x8d, 0x41,
-	0xe8, 0x5d, 0xbb, 0x21, 0xb1, 0xc9, 0xcf, 0x73, 0x32, 0x0b, 0x51, 0x13, 0x54, 0x77, 0x78, 0xe5,
-	0xf9, 0xb3, 0xbd, 0xfc, 0xa3, 0xc2, 0x7e, 0xc5, 0x16, 0x3b, 0x8c, 0x40, 0xbb, 0x75, 0x9d, 0x4d,
-	0x02, 0x7f, 0x46, 0xf0, 0x0e, 0x6c, 0x1f, 0x11, 0x37, 0x49, 0x80, 0x1b, 0x80, 0xe2, 0xa0, 0x70,
-	0x45, 0xa0, 0x75, 0x49, 0x68, 0x30, 0x2e, 0xe9, 0xf9, 0x39, 0x6c, 0xc7, 0x30, 0xee, 0x18, 0xcb,
-	0xaf, 0x24, 0xf2, 0x7f, 0x07, 0x3b, 0x6f, 0x82, 0xa1, 0xf7, 0xd3, 0x22, 0xc1, 0x81, 0x34, 0x28,
-	0xb8, 0xc3, 0xa1, 0xf0, 0xa5, 0x4b, 0x4a, 0x30, 0x25, 0x57, 0xc1, 0x35, 0x91, 0x07, 0xe0, 0x3b,
-	0xdc, 0x84, 0x46, 0x92, 0x40, 0x54, 0xf6, 0x2b, 0x14, 0x4f, 0x67, 0x64, 0x8a, 0x74, 0x28, 0xcf,
-	0x67, 0x64, 0xea, 0xbb, 0x57, 0x64, 0x4f, 0x79, 0xa4, 0xec, 0x57, 0xec, 0x68, 0x8f, 0x9e, 0x42,
-	0x31, 0x5c, 0x4c, 0x28, 0xa3, 0xb2, 0x5f, 0x3b, 0xd8, 0x69, 0x33, 0x79, 0x69, 0x14, 0xfb, 0x39,
-	0x59, 0x4c, 0x88, 0xcd, 0x1c, 0xf0, 0x73, 0x28, 0x4b, 0x04, 0x55, 0x61, 0xc3, 0xea, 0xff, 0x68,
-	0xf4, 0xac, 0x23, 0x2d, 0x87, 0x2a, 0x50, 0xfa, 0xfe, 0xf4, 0x8d, 0xd1, 0xd7, 0x14, 0xb4, 0x09,
-	0xe5, 0x63, 0xeb, 0xd8, 0xec, 0x59, 0x7d, 0x53, 0xcb, 0x63, 0x17, 0x76, 0x8c, 0x79, 0x38, 0x22,
-	0x7e, 0xe8, 0x0d, 0x62, 0x9f, 0xe1, 0x31, 0x6c, 0x5e, 0x78, 0xe1, 0x68, 0x7e, 0xfe, 0x2e, 0x0c,
-	0x2e, 0x89, 0x2f, 0x2a, 0xaa, 0x72, 0xec, 0x84, 0x42, 0xe8, 0x29, 0xd4, 0x85, 0x4b, 0x54, 0x77,
-	0x9e, 0x79, 0xd5, 0x38, 0x7c, 0x2a, 0x50, 0x7c, 0x08, 0x8d, 0x64, 0x0a, 0x21, 0xf5, 0x03, 0x80,
-	0x89, 0x3b, 0x18, 0x25, 0x32, 0x54, 0x28, 0xc2, 0xf8, 0x71, 0x1d, 0xb6, 0xce, 0x46, 0x81, 0x71,
-	0x65, 0xc9, 0xef, 0xf5, 0x05, 0xd4, 0x24, 0x20, 0x18, 0xd6, 0x68, 0x86, 0x2d, 0x28, 0x1b, 0x9d,
-	0x9e, 0xe9, 0x87, 0xd3, 0xc5, 0x5a, 0x6d, 0x1f, 0x43, 0x69, 0x36, 0x08, 0x22, 0x71, 0xab, 0x5c,
-	0x5c, 0x87, 0x42, 0x36, 0xb7, 0xe0, 0xdf, 0x14, 0x28, 0x18, 0x9d, 0x1e, 0x7a, 0x0e, 0x1b, 0xc4,
-	0x0f, 0xa7, 0x1e, 0xe1, 0x97, 0xa3, 0x7a, 0xd0, 0xe4, 0xce, 0x46, 0xa7, 0xd7, 0x36, 0xb9, 0x81,
-	0xe5, 0xb3, 0xa5, 0x9b, 0xde, 0x85, 0xcd, 0xb8, 0x81, 0x5e, 0x97, 0x4b, 0xb2, 0x10, 0x35, 0xd0,
-	0x25, 0x4d, 0x7f, 0xed, 0x8e, 0xe7, 0xd9, 0xe9, 0x99, 0xe5, 0x9b, 0xfc, 0xd7, 0x0a, 0xb6, 0x40,
-	0xa3, 0x1a, 0x06, 0x53, 0xef, 0x97, 0xe8, 0x1b, 0x21, 0x28, 0x4e, 0xc9, 0x24, 0x10, 0x6c, 0x6c,
-	0xfd, 0x7f, 0x4e, 0xf3, 0x02, 0xb6, 0x63, 0x54, 0x42, 0xc9, 0x16, 0x80, 0x2b, 0xc1, 0x21, 0x63,
-	0x2c, 0xdb, 0x31, 0x04, 0x77, 0xa0, 0xde, 0x25, 0x21, 0xe7, 0x11, 0xe9, 0xd7, 0x89, 0xda, 0x80,
-	0x12, 0x2d, 0x47, 0x36, 0x31, 0xdf, 0xe0, 0x97, 0xac, 0x09, 0x05, 0x89, 0x48, 0xfc, 0x04, 0x54,
-	0x56, 0x16, 0x97, 0x34, 0x55, 0xb1, 0x30, 0xe1, 0x21, 0xd4, 0x9d, 0x0f, 0xc8, 0x2e, 0x85, 0xc9,
-	0x67, 0x09, 0x53, 0xb8, 0x53, 0x18, 0x04, 0x9a, 0x93, 0x2a, 0x0f, 0x3f, 0x81, 0x2d, 0x3a, 0x23,
-	0x3a, 0xbd, 0x35, 0xa2, 0xe3, 0xaf, 0xa0, 0x26, 0x9d, 0xc4, 0xa9, 0x3e, 0x81, 0x82, 0x3b, 0x18,
-	0x33, 0xa7, 0xea, 0x41, 0x25, 0xba, 0x25, 0xaf, 0x37, 0x96, 0xef, 0x1f, 0xd2, 0xab, 0x64, 0x53,
-	0x33, 0x76, 0x60, 0xcb, 0xf9, 0x2f, 0x72, 0xd4, 0x86, 0x0d, 0x9f, 0xdc, 0xbc, 0xa3, 0x74, 0xf9,
-	0x34, 0x1d, 0x2c, 0xdf, 0x3f, 0x54, 0xfb, 0xe4, 0x86, 0x52, 0xa8, 0x3e, 0xb9, 0x31, 0x06, 0x63,
-	0xac, 0x41, 0xcd, 0x49, 0x14, 0x43, 0x27, 0x4f, 0x97, 0x84, 0x1d, 0x77, 0xe2, 0x9e, 0x7b, 0x63,
-	0x2f, 0x5c, 0xc8, 0x7e, 0x7a, 0x09, 0xf7, 0x52, 0xf8, 0xed, 0x65, 0x18, 0x44, 0xa8, 0x28, 0x26,
-	0x86, 0xe0, 0x36, 0x34, 0x6d, 0x72, 0x1d, 0x5c, 0x12, 0x7a, 0x8f, 0x58, 0xb3, 0xca, 0x03, 0x34,
-	0xa0, 0x14, 0xef, 0x66, 0xbe, 0xc1, 0xf7, 0x61, 0x77, 0xc5, 0x9f, 0xa7, 0xfa, 0xec, 0x4b, 0x28,
-	0x31, 0xc1, 0x51, 0x19, 0x8a, 0xfd, 0xb7, 0x7d, 0x53, 0xcb, 0x21, 0x00, 0xd5, 0x36, 0x8d, 0x23,
-	0xd3, 0xd6, 0x14, 0xba, 0x3e, 0xb3, 0xad, 0x13, 0xd3, 0xd6, 0xf2, 0x74, 0x84, 0xbd, 0x3d, 0xeb,
-	0x9b, 0xb6, 0x56, 0x38, 0xf8, 0x4b, 0x85, 0x82, 0x71, 0x6c, 0xa1, 0x57, 0x50, 0x96, 0x8f, 0x02,
-	0xba, 0x27, 0x64, 0x49, 0x3e, 0x07, 0x7a, 0x33, 0x0d, 0x0b, 0x51, 0x72, 0xc8, 0x00, 0xb8, 0x7d,
-	0x28, 0xd0, 0x2e, 0xf7, 0x5b, 0x79, 0x4f, 0xf4, 0xbd, 0x55, 0x43, 0x44, 0xf1, 0x2d, 0x54, 0xa2,
-	0x17, 0x04, 0x89, 0x4c, 0xe9, 0x67, 0x46, 0xdf, 0x5d, 0xc1, 0xa3, 0xf8, 0x2e, 0x6c, 0xc6, 0xdf,
-	0x04, 0x74, 0x9f, 0xbb, 0x66, 0x3c, 0x34, 0xba, 0x9e, 0x65, 0x8a, 0x13, 0xc5, 0x47, 0xac, 0x24,
-	0xca, 0x98, 0xec, 0x92, 0x28, 0x6b, 0x22, 0xf3, 0x13, 0x45, 0xc3, 0x41, 0x9e, 0x28, 0x3d, 0x78,
-	0xe4, 0x89, 0x56, 0xa6, 0x08, 0xce, 0xa1, 0x43, 0x50, 0xf9, 0x8c, 0x46, 0xe2, 0x95, 0x4a, 0x8c,
-	0x70, 0xbd, 0x91, 0x04, 0xa3, 0xb0, 0x57, 0x50, 0x96, 0x93, 0x41, 0x7e, 0xc8, 0xd4, 0xb8, 0xd1,
-	0x9b, 0x69, 0x38, 0x1e, 0xec, 0xa4, 0x82, 0x9d, 0xec, 0x60, 0x67, 0x35, 0xf8, 0x10, 0x54, 0xde,
-	0xbb, 0xb2, 0xe0, 0x44, 0xbb, 0xcb, 0x82, 0x93, 0xed, 0xcd, 0xc3, 0x9c, 0x44, 0x98, 0x93, 0x15,
-	0xe6, 0xa4, 0xc3, 0x7e, 0x60, 0xe3, 0xe4, 0xb6, 0xe5, 0x90, 0x1e, 0xf1, 0xaf, 0xf4, 0xa7, 0xfe,
-	0x51, 0xa6, 0x2d, 0xe2, 0x3a, 0x86, 0x7a, 0xaa, 0xab, 0xd0, 0xc7, 0x3c, 0x22, 0xbb, 0x39, 0xf5,
-	0x07, 0x77, 0x58, 0x25, 0xe3, 0x6b, 0xed, 0x8f, 0x65, 0x4b, 0xf9, 0x73, 0xd9, 0x52, 0xfe, 0x5e,
-	0xb6, 0x94, 0xdf, 0xff, 0x69, 0xe5, 0xce, 0x55, 0xf6, 0x3f, 0xed, 0xc5, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xea, 0xbf, 0x70, 0xf0, 0xdd, 0x09, 0x00, 0x00,
+	// 987 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x5f, 0x6f, 0xdb, 0x36,
+	0x10, 0x8f, 0xfc, 0x47, 0xb1, 0xcf, 0x89, 0xad, 0x30, 0xaa, 0x93, 0x6a, 0xab, 0xdb, 0xb2, 0x03,
+	0x9a, 0x6d, 0x80, 0x5b, 0xb8, 0xcb, 0x3a, 0xac, 0xc0, 0x06, 0xd5, 0x11, 0x5c, 0x0d, 0xae, 0x1b,
+	0x48, 0xc9, 0xf2, 0x58, 0x28, 0x36, 0x17, 0x0b, 0x71, 0x24, 0xcd, 0x96, 0x13, 0x78, 0x4f, 0xfb,
+	0x18, 0xdb, 0x37, 0xda, 0xe3, 0x1e, 0xf6, 0x5c, 0x0c, 0xde, 0x17, 0x19, 0x28, 0x92, 0x8a, 0x24,
+	0x2b, 0xde, 0xfa, 0x22, 0x90, 0xbf, 0xbb, 0xfb, 0xdd, 0xf1, 0xc8, 0xbb, 0x13, 0x34, 0x87, 0x13,
+	0x97, 0x78, 0xe1, 0x33, 0x67, 0x1e, 0x8e, 0xa3, 0x4f, 0x3b, 0x98, 0xfa, 0xa1, 0x8f, 0x4a, 0x74,
+	0xad, 0xa9, 0x17, 0xfe, 0x85, 0x1f, 0x01, 0xcf, 0xe8, 0x8a, 0xc9, 0xf0, 0xe7, 0xd0, 0xd0, 0x87,
+	0xa1, 0x7b, 0xed, 0x84, 0xc4, 0x22, 0x3f, 0xcf, 0xc9, 0x2c, 0x44, 0x4d, 0x90, 0x9d, 0xd1, 0x95,
+	0xeb, 0xcd, 0xf6, 0x0b, 0x8f, 0x8a, 0x07, 0x55, 0x8b, 0xef, 0x30, 0x02, 0xe5, 0x56, 0x75, 0x16,
+	0xf8, 0xde, 0x8c, 0xe0, 0x5d, 0xd8, 0x39, 0x22, 0x4e, 0x9a, 0x00, 0xab, 0x80, 0x92, 0x20, 0x57,
+	0x45, 0xa0, 0xf4, 0x48, 0xa8, 0x47, 0x5c, 0x42, 0xf3, 0x4b, 0xd8, 0x49, 0x60, 0x4c, 0x31, 0xe1,
+	0x5f, 0x4a, 0xf9, 0xff, 0x1e, 0x76, 0xdf, 0xfa, 0x23, 0xf7, 0xa7, 0x45, 0x8a, 0x03, 0x29, 0x50,
+	0x74, 0x46, 0x23, 0xae, 0x4b, 0x97, 0x94, 0x60, 0x4a, 0xae, 0xfc, 0x6b, 0x22, 0x0e, 0xc0, 0x76,
+	0xb8, 0x09, 0x6a, 0x9a, 0x80, 0x47, 0xf6, 0xbb, 0x04, 0xa5, 0xd3, 0x19, 0x99, 0x22, 0x0d, 0x2a,
+	0xf3, 0x19, 0x99, 0x7a, 0xce, 0x15, 0xd9, 0x97, 0x1e, 0x49, 0x07, 0x55, 0x2b, 0xde, 0xa3, 0xa7,
+	0x50, 0x0a, 0x17, 0x01, 0xa5, 0x94, 0x0e, 0xea, 0x9d, 0xdd, 0x76, 0x94, 0x5f, 0x6a, 0x15, 0x7d,
+	0x4e, 0x16, 0x01, 0xb1, 0x22, 0x05, 0x4a, 0x12, 0xb8, 0x01, 0x99, 0xb8, 0x1e, 0xd9, 0x2f, 0x32,
+	0x12, 0xb1, 0xc7, 0xcf, 0xa1, 0x22, 0xb4, 0x51, 0x0d, 0x36, 0xcd, 0xc1, 0x8f, 0x7a, 0xdf, 0x3c,
+	0x52, 0x36, 0x50, 0x15, 0xca, 0x6f, 0x4e, 0xdf, 0xea, 0x03, 0x45, 0x42, 0x5b, 0x50, 0x39, 0x36,
+	0x8f, 0x8d, 0xbe, 0x39, 0x30, 0x94, 0x02, 0x76, 0x60, 0x57, 0x9f, 0x87, 0x63, 0xe2, 0x85, 0xee,
+	0x30, 0x71, 0x47, 0x8f, 0x61, 0xeb, 0xc2, 0x0d, 0xc7, 0xf3, 0xf3, 0xf7, 0xa1, 0x7f, 0x49, 0x3c,
+	0x1e, 0x6d, 0x8d, 0x61, 0x27, 0x14, 0x42, 0x4f, 0xa1, 0xc1, 0x55, 0xe2, 0x33, 0x15, 0x22, 0xad,
+	0x3a, 0x83, 0x4f, 0x39, 0x8a, 0x0f, 0x41, 0x4d, 0xbb, 0xe0, 0xf7, 0xf0, 0x00, 0x20, 0x70, 0x86,
+	0xe3, 0x94, 0x87, 0x2a, 0x45, 0x22, 0x7e, 0xdc, 0x80, 0xed, 0xb3, 0xb1, 0xaf, 0x5f, 0x99, 0xe2,
+	0x32, 0xdf, 0x40, 0x5d, 0x00, 0x9c, 0x61, 0x5d, 0x3e, 0x93, 0x69, 0x2a, 0x64, 0xd2, 0x64, 0x42,
+	0x45, 0xef, 0xf6, 0x0d, 0x2f, 0x9c, 0x2e, 0xd6, 0x72, 0x3c, 0x86, 0xf2, 0x6c, 0xe8, 0xc7, 0x97,
+	0x52, 0x63, 0x97, 0x62, 0x53, 0xc8, 0x62, 0x12, 0xfc, 0xab, 0x04, 0x45, 0xbd, 0xdb, 0x47, 0xcf,
+	0x61, 0x93, 0x78, 0xe1, 0xd4, 0x25, 0xec, 0x55, 0xd5, 0x3a, 0x4d, 0xa6, 0xac, 0x77, 0xfb, 0x6d,
+	0x83, 0x09, 0x22, 0x7f, 0x96, 0x50, 0xd3, 0x7a, 0xb0, 0x95, 0x14, 0xd0, 0x77, 0x76, 0x49, 0x16,
+	0x3c, 0x06, 0xba, 0xa4, 0xee, 0xaf, 0x9d, 0xc9, 0x3c, 0xdf, 0x7d, 0x24, 0xf9, 0xb6, 0xf0, 0x8d,
+	0x84, 0x4d, 0x50, 0x68, 0x7e, 0xfd, 0xa9, 0xfb, 0x4b, 0x7c, 0x7f, 0x08, 0x4a, 0x53, 0x12, 0xf8,
+	0x9c, 0x2d, 0x5a, 0xff, 0x9f, 0xd3, 0xbc, 0x80, 0x9d, 0x04, 0x15, 0xcf, 0x72, 0x0b, 0xc0, 0x11,
+	0xe0, 0x28, 0x62, 0xac, 0x58, 0x09, 0x04, 0x77, 0xa1, 0xd1, 0x23, 0x21, 0xe3, 0xe1, 0xee, 0xd7,
+	0x25, 0x55, 0x85, 0x32, 0x0d, 0x47, 0x54, 0x3f, 0xdb, 0xe0, 0x97, 0xa0, 0xdc, 0x92, 0x70, 0xc7,
+	0x4f, 0x40, 0x8e, 0xc2, 0x62, 0x29, 0xcd, 0x44, 0xcc, 0x45, 0x78, 0x04, 0x0d, 0xfb, 0x23, 0xbc,
+	0x8b, 0xc4, 0x14, 0xf2, 0x12, 0x53, 0xbc, 0x33, 0x31, 0x08, 0x14, 0x3b, 0x13, 0x1e, 0x7e, 0x02,
+	0xdb, 0xb4, 0xb9, 0x74, 0xfb, 0x6b, 0x92, 0x8e, 0xbf, 0x86, 0xba, 0x50, 0xe2, 0xa7, 0xfa, 0x0c,
+	0x8a, 0xce, 0x70, 0x12, 0x29, 0xd5, 0x3a, 0xd5, 0xf8, 0x95, 0xbc, 0xde, 0x5c, 0x7e, 0x78, 0x48,
+	0x9f, 0x92, 0x45, 0xc5, 0xd8, 0x86, 0x6d, 0xfb, 0xbf, 0xc8, 0x51, 0x1b, 0x36, 0x3d, 0x72, 0xf3,
+	0x9e, 0xd2, 0x15, 0xb2, 0x74, 0xb0, 0xfc, 0xf0, 0x50, 0x1e, 0x90, 0x1b, 0x4a, 0x21, 0x7b, 0xe4,
+	0x46, 0x1f, 0x4e, 0xb0, 0x02, 0x75, 0x3b, 0x15, 0x0c, 0xee, 0x80, 0xda, 0x23, 0x61, 0xd7, 0x09,
+	0x9c, 0x73, 0x77, 0xe2, 0x86, 0x8b, 0x44, 0x0a, 0xe3, 0xea, 0x91, 0x32, 0xd5, 0xf3, 0x12, 0xee,
+	0x65, 0x6c, 0x6e, 0x1f, 0xca, 0x30, 0x46, 0xb9, 0x59, 0x02, 0xc1, 0x6d, 0x68, 0x5a, 0xe4, 0xda,
+	0xbf, 0x24, 0xf4, 0x8d, 0x45, 0x45, 0x2e, 0xdc, 0xa9, 0x50, 0x4e, 0x76, 0x01, 0xb6, 0xc1, 0xf7,
+	0x61, 0x6f, 0x45, 0x9f, 0xb9, 0xfa, 0xe2, 0x2b, 0x28, 0x47, 0x97, 0x81, 0x2a, 0x50, 0x1a, 0xbc,
+	0x1b, 0x18, 0xca, 0x06, 0x02, 0x90, 0x2d, 0x43, 0x3f, 0x32, 0x2c, 0x45, 0xa2, 0xeb, 0x33, 0xcb,
+	0x3c, 0x31, 0x2c, 0xa5, 0x40, 0x5b, 0xdf, 0xbb, 0xb3, 0x81, 0x61, 0x29, 0xc5, 0xce, 0x5f, 0x32,
+	0x14, 0xf5, 0x63, 0x13, 0xbd, 0x82, 0x8a, 0x98, 0x34, 0xe8, 0x1e, 0x4f, 0x59, 0x7a, 0xc6, 0x68,
+	0xcd, 0x2c, 0xcc, 0x13, 0xb6, 0x81, 0x74, 0x80, 0xdb, 0xe9, 0x83, 0xf6, 0x98, 0xde, 0xca, 0x90,
+	0xd2, 0xf6, 0x57, 0x05, 0x31, 0xc5, 0x77, 0x50, 0x8d, 0xc7, 0x12, 0xe2, 0x9e, 0xb2, 0xb3, 0x4b,
+	0xdb, 0x5b, 0xc1, 0x63, 0xfb, 0x1e, 0x6c, 0x25, 0x07, 0x0d, 0xba, 0xcf, 0x54, 0x73, 0xa6, 0x97,
+	0xa6, 0xe5, 0x89, 0x92, 0x44, 0xc9, 0xd6, 0x2c, 0x88, 0x72, 0x26, 0x82, 0xa6, 0xe5, 0x89, 0x92,
+	0x27, 0x8a, 0x1b, 0x87, 0x38, 0x51, 0xb6, 0x29, 0x69, 0x7b, 0x2b, 0x78, 0x6c, 0x7f, 0x08, 0x32,
+	0xeb, 0xed, 0x88, 0x4f, 0xbe, 0x54, 0xeb, 0xd7, 0xd4, 0x34, 0x18, 0x9b, 0xbd, 0x82, 0x8a, 0xe8,
+	0x1a, 0xe2, 0x22, 0x33, 0xad, 0x48, 0x6b, 0x66, 0xe1, 0xa4, 0xb1, 0x9d, 0x31, 0xb6, 0xf3, 0x8d,
+	0xed, 0x55, 0xe3, 0x43, 0x90, 0x59, 0x5d, 0x8b, 0x80, 0x53, 0xad, 0x40, 0x53, 0xd3, 0x60, 0xd2,
+	0xcc, 0x4e, 0x99, 0xd9, 0x79, 0x66, 0x76, 0xd6, 0xec, 0x07, 0xd8, 0x4e, 0x95, 0x1c, 0xd2, 0x62,
+	0xfe, 0x95, 0xda, 0xd5, 0x3e, 0xc9, 0x95, 0xc5, 0x5c, 0xc7, 0xd0, 0xc8, 0x54, 0x15, 0xfa, 0x94,
+	0x59, 0xe4, 0x17, 0xa7, 0xf6, 0xe0, 0x0e, 0xa9, 0x60, 0x7c, 0xad, 0xfc, 0xb1, 0x6c, 0x49, 0x7f,
+	0x2e, 0x5b, 0xd2, 0xdf, 0xcb, 0x96, 0xf4, 0xdb, 0x3f, 0xad, 0x8d, 0x73, 0x39, 0xfa, 0xf9, 0x7b,
+	0xf1, 0xef, 0x00, 0x07, 0x5c, 0xb1, 0xf5, 0x32, 0x0a, 0x00, 0x00,
 }
@@ -15,6 +15,7 @@ import (
 	types "github.com/gogo/protobuf/types"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/health"
@@ -48,6 +49,7 @@ type APIClient struct {
 	ObjectAPIClient
 	AuthAPIClient
 	Enterprise enterprise.APIClient // not embedded--method name conflicts with AuthAPIClient
+	Admin      admin.APIClient      // not embedded--method names conflict with APIClient's helpers
 
 	// addr is a "host:port" string pointing at a pachd endpoint
 	addr string
@@ -253,6 +255,7 @@ func (c *APIClient) connect() error {
 	c.PpsAPIClient = pps.NewAPIClient(clientConn)
 	c.ObjectAPIClient = pfs.NewObjectAPIClient(clientConn)
 	c.Enterprise = enterprise.NewAPIClient(clientConn)
+	c.Admin = admin.NewAPIClient(clientConn)
 	c.clientConn = clientConn
 	c.healthClient = health.NewHealthClient(clientConn)
 	return nil
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -86,6 +88,28 @@ func (c APIClient) ListRepo(provenance []string) ([]*pfs.RepoInfo, error) {
 	return repoInfos.RepoInfo, nil
 }
 
+// ListRepoPage is like ListRepo, except that it returns at most 'pageSize'
+// repos, newest first, starting after the repo 'pageToken' (if it's set). It
+// also returns the token of the next page, which is empty if there are no
+// more repos.
+func (c APIClient) ListRepoPage(provenance []string, pageSize int64, pageToken string) ([]*pfs.RepoInfo, string, error) {
+	request := &pfs.ListRepoRequest{
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+	for _, repoName := range provenance {
+		request.Provenance = append(request.Provenance, NewRepo(repoName))
+	}
+	repoInfos, err := c.PfsAPIClient.ListRepo(
+		c.Ctx(),
+		request,
+	)
+	if err != nil {
+		return nil, "", sanitizeErr(err)
+	}
+	return repoInfos.RepoInfo, repoInfos.NextPageToken, nil
+}
+
 // DeleteRepo deletes a repo and reclaims the storage space it was using. Note
 // that as of 1.0 we do not reclaim the blocks that the Repo was referencing,
 // this is because they may also be referenced by other Repos and deleting them
@@ -104,6 +128,94 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return err
 }
 
+// ForkRepo creates a new Repo named dst whose commits and branches are
+// copies of those in src. The new Repo shares its data with src, so forking
+// is cheap regardless of the size of src. Commits made to either Repo after
+// the fork are not visible in the other.
+func (c APIClient) ForkRepo(src string, dst string) error {
+	_, err := c.PfsAPIClient.ForkRepo(
+		c.Ctx(),
+		&pfs.ForkRepoRequest{
+			Src: NewRepo(src),
+			Dst: NewRepo(dst),
+		},
+	)
+	return sanitizeErr(err)
+}
+
+// SetRepoQuota limits the storage used by a Repo to sizeBytes bytes and
+// fileCount files. A limit of 0 means that there's no limit; if both limits
+// are 0, the Repo's quota is removed. Only cluster admins may set quotas.
+func (c APIClient) SetRepoQuota(repoName string, sizeBytes uint64, fileCount uint64) error {
+	_, err := c.PfsAPIClient.SetQuota(
+		c.Ctx(),
+		&pfs.SetQuotaRequest{
+			Repo: NewRepo(repoName),
+			Quota: &pfs.Quota{
+				SizeBytes: sizeBytes,
+				FileCount: fileCount,
+			},
+		},
+	)
+	return sanitizeErr(err)
+}
+
+// SetUserQuota limits the storage used by the commits that a user authors to
+// sizeBytes bytes and fileCount files. It's otherwise the same as
+// SetRepoQuota.
+func (c APIClient) SetUserQuota(user string, sizeBytes uint64, fileCount uint64) error {
+	_, err := c.PfsAPIClient.SetQuota(
+		c.Ctx(),
+		&pfs.SetQuotaRequest{
+			User: user,
+			Quota: &pfs.Quota{
+				SizeBytes: sizeBytes,
+				FileCount: fileCount,
+			},
+		},
+	)
+	return sanitizeErr(err)
+}
+
+// ListQuota returns the storage used by every Repo and user, along with
+// their quotas.
+func (c APIClient) ListQuota() ([]*pfs.QuotaInfo, error) {
+	quotaInfos, err := c.PfsAPIClient.ListQuota(
+		c.Ctx(),
+		&pfs.ListQuotaRequest{},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return quotaInfos.QuotaInfo, nil
+}
+
+// Fsck checks the consistency of every Repo's Commits, trees and branches,
+// and returns the problems that it finds. If fix is true, the problems that
+// can be fixed safely are fixed, and marked as such.
+func (c APIClient) Fsck(fix bool) ([]*pfs.FsckResponse, error) {
+	fsckClient, err := c.PfsAPIClient.Fsck(
+		c.Ctx(),
+		&pfs.FsckRequest{
+			Fix: fix,
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	var result []*pfs.FsckResponse
+	for {
+		response, err := fsckClient.Recv()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, sanitizeErr(err)
+		}
+		result = append(result, response)
+	}
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -220,6 +332,31 @@ func (c APIClient) ListCommit(repoName string, to string, from string, number ui
 	return commitInfos.CommitInfo, nil
 }
 
+// ListCommitPage is like ListCommit, except that it lists the 'number'
+// commits after the commit 'pageToken' (if it's set). It also returns the
+// token of the next page, which is empty if there are no more commits.
+func (c APIClient) ListCommitPage(repoName string, to string, from string, number uint64, pageToken string) ([]*pfs.CommitInfo, string, error) {
+	req := &pfs.ListCommitRequest{
+		Repo:      NewRepo(repoName),
+		Number:    number,
+		PageToken: pageToken,
+	}
+	if from != "" {
+		req.From = NewCommit(repoName, from)
+	}
+	if to != "" {
+		req.To = NewCommit(repoName, to)
+	}
+	commitInfos, err := c.PfsAPIClient.ListCommit(
+		c.Ctx(),
+		req,
+	)
+	if err != nil {
+		return nil, "", sanitizeErr(err)
+	}
+	return commitInfos.CommitInfo, commitInfos.NextPageToken, nil
+}
+
 // ListCommitByRepo lists all commits in a repo.
 func (c APIClient) ListCommitByRepo(repoName string) ([]*pfs.CommitInfo, error) {
 	return c.ListCommit(repoName, "", "", 0)
@@ -493,6 +630,19 @@ func (c APIClient) Compact() error {
 	return err
 }
 
+// InspectCompaction returns the progress of the compaction of objects that's
+// running, or the results of the last one if none is.
+func (c APIClient) InspectCompaction() (*pfs.CompactionInfo, error) {
+	compactionInfo, err := c.ObjectAPIClient.InspectCompaction(
+		c.Ctx(),
+		&types.Empty{},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return compactionInfo, nil
+}
+
 // PutFileWriter writes a file to PFS.
 // NOTE: PutFileWriter returns an io.WriteCloser you must call Close on it when
 // you are done writing.
@@ -534,6 +684,51 @@ func (c APIClient) PutFileOverwrite(repoName string, commitID string, path strin
 	return int(written), err
 }
 
+// PutFileWithAttrs is like PutFile, except that it also sets the file's POSIX
+// permission bits to those in 'mode' and its modification time to 'mtime'.
+// If overwrite is true, the file's existing content is replaced.
+func (c APIClient) PutFileWithAttrs(repoName string, commitID string, path string, mode os.FileMode, mtime time.Time, overwrite bool, reader io.Reader) (_ int, retErr error) {
+	writer, err := c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, overwrite)
+	if err != nil {
+		return 0, sanitizeErr(err)
+	}
+	writer.request.Mode = uint32(mode.Perm())
+	if !mtime.IsZero() {
+		writer.request.Mtime, err = types.TimestampProto(mtime)
+		if err != nil {
+			return 0, err
+		}
+	}
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	written, err := io.Copy(writer, reader)
+	return int(written), err
+}
+
+// PutSymlink creates a symlink at 'path' that points to 'target'. The target
+// isn't checked, and may be outside of the repo.
+func (c APIClient) PutSymlink(repoName string, commitID string, path string, target string) (retErr error) {
+	putFileClient, err := c.PfsAPIClient.PutFile(c.Ctx())
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	defer func() {
+		if _, err := putFileClient.CloseAndRecv(); err != nil && retErr == nil {
+			retErr = sanitizeErr(err)
+		}
+	}()
+	if err := putFileClient.Send(&pfs.PutFileRequest{
+		File:          NewFile(repoName, commitID, path),
+		SymlinkTarget: target,
+	}); err != nil {
+		return sanitizeErr(err)
+	}
+	return nil
+}
+
 //PutFileSplit writes a file to PFS from a reader
 // delimiter is used to tell PFS how to break the input into blocks
 func (c APIClient) PutFileSplit(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, overwrite bool, reader io.Reader) (_ int, retErr error) {
@@ -651,6 +846,25 @@ func (c APIClient) ListFile(repoName string, commitID string, path string) ([]*p
 	return fileInfos.FileInfo, nil
 }
 
+// ListFileHistory returns info about a file in each of the commits, starting
+// from commitID and going back through its ancestors, in which the file was
+// changed. Commits in which the file was deleted are returned with Deleted
+// set. At most n FileInfos are returned; if n is 0, the file's entire history
+// is returned.
+func (c APIClient) ListFileHistory(repoName string, commitID string, path string, n int64) ([]*pfs.FileInfo, error) {
+	fileInfos, err := c.PfsAPIClient.ListFileHistory(
+		c.Ctx(),
+		&pfs.ListFileHistoryRequest{
+			File: NewFile(repoName, commitID, path),
+			N:    n,
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return fileInfos.FileInfo, nil
+}
+
 // GlobFile returns files that match a given glob pattern in a given commit.
 // The pattern is documented here:
 // https://golang.org/pkg/path/filepath/#Match
@@ -668,6 +882,38 @@ func (c APIClient) GlobFile(repoName string, commitID string, pattern string) ([
 	return fileInfos.FileInfo, nil
 }
 
+// GrepFile searches the files that match a glob pattern for lines that match
+// a regular expression, and calls f with each matching line. Directories that
+// match the pattern are searched recursively. Matches are returned in path
+// order, and if maxMatches is > 0, at most maxMatches of them are returned.
+// The search runs inside pachd, so file contents aren't sent to the client.
+func (c APIClient) GrepFile(repoName string, commitID string, pattern string, regex string, maxMatches int64, f func(*pfs.GrepFileResponse) error) error {
+	grepFileClient, err := c.PfsAPIClient.GrepFile(
+		c.Ctx(),
+		&pfs.GrepFileRequest{
+			Commit:     NewCommit(repoName, commitID),
+			Pattern:    pattern,
+			Regex:      regex,
+			MaxMatches: maxMatches,
+		},
+	)
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	for {
+		response, err := grepFileClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		if err := f(response); err != nil {
+			return err
+		}
+	}
+}
+
 // DiffFile returns the difference between 2 paths, old path may be omitted in
 // which case the parent of the new path will be used. DiffFile return 2 values
 // (unless it returns an error) the first value is files present under new
@@ -693,6 +939,31 @@ func (c APIClient) DiffFile(newRepoName, newCommitID, newPath, oldRepoName,
 	return resp.NewFiles, resp.OldFiles, nil
 }
 
+// DiffFileContent is like DiffFile, but it also returns the changes to the
+// contents of the text files that differ. Files larger than sizeLimit bytes
+// are skipped; if sizeLimit is 0 a default limit is used.
+func (c APIClient) DiffFileContent(newRepoName, newCommitID, newPath, oldRepoName,
+	oldCommitID, oldPath string, shallow bool, sizeLimit uint64) ([]*pfs.FileInfo, []*pfs.FileInfo, []*pfs.FileContentDiff, error) {
+	var oldFile *pfs.File
+	if oldRepoName != "" {
+		oldFile = NewFile(oldRepoName, oldCommitID, oldPath)
+	}
+	resp, err := c.PfsAPIClient.DiffFile(
+		c.Ctx(),
+		&pfs.DiffFileRequest{
+			NewFile:          NewFile(newRepoName, newCommitID, newPath),
+			OldFile:          oldFile,
+			Shallow:          shallow,
+			Content:          true,
+			ContentSizeLimit: sizeLimit,
+		},
+	)
+	if err != nil {
+		return nil, nil, nil, sanitizeErr(err)
+	}
+	return resp.NewFiles, resp.OldFiles, resp.ContentDiffs, nil
+}
+
 // WalkFn is the type of the function called for each file in Walk.
 // Returning a non-nil error from WalkFn will result in Walk aborting and
 // returning said error.
@@ -6,3 +6,8 @@ import "fmt"
 func (c *Commit) FullID() string {
 	return fmt.Sprintf("%s/%s", c.Repo.Name, c.ID)
 }
+
+// PipelineAuthor returns the author of the commits made by 'pipeline'.
+func PipelineAuthor(pipeline string) string {
+	return "pipeline:" + pipeline
+}
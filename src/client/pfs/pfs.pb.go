@@ -27,6 +27,13 @@
 		ListRepoRequest
 		ListRepoResponse
 		DeleteRepoRequest
+		Quota
+		Usage
+		SetQuotaRequest
+		ListQuotaRequest
+		QuotaInfo
+		QuotaInfos
+		ForkRepoRequest
 		StartCommitRequest
 		BuildCommitRequest
 		FinishCommitRequest
@@ -43,10 +50,17 @@
 		PutFileRequest
 		InspectFileRequest
 		ListFileRequest
+		ListFileHistoryRequest
 		GlobFileRequest
+		GrepFileRequest
+		GrepFileResponse
 		FileInfos
 		DiffFileRequest
+		JSONKeyDiff
+		FileContentDiff
 		DiffFileResponse
+		FsckRequest
+		FsckResponse
 		DeleteFileRequest
 		PutObjectRequest
 		GetObjectsRequest
@@ -61,6 +75,7 @@
 		CheckObjectRequest
 		CheckObjectResponse
 		ObjectIndex
+		CompactionInfo
 */
 package pfs
 
@@ -97,17 +112,20 @@ const (
 	FileType_RESERVED FileType = 0
 	FileType_FILE     FileType = 1
 	FileType_DIR      FileType = 2
+	FileType_SYMLINK  FileType = 3
 )
 
 var FileType_name = map[int32]string{
 	0: "RESERVED",
 	1: "FILE",
 	2: "DIR",
+	3: "SYMLINK",
 }
 var FileType_value = map[string]int32{
 	"RESERVED": 0,
 	"FILE":     1,
 	"DIR":      2,
+	"SYMLINK":  3,
 }
 
 func (x FileType) String() string {
@@ -299,6 +317,11 @@ type RepoInfo struct {
 	Provenance  []*Repo                     `protobuf:"bytes,4,rep,name=provenance" json:"provenance,omitempty"`
 	Description string                      `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Scope       auth.Scope                  `protobuf:"varint,6,opt,name=scope,proto3,enum=auth.Scope" json:"scope,omitempty"`
+	// file_count is the number of files that have been added to the repo
+	FileCount uint64 `protobuf:"varint,7,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	// user_usage is the storage that each user's commits have added to the
+	// repo, which is subtracted from the users' usage when the repo is deleted
+	UserUsage map[string]*Usage `protobuf:"bytes,8,rep,name=user_usage,json=userUsage" json:"user_usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *RepoInfo) Reset()                    { *m = RepoInfo{} }
@@ -348,6 +371,20 @@ func (m *RepoInfo) GetScope() auth.Scope {
 	return auth.Scope_NONE
 }
 
+func (m *RepoInfo) GetFileCount() uint64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+func (m *RepoInfo) GetUserUsage() map[string]*Usage {
+	if m != nil {
+		return m.UserUsage
+	}
+	return nil
+}
+
 // Commit is a reference to a commit (e.g. the collection of branches and the
 // collection of currently-open commits in etcd are collections of Commit
 // protos)
@@ -386,6 +423,14 @@ type CommitInfo struct {
 	// this is the block that stores the serialized form of a tree that
 	// represents the entire file system hierarchy of the repo at this commit
 	Tree *Object `protobuf:"bytes,7,opt,name=tree" json:"tree,omitempty"`
+	// description is a user-provided message describing the commit
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	// author is the user or pipeline ("pipeline:<name>") that started the
+	// commit. It's empty if the auth system isn't activated.
+	Author string `protobuf:"bytes,9,opt,name=author,proto3" json:"author,omitempty"`
+	// usage is the storage that the commit added to its repo's and its
+	// author's usage when it was finished
+	Usage *Usage `protobuf:"bytes,10,opt,name=usage" json:"usage,omitempty"`
 }
 
 func (m *CommitInfo) Reset()                    { *m = CommitInfo{} }
@@ -442,6 +487,27 @@ func (m *CommitInfo) GetTree() *Object {
 	return nil
 }
 
+func (m *CommitInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CommitInfo) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *CommitInfo) GetUsage() *Usage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type FileInfo struct {
 	File      *File    `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 	FileType  FileType `protobuf:"varint,2,opt,name=file_type,json=fileType,proto3,enum=pfs.FileType" json:"file_type,omitempty"`
@@ -451,6 +517,15 @@ type FileInfo struct {
 	Children []string  `protobuf:"bytes,6,rep,name=children" json:"children,omitempty"`
 	Objects  []*Object `protobuf:"bytes,8,rep,name=objects" json:"objects,omitempty"`
 	Hash     []byte    `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	// mode holds the POSIX permission bits of the file, or 0 if they were never
+	// set
+	Mode  uint32                      `protobuf:"varint,9,opt,name=mode,proto3" json:"mode,omitempty"`
+	Mtime *google_protobuf1.Timestamp `protobuf:"bytes,10,opt,name=mtime" json:"mtime,omitempty"`
+	// symlink_target is the target of the link, if file_type is SYMLINK
+	SymlinkTarget string `protobuf:"bytes,11,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	// deleted is set in the history returned by ListFileHistory, for the
+	// commits in which the file was deleted
+	Deleted bool `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
 }
 
 func (m *FileInfo) Reset()                    { *m = FileInfo{} }
@@ -500,6 +575,34 @@ func (m *FileInfo) GetHash() []byte {
 	return nil
 }
 
+func (m *FileInfo) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileInfo) GetMtime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Mtime
+	}
+	return nil
+}
+
+func (m *FileInfo) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
+func (m *FileInfo) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type ByteRange struct {
 	Lower uint64 `protobuf:"varint,1,opt,name=lower,proto3" json:"lower,omitempty"`
 	Upper uint64 `protobuf:"varint,2,opt,name=upper,proto3" json:"upper,omitempty"`
@@ -643,6 +746,11 @@ type ListRepoRequest struct {
 	// Include auth information in the response, i.e. what kind of
 	// scope the user has over each repo.
 	IncludeAuth bool `protobuf:"varint,2,opt,name=include_auth,json=includeAuth,proto3" json:"include_auth,omitempty"`
+	// If page_size isn't 0, at most page_size repos are returned, newest
+	// first. To list the next page, set page_token to the previous response's
+	// next_page_token.
+	PageSize  int64  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (m *ListRepoRequest) Reset()                    { *m = ListRepoRequest{} }
@@ -664,8 +772,24 @@ func (m *ListRepoRequest) GetIncludeAuth() bool {
 	return false
 }
 
+func (m *ListRepoRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListRepoRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type ListRepoResponse struct {
 	RepoInfo []*RepoInfo `protobuf:"bytes,1,rep,name=repo_info,json=repoInfo" json:"repo_info,omitempty"`
+	// next_page_token is set if there may be more repos after this page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (m *ListRepoResponse) Reset()                    { *m = ListRepoResponse{} }
@@ -680,6 +804,13 @@ func (m *ListRepoResponse) GetRepoInfo() []*RepoInfo {
 	return nil
 }
 
+func (m *ListRepoResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type DeleteRepoRequest struct {
 	Repo  *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
 	Force bool  `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
@@ -712,18 +843,203 @@ func (m *DeleteRepoRequest) GetAll() bool {
 	return false
 }
 
+// Quota limits the storage that a repo or user may use. A limit of 0 means
+// that there's no limit.
+type Quota struct {
+	SizeBytes uint64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FileCount uint64 `protobuf:"varint,2,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+}
+
+func (m *Quota) Reset()                    { *m = Quota{} }
+func (m *Quota) String() string            { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()               {}
+func (*Quota) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{19} }
+
+func (m *Quota) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *Quota) GetFileCount() uint64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+// Usage is the storage used by a repo or user
+type Usage struct {
+	SizeBytes uint64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FileCount uint64 `protobuf:"varint,2,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+}
+
+func (m *Usage) Reset()                    { *m = Usage{} }
+func (m *Usage) String() string            { return proto.CompactTextString(m) }
+func (*Usage) ProtoMessage()               {}
+func (*Usage) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{20} }
+
+func (m *Usage) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *Usage) GetFileCount() uint64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+type SetQuotaRequest struct {
+	// Exactly one of repo and user must be set
+	Repo *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// If quota is unset or has no limits, the existing quota is removed
+	Quota *Quota `protobuf:"bytes,3,opt,name=quota" json:"quota,omitempty"`
+}
+
+func (m *SetQuotaRequest) Reset()                    { *m = SetQuotaRequest{} }
+func (m *SetQuotaRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetQuotaRequest) ProtoMessage()               {}
+func (*SetQuotaRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{21} }
+
+func (m *SetQuotaRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *SetQuotaRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SetQuotaRequest) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type ListQuotaRequest struct {
+}
+
+func (m *ListQuotaRequest) Reset()                    { *m = ListQuotaRequest{} }
+func (m *ListQuotaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListQuotaRequest) ProtoMessage()               {}
+func (*ListQuotaRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{22} }
+
+// QuotaInfo describes the storage used by a repo or user and its quota
+type QuotaInfo struct {
+	// Exactly one of repo and user is set
+	Repo *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// quota is unset if there's no quota
+	Quota *Quota `protobuf:"bytes,3,opt,name=quota" json:"quota,omitempty"`
+	Usage *Usage `protobuf:"bytes,4,opt,name=usage" json:"usage,omitempty"`
+}
+
+func (m *QuotaInfo) Reset()                    { *m = QuotaInfo{} }
+func (m *QuotaInfo) String() string            { return proto.CompactTextString(m) }
+func (*QuotaInfo) ProtoMessage()               {}
+func (*QuotaInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{23} }
+
+func (m *QuotaInfo) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *QuotaInfo) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *QuotaInfo) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *QuotaInfo) GetUsage() *Usage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type QuotaInfos struct {
+	QuotaInfo []*QuotaInfo `protobuf:"bytes,1,rep,name=quota_info,json=quotaInfo" json:"quota_info,omitempty"`
+}
+
+func (m *QuotaInfos) Reset()                    { *m = QuotaInfos{} }
+func (m *QuotaInfos) String() string            { return proto.CompactTextString(m) }
+func (*QuotaInfos) ProtoMessage()               {}
+func (*QuotaInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{24} }
+
+func (m *QuotaInfos) GetQuotaInfo() []*QuotaInfo {
+	if m != nil {
+		return m.QuotaInfo
+	}
+	return nil
+}
+
+type ForkRepoRequest struct {
+	Src         *Repo  `protobuf:"bytes,1,opt,name=src" json:"src,omitempty"`
+	Dst         *Repo  `protobuf:"bytes,2,opt,name=dst" json:"dst,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *ForkRepoRequest) Reset()                    { *m = ForkRepoRequest{} }
+func (m *ForkRepoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForkRepoRequest) ProtoMessage()               {}
+func (*ForkRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{25} }
+
+func (m *ForkRepoRequest) GetSrc() *Repo {
+	if m != nil {
+		return m.Src
+	}
+	return nil
+}
+
+func (m *ForkRepoRequest) GetDst() *Repo {
+	if m != nil {
+		return m.Dst
+	}
+	return nil
+}
+
+func (m *ForkRepoRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type StartCommitRequest struct {
 	// Parent.ID may be empty in which case the commit that Branch points to will be used as the parent.
 	// If branch is empty, or if branch does not exist, the commit will have no parent.
-	Parent     *Commit   `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
-	Branch     string    `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
-	Provenance []*Commit `protobuf:"bytes,2,rep,name=provenance" json:"provenance,omitempty"`
+	Parent      *Commit   `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
+	Branch      string    `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Provenance  []*Commit `protobuf:"bytes,2,rep,name=provenance" json:"provenance,omitempty"`
+	Description string    `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (m *StartCommitRequest) Reset()                    { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()               {}
-func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{19} }
+func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{26} }
 
 func (m *StartCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -746,17 +1062,32 @@ func (m *StartCommitRequest) GetProvenance() []*Commit {
 	return nil
 }
 
+func (m *StartCommitRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type BuildCommitRequest struct {
-	Parent     *Commit   `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
-	Branch     string    `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
-	Provenance []*Commit `protobuf:"bytes,2,rep,name=provenance" json:"provenance,omitempty"`
-	Tree       *Object   `protobuf:"bytes,3,opt,name=tree" json:"tree,omitempty"`
+	Parent      *Commit   `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
+	Branch      string    `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	Provenance  []*Commit `protobuf:"bytes,2,rep,name=provenance" json:"provenance,omitempty"`
+	Tree        *Object   `protobuf:"bytes,3,opt,name=tree" json:"tree,omitempty"`
+	Description string    `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// If set, the commit is created with this ID instead of a new one. This is
+	// used to replicate commits from another cluster.
+	ID string `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	// If set, the commit is attributed to this author instead of the caller.
+	// This is used to replicate commits, and by pipelines when the auth system
+	// isn't activated. Only admins can attribute commits to someone else.
+	Author string `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
 }
 
 func (m *BuildCommitRequest) Reset()                    { *m = BuildCommitRequest{} }
 func (m *BuildCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*BuildCommitRequest) ProtoMessage()               {}
-func (*BuildCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{20} }
+func (*BuildCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{27} }
 
 func (m *BuildCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -786,14 +1117,37 @@ func (m *BuildCommitRequest) GetTree() *Object {
 	return nil
 }
 
+func (m *BuildCommitRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *BuildCommitRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *BuildCommitRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
 type FinishCommitRequest struct {
 	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	// If set, description replaces the commit's existing description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (m *FinishCommitRequest) Reset()                    { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()               {}
-func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{21} }
+func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{28} }
 
 func (m *FinishCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -802,6 +1156,13 @@ func (m *FinishCommitRequest) GetCommit() *Commit {
 	return nil
 }
 
+func (m *FinishCommitRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type InspectCommitRequest struct {
 	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 }
@@ -809,7 +1170,7 @@ type InspectCommitRequest struct {
 func (m *InspectCommitRequest) Reset()                    { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()               {}
-func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{22} }
+func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{29} }
 
 func (m *InspectCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -823,12 +1184,15 @@ type ListCommitRequest struct {
 	From   *Commit `protobuf:"bytes,2,opt,name=from" json:"from,omitempty"`
 	To     *Commit `protobuf:"bytes,3,opt,name=to" json:"to,omitempty"`
 	Number uint64  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	// To list the commits after the first 'number' commits, set page_token to
+	// the previous response's next_page_token.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (m *ListCommitRequest) Reset()                    { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()               {}
-func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{23} }
+func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{30} }
 
 func (m *ListCommitRequest) GetRepo() *Repo {
 	if m != nil {
@@ -858,14 +1222,23 @@ func (m *ListCommitRequest) GetNumber() uint64 {
 	return 0
 }
 
+func (m *ListCommitRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type CommitInfos struct {
 	CommitInfo []*CommitInfo `protobuf:"bytes,1,rep,name=commit_info,json=commitInfo" json:"commit_info,omitempty"`
+	// next_page_token is set if there may be more commits after this page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (m *CommitInfos) Reset()                    { *m = CommitInfos{} }
 func (m *CommitInfos) String() string            { return proto.CompactTextString(m) }
 func (*CommitInfos) ProtoMessage()               {}
-func (*CommitInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{24} }
+func (*CommitInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{31} }
 
 func (m *CommitInfos) GetCommitInfo() []*CommitInfo {
 	if m != nil {
@@ -874,6 +1247,13 @@ func (m *CommitInfos) GetCommitInfo() []*CommitInfo {
 	return nil
 }
 
+func (m *CommitInfos) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type ListBranchRequest struct {
 	Repo *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
 }
@@ -881,7 +1261,7 @@ type ListBranchRequest struct {
 func (m *ListBranchRequest) Reset()                    { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()               {}
-func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{25} }
+func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{32} }
 
 func (m *ListBranchRequest) GetRepo() *Repo {
 	if m != nil {
@@ -898,7 +1278,7 @@ type SetBranchRequest struct {
 func (m *SetBranchRequest) Reset()                    { *m = SetBranchRequest{} }
 func (m *SetBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetBranchRequest) ProtoMessage()               {}
-func (*SetBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{26} }
+func (*SetBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
 
 func (m *SetBranchRequest) GetCommit() *Commit {
 	if m != nil {
@@ -922,7 +1302,7 @@ type DeleteBranchRequest struct {
 func (m *DeleteBranchRequest) Reset()                    { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()               {}
-func (*DeleteBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{27} }
+func (*DeleteBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
 
 func (m *DeleteBranchRequest) GetRepo() *Repo {
 	if m != nil {
@@ -945,7 +1325,7 @@ type DeleteCommitRequest struct {
 func (m *DeleteCommitRequest) Reset()                    { *m = DeleteCommitRequest{} }
 func (m *DeleteCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCommitRequest) ProtoMessage()               {}
-func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{28} }
+func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
 
 func (m *DeleteCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -962,7 +1342,7 @@ type FlushCommitRequest struct {
 func (m *FlushCommitRequest) Reset()                    { *m = FlushCommitRequest{} }
 func (m *FlushCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*FlushCommitRequest) ProtoMessage()               {}
-func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{29} }
+func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
 
 func (m *FlushCommitRequest) GetCommits() []*Commit {
 	if m != nil {
@@ -988,7 +1368,7 @@ type SubscribeCommitRequest struct {
 func (m *SubscribeCommitRequest) Reset()                    { *m = SubscribeCommitRequest{} }
 func (m *SubscribeCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*SubscribeCommitRequest) ProtoMessage()               {}
-func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{30} }
+func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
 
 func (m *SubscribeCommitRequest) GetRepo() *Repo {
 	if m != nil {
@@ -1020,7 +1400,7 @@ type GetFileRequest struct {
 func (m *GetFileRequest) Reset()                    { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()               {}
-func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{31} }
+func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{38} }
 
 func (m *GetFileRequest) GetFile() *File {
 	if m != nil {
@@ -1062,12 +1442,19 @@ type PutFileRequest struct {
 	// If true overwrite the existing value of the file, equivalent to calling
 	// DeleteFile followed by PutFile.
 	Overwrite bool `protobuf:"varint,10,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// If set, mode and mtime replace the POSIX permission bits and modification
+	// time of the file (or of each file, if the data is split).
+	Mode  uint32                      `protobuf:"varint,11,opt,name=mode,proto3" json:"mode,omitempty"`
+	Mtime *google_protobuf1.Timestamp `protobuf:"bytes,12,opt,name=mtime" json:"mtime,omitempty"`
+	// If set, a symlink pointing to symlink_target is created at File.Path, and
+	// no data may be sent.
+	SymlinkTarget string `protobuf:"bytes,13,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
 }
 
 func (m *PutFileRequest) Reset()                    { *m = PutFileRequest{} }
 func (m *PutFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRequest) ProtoMessage()               {}
-func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{32} }
+func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
 
 func (m *PutFileRequest) GetFile() *File {
 	if m != nil {
@@ -1125,6 +1512,27 @@ func (m *PutFileRequest) GetOverwrite() bool {
 	return false
 }
 
+func (m *PutFileRequest) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *PutFileRequest) GetMtime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Mtime
+	}
+	return nil
+}
+
+func (m *PutFileRequest) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 type InspectFileRequest struct {
 	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 }
@@ -1132,7 +1540,7 @@ type InspectFileRequest struct {
 func (m *InspectFileRequest) Reset()                    { *m = InspectFileRequest{} }
 func (m *InspectFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectFileRequest) ProtoMessage()               {}
-func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
+func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
 
 func (m *InspectFileRequest) GetFile() *File {
 	if m != nil {
@@ -1149,7 +1557,7 @@ type ListFileRequest struct {
 func (m *ListFileRequest) Reset()                    { *m = ListFileRequest{} }
 func (m *ListFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListFileRequest) ProtoMessage()               {}
-func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
+func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{41} }
 
 func (m *ListFileRequest) GetFile() *File {
 	if m != nil {
@@ -1165,6 +1573,31 @@ func (m *ListFileRequest) GetFull() bool {
 	return false
 }
 
+type ListFileHistoryRequest struct {
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// The maximum number of commits to return; if 0, all commits are returned.
+	N int64 `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+}
+
+func (m *ListFileHistoryRequest) Reset()                    { *m = ListFileHistoryRequest{} }
+func (m *ListFileHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListFileHistoryRequest) ProtoMessage()               {}
+func (*ListFileHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
+
+func (m *ListFileHistoryRequest) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *ListFileHistoryRequest) GetN() int64 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
 type GlobFileRequest struct {
 	Commit  *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 	Pattern string  `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
@@ -1173,7 +1606,7 @@ type GlobFileRequest struct {
 func (m *GlobFileRequest) Reset()                    { *m = GlobFileRequest{} }
 func (m *GlobFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*GlobFileRequest) ProtoMessage()               {}
-func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
+func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
 
 func (m *GlobFileRequest) GetCommit() *Commit {
 	if m != nil {
@@ -1189,35 +1622,122 @@ func (m *GlobFileRequest) GetPattern() string {
 	return ""
 }
 
-// FileInfos is the result of both ListFile and GlobFile
-type FileInfos struct {
-	FileInfo []*FileInfo `protobuf:"bytes,1,rep,name=file_info,json=fileInfo" json:"file_info,omitempty"`
-}
-
-func (m *FileInfos) Reset()                    { *m = FileInfos{} }
-func (m *FileInfos) String() string            { return proto.CompactTextString(m) }
-func (*FileInfos) ProtoMessage()               {}
-func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
-
-func (m *FileInfos) GetFileInfo() []*FileInfo {
+type GrepFileRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	// pattern is a glob pattern, as in GlobFileRequest. Directories that match
+	// it are searched recursively.
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// regex is the regular expression that lines must match, in the syntax
+	// accepted by Go's regexp package (RE2).
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	// max_matches is the maximum number of matching lines to return; if 0, all
+	// of them are returned.
+	MaxMatches int64 `protobuf:"varint,4,opt,name=max_matches,json=maxMatches,proto3" json:"max_matches,omitempty"`
+}
+
+func (m *GrepFileRequest) Reset()                    { *m = GrepFileRequest{} }
+func (m *GrepFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GrepFileRequest) ProtoMessage()               {}
+func (*GrepFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
+
+func (m *GrepFileRequest) GetCommit() *Commit {
 	if m != nil {
-		return m.FileInfo
+		return m.Commit
 	}
 	return nil
 }
 
-type DiffFileRequest struct {
-	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile" json:"new_file,omitempty"`
-	// OldFile may be left nil in which case the same path in the parent of
-	// NewFile's commit will be used.
-	OldFile *File `protobuf:"bytes,2,opt,name=old_file,json=oldFile" json:"old_file,omitempty"`
-	Shallow bool  `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
+func (m *GrepFileRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *GrepFileRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *GrepFileRequest) GetMaxMatches() int64 {
+	if m != nil {
+		return m.MaxMatches
+	}
+	return 0
+}
+
+// GrepFileResponse is a line that matched the regex in a GrepFileRequest
+type GrepFileResponse struct {
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// line_number is the number of the line in the file, starting at 1
+	LineNumber int64 `protobuf:"varint,2,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	// line is the content of the line, without its trailing newline
+	Line string `protobuf:"bytes,3,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (m *GrepFileResponse) Reset()                    { *m = GrepFileResponse{} }
+func (m *GrepFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*GrepFileResponse) ProtoMessage()               {}
+func (*GrepFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
+
+func (m *GrepFileResponse) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *GrepFileResponse) GetLineNumber() int64 {
+	if m != nil {
+		return m.LineNumber
+	}
+	return 0
+}
+
+func (m *GrepFileResponse) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
+// FileInfos is the result of both ListFile and GlobFile
+type FileInfos struct {
+	FileInfo []*FileInfo `protobuf:"bytes,1,rep,name=file_info,json=fileInfo" json:"file_info,omitempty"`
+}
+
+func (m *FileInfos) Reset()                    { *m = FileInfos{} }
+func (m *FileInfos) String() string            { return proto.CompactTextString(m) }
+func (*FileInfos) ProtoMessage()               {}
+func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
+
+func (m *FileInfos) GetFileInfo() []*FileInfo {
+	if m != nil {
+		return m.FileInfo
+	}
+	return nil
+}
+
+type DiffFileRequest struct {
+	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile" json:"new_file,omitempty"`
+	// OldFile may be left nil in which case the same path in the parent of
+	// NewFile's commit will be used.
+	OldFile *File `protobuf:"bytes,2,opt,name=old_file,json=oldFile" json:"old_file,omitempty"`
+	Shallow bool  `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
+	// If true, the response includes the changes to the contents of the text
+	// files that differ.
+	Content bool `protobuf:"varint,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Files larger than this aren't included in content diffs. If 0, a default
+	// limit is used.
+	ContentSizeLimit uint64 `protobuf:"varint,5,opt,name=content_size_limit,json=contentSizeLimit,proto3" json:"content_size_limit,omitempty"`
 }
 
 func (m *DiffFileRequest) Reset()                    { *m = DiffFileRequest{} }
 func (m *DiffFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*DiffFileRequest) ProtoMessage()               {}
-func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
+func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
 
 func (m *DiffFileRequest) GetNewFile() *File {
 	if m != nil {
@@ -1240,15 +1760,110 @@ func (m *DiffFileRequest) GetShallow() bool {
 	return false
 }
 
+func (m *DiffFileRequest) GetContent() bool {
+	if m != nil {
+		return m.Content
+	}
+	return false
+}
+
+func (m *DiffFileRequest) GetContentSizeLimit() uint64 {
+	if m != nil {
+		return m.ContentSizeLimit
+	}
+	return 0
+}
+
+// JSONKeyDiff describes a change to the value at one key of a JSON file.
+type JSONKeyDiff struct {
+	// key identifies the changed value, e.g. "labels[0].name"
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The JSON encodings of the old and new values. old_value is empty if the
+	// key was added and new_value is empty if the key was removed.
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *JSONKeyDiff) Reset()                    { *m = JSONKeyDiff{} }
+func (m *JSONKeyDiff) String() string            { return proto.CompactTextString(m) }
+func (*JSONKeyDiff) ProtoMessage()               {}
+func (*JSONKeyDiff) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
+
+func (m *JSONKeyDiff) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *JSONKeyDiff) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *JSONKeyDiff) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+// FileContentDiff describes the changes to the contents of a file. One of
+// unified_diff or json_diffs is set.
+type FileContentDiff struct {
+	// new_path and old_path are empty if the file was removed or added
+	// respectively
+	NewPath     string         `protobuf:"bytes,1,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	OldPath     string         `protobuf:"bytes,2,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	UnifiedDiff string         `protobuf:"bytes,3,opt,name=unified_diff,json=unifiedDiff,proto3" json:"unified_diff,omitempty"`
+	JsonDiffs   []*JSONKeyDiff `protobuf:"bytes,4,rep,name=json_diffs,json=jsonDiffs" json:"json_diffs,omitempty"`
+}
+
+func (m *FileContentDiff) Reset()                    { *m = FileContentDiff{} }
+func (m *FileContentDiff) String() string            { return proto.CompactTextString(m) }
+func (*FileContentDiff) ProtoMessage()               {}
+func (*FileContentDiff) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
+
+func (m *FileContentDiff) GetNewPath() string {
+	if m != nil {
+		return m.NewPath
+	}
+	return ""
+}
+
+func (m *FileContentDiff) GetOldPath() string {
+	if m != nil {
+		return m.OldPath
+	}
+	return ""
+}
+
+func (m *FileContentDiff) GetUnifiedDiff() string {
+	if m != nil {
+		return m.UnifiedDiff
+	}
+	return ""
+}
+
+func (m *FileContentDiff) GetJsonDiffs() []*JSONKeyDiff {
+	if m != nil {
+		return m.JsonDiffs
+	}
+	return nil
+}
+
 type DiffFileResponse struct {
-	NewFiles []*FileInfo `protobuf:"bytes,1,rep,name=new_files,json=newFiles" json:"new_files,omitempty"`
-	OldFiles []*FileInfo `protobuf:"bytes,2,rep,name=old_files,json=oldFiles" json:"old_files,omitempty"`
+	NewFiles     []*FileInfo        `protobuf:"bytes,1,rep,name=new_files,json=newFiles" json:"new_files,omitempty"`
+	OldFiles     []*FileInfo        `protobuf:"bytes,2,rep,name=old_files,json=oldFiles" json:"old_files,omitempty"`
+	ContentDiffs []*FileContentDiff `protobuf:"bytes,3,rep,name=content_diffs,json=contentDiffs" json:"content_diffs,omitempty"`
 }
 
 func (m *DiffFileResponse) Reset()                    { *m = DiffFileResponse{} }
 func (m *DiffFileResponse) String() string            { return proto.CompactTextString(m) }
 func (*DiffFileResponse) ProtoMessage()               {}
-func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{38} }
+func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
 
 func (m *DiffFileResponse) GetNewFiles() []*FileInfo {
 	if m != nil {
@@ -1264,6 +1879,56 @@ func (m *DiffFileResponse) GetOldFiles() []*FileInfo {
 	return nil
 }
 
+func (m *DiffFileResponse) GetContentDiffs() []*FileContentDiff {
+	if m != nil {
+		return m.ContentDiffs
+	}
+	return nil
+}
+
+type FsckRequest struct {
+	// If fix is set, problems that can be fixed safely are fixed
+	Fix bool `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+}
+
+func (m *FsckRequest) Reset()                    { *m = FsckRequest{} }
+func (m *FsckRequest) String() string            { return proto.CompactTextString(m) }
+func (*FsckRequest) ProtoMessage()               {}
+func (*FsckRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
+
+func (m *FsckRequest) GetFix() bool {
+	if m != nil {
+		return m.Fix
+	}
+	return false
+}
+
+// FsckResponse describes a problem found by Fsck
+type FsckResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// fixed is set if the problem was fixed
+	Fixed bool `protobuf:"varint,2,opt,name=fixed,proto3" json:"fixed,omitempty"`
+}
+
+func (m *FsckResponse) Reset()                    { *m = FsckResponse{} }
+func (m *FsckResponse) String() string            { return proto.CompactTextString(m) }
+func (*FsckResponse) ProtoMessage()               {}
+func (*FsckResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
+
+func (m *FsckResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FsckResponse) GetFixed() bool {
+	if m != nil {
+		return m.Fixed
+	}
+	return false
+}
+
 type DeleteFileRequest struct {
 	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 }
@@ -1271,7 +1936,7 @@ type DeleteFileRequest struct {
 func (m *DeleteFileRequest) Reset()                    { *m = DeleteFileRequest{} }
 func (m *DeleteFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteFileRequest) ProtoMessage()               {}
-func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{53} }
 
 func (m *DeleteFileRequest) GetFile() *File {
 	if m != nil {
@@ -1288,7 +1953,7 @@ type PutObjectRequest struct {
 func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
 func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{54} }
 
 func (m *PutObjectRequest) GetValue() []byte {
 	if m != nil {
@@ -1313,7 +1978,7 @@ type GetObjectsRequest struct {
 func (m *GetObjectsRequest) Reset()                    { *m = GetObjectsRequest{} }
 func (m *GetObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObjectsRequest) ProtoMessage()               {}
-func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{41} }
+func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{55} }
 
 func (m *GetObjectsRequest) GetObjects() []*Object {
 	if m != nil {
@@ -1344,7 +2009,7 @@ type TagObjectRequest struct {
 func (m *TagObjectRequest) Reset()                    { *m = TagObjectRequest{} }
 func (m *TagObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*TagObjectRequest) ProtoMessage()               {}
-func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
+func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{56} }
 
 func (m *TagObjectRequest) GetObject() *Object {
 	if m != nil {
@@ -1366,7 +2031,7 @@ type ListObjectsRequest struct {
 func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
 func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{57} }
 
 type ListTagsRequest struct {
 	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
@@ -1376,7 +2041,7 @@ type ListTagsRequest struct {
 func (m *ListTagsRequest) Reset()                    { *m = ListTagsRequest{} }
 func (m *ListTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListTagsRequest) ProtoMessage()               {}
-func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
+func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{58} }
 
 func (m *ListTagsRequest) GetPrefix() string {
 	if m != nil {
@@ -1400,7 +2065,7 @@ type ListTagsResponse struct {
 func (m *ListTagsResponse) Reset()                    { *m = ListTagsResponse{} }
 func (m *ListTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListTagsResponse) ProtoMessage()               {}
-func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
+func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{59} }
 
 func (m *ListTagsResponse) GetTag() string {
 	if m != nil {
@@ -1423,7 +2088,7 @@ type DeleteObjectsRequest struct {
 func (m *DeleteObjectsRequest) Reset()                    { *m = DeleteObjectsRequest{} }
 func (m *DeleteObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectsRequest) ProtoMessage()               {}
-func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
+func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{60} }
 
 func (m *DeleteObjectsRequest) GetObjects() []*Object {
 	if m != nil {
@@ -1433,12 +2098,34 @@ func (m *DeleteObjectsRequest) GetObjects() []*Object {
 }
 
 type DeleteObjectsResponse struct {
+	// objects are the objects that were deleted, which excludes the requested
+	// objects that didn't exist.
+	Objects []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+	// bytes is the number of bytes that were deleted from object storage. An
+	// object that compaction packed into a block with other objects only frees
+	// space once all of them have been deleted, at which point the whole block
+	// is counted.
+	Bytes uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
 }
 
 func (m *DeleteObjectsResponse) Reset()                    { *m = DeleteObjectsResponse{} }
 func (m *DeleteObjectsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectsResponse) ProtoMessage()               {}
-func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
+func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{61} }
+
+func (m *DeleteObjectsResponse) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *DeleteObjectsResponse) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
 
 type DeleteTagsRequest struct {
 	Tags []string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
@@ -1447,7 +2134,7 @@ type DeleteTagsRequest struct {
 func (m *DeleteTagsRequest) Reset()                    { *m = DeleteTagsRequest{} }
 func (m *DeleteTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteTagsRequest) ProtoMessage()               {}
-func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
+func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{62} }
 
 func (m *DeleteTagsRequest) GetTags() []string {
 	if m != nil {
@@ -1462,7 +2149,7 @@ type DeleteTagsResponse struct {
 func (m *DeleteTagsResponse) Reset()                    { *m = DeleteTagsResponse{} }
 func (m *DeleteTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteTagsResponse) ProtoMessage()               {}
-func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
+func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{63} }
 
 type CheckObjectRequest struct {
 	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
@@ -1471,7 +2158,7 @@ type CheckObjectRequest struct {
 func (m *CheckObjectRequest) Reset()                    { *m = CheckObjectRequest{} }
 func (m *CheckObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*CheckObjectRequest) ProtoMessage()               {}
-func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
+func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{64} }
 
 func (m *CheckObjectRequest) GetObject() *Object {
 	if m != nil {
@@ -1487,7 +2174,7 @@ type CheckObjectResponse struct {
 func (m *CheckObjectResponse) Reset()                    { *m = CheckObjectResponse{} }
 func (m *CheckObjectResponse) String() string            { return proto.CompactTextString(m) }
 func (*CheckObjectResponse) ProtoMessage()               {}
-func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
+func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{65} }
 
 func (m *CheckObjectResponse) GetExists() bool {
 	if m != nil {
@@ -1504,7 +2191,7 @@ type ObjectIndex struct {
 func (m *ObjectIndex) Reset()                    { *m = ObjectIndex{} }
 func (m *ObjectIndex) String() string            { return proto.CompactTextString(m) }
 func (*ObjectIndex) ProtoMessage()               {}
-func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
+func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{66} }
 
 func (m *ObjectIndex) GetObjects() map[string]*BlockRef {
 	if m != nil {
@@ -1520,6 +2207,82 @@ func (m *ObjectIndex) GetTags() map[string]*Object {
 	return nil
 }
 
+// CompactionInfo describes a compaction of objects. It's also the checkpoint
+// that lets an interrupted compaction resume where it left off.
+type CompactionInfo struct {
+	Running bool                        `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Started *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=started" json:"started,omitempty"`
+	// finished is unset while the compaction is running
+	Finished         *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=finished" json:"finished,omitempty"`
+	ObjectsCompacted uint64                      `protobuf:"varint,4,opt,name=objects_compacted,json=objectsCompacted,proto3" json:"objects_compacted,omitempty"`
+	TagsCompacted    uint64                      `protobuf:"varint,5,opt,name=tags_compacted,json=tagsCompacted,proto3" json:"tags_compacted,omitempty"`
+	IndexesMerged    uint64                      `protobuf:"varint,6,opt,name=indexes_merged,json=indexesMerged,proto3" json:"indexes_merged,omitempty"`
+	BytesRewritten   uint64                      `protobuf:"varint,7,opt,name=bytes_rewritten,json=bytesRewritten,proto3" json:"bytes_rewritten,omitempty"`
+	// error is set if the compaction failed
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *CompactionInfo) Reset()                    { *m = CompactionInfo{} }
+func (m *CompactionInfo) String() string            { return proto.CompactTextString(m) }
+func (*CompactionInfo) ProtoMessage()               {}
+func (*CompactionInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{67} }
+
+func (m *CompactionInfo) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *CompactionInfo) GetStarted() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *CompactionInfo) GetFinished() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+func (m *CompactionInfo) GetObjectsCompacted() uint64 {
+	if m != nil {
+		return m.ObjectsCompacted
+	}
+	return 0
+}
+
+func (m *CompactionInfo) GetTagsCompacted() uint64 {
+	if m != nil {
+		return m.TagsCompacted
+	}
+	return 0
+}
+
+func (m *CompactionInfo) GetIndexesMerged() uint64 {
+	if m != nil {
+		return m.IndexesMerged
+	}
+	return 0
+}
+
+func (m *CompactionInfo) GetBytesRewritten() uint64 {
+	if m != nil {
+		return m.BytesRewritten
+	}
+	return 0
+}
+
+func (m *CompactionInfo) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Repo)(nil), "pfs.Repo")
 	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
@@ -1540,6 +2303,13 @@ func init() {
 	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
 	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
 	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
+	proto.RegisterType((*Quota)(nil), "pfs.Quota")
+	proto.RegisterType((*Usage)(nil), "pfs.Usage")
+	proto.RegisterType((*SetQuotaRequest)(nil), "pfs.SetQuotaRequest")
+	proto.RegisterType((*ListQuotaRequest)(nil), "pfs.ListQuotaRequest")
+	proto.RegisterType((*QuotaInfo)(nil), "pfs.QuotaInfo")
+	proto.RegisterType((*QuotaInfos)(nil), "pfs.QuotaInfos")
+	proto.RegisterType((*ForkRepoRequest)(nil), "pfs.ForkRepoRequest")
 	proto.RegisterType((*StartCommitRequest)(nil), "pfs.StartCommitRequest")
 	proto.RegisterType((*BuildCommitRequest)(nil), "pfs.BuildCommitRequest")
 	proto.RegisterType((*FinishCommitRequest)(nil), "pfs.FinishCommitRequest")
@@ -1556,10 +2326,17 @@ func init() {
 	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
 	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
 	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
+	proto.RegisterType((*ListFileHistoryRequest)(nil), "pfs.ListFileHistoryRequest")
 	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
+	proto.RegisterType((*GrepFileRequest)(nil), "pfs.GrepFileRequest")
+	proto.RegisterType((*GrepFileResponse)(nil), "pfs.GrepFileResponse")
 	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
 	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
+	proto.RegisterType((*JSONKeyDiff)(nil), "pfs.JSONKeyDiff")
+	proto.RegisterType((*FileContentDiff)(nil), "pfs.FileContentDiff")
 	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
+	proto.RegisterType((*FsckRequest)(nil), "pfs.FsckRequest")
+	proto.RegisterType((*FsckResponse)(nil), "pfs.FsckResponse")
 	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
 	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
 	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
@@ -1574,6 +2351,7 @@ func init() {
 	proto.RegisterType((*CheckObjectRequest)(nil), "pfs.CheckObjectRequest")
 	proto.RegisterType((*CheckObjectResponse)(nil), "pfs.CheckObjectResponse")
 	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
+	proto.RegisterType((*CompactionInfo)(nil), "pfs.CompactionInfo")
 	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
 	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
 	proto.RegisterEnum("pfs.ListFileMode", ListFileMode_name, ListFileMode_value)
@@ -1600,6 +2378,15 @@ type APIClient interface {
 	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
 	// DeleteRepo deletes a repo.
 	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ForkRepo creates a new repo whose commits and branches reference the
+	// same trees as those of an existing repo. No data is copied.
+	ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// SetQuota sets the storage quota of a repo or user. If the auth system is
+	// activated, only cluster admins may set quotas.
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ListQuota returns the storage used by every repo and user, along with
+	// their quotas.
+	ListQuota(ctx context.Context, in *ListQuotaRequest, opts ...grpc.CallOption) (*QuotaInfos, error)
 	// Commit rpcs
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
@@ -1634,12 +2421,23 @@ type APIClient interface {
 	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
 	// GlobFile returns info about all files.
 	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
+	// GrepFile returns the lines that match a regular expression in the files
+	// that match a glob pattern.
+	GrepFile(ctx context.Context, in *GrepFileRequest, opts ...grpc.CallOption) (API_GrepFileClient, error)
+	// ListFileHistory returns info about a file in each commit, starting from
+	// the given commit and going back through its ancestors, in which the file
+	// was changed or deleted.
+	ListFileHistory(ctx context.Context, in *ListFileHistoryRequest, opts ...grpc.CallOption) (*FileInfos, error)
 	// DiffFile returns the differences between 2 paths at 2 commits.
 	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
 	// DeleteFile deletes a file.
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// Fsck checks the consistency of every commit, tree and branch, and
+	// returns the problems that it finds. If auth is activated, only cluster
+	// admins may run it.
+	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
 }
 
 type aPIClient struct {
@@ -1686,6 +2484,33 @@ func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts
 	return out, nil
 }
 
+func (c *aPIClient) ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/ForkRepo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/SetQuota", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListQuota(ctx context.Context, in *ListQuotaRequest, opts ...grpc.CallOption) (*QuotaInfos, error) {
+	out := new(QuotaInfos)
+	err := grpc.Invoke(ctx, "/pfs.API/ListQuota", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
 	out := new(Commit)
 	err := grpc.Invoke(ctx, "/pfs.API/StartCommit", in, out, c.cc, opts...)
@@ -1924,6 +2749,47 @@ func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...g
 	return out, nil
 }
 
+func (c *aPIClient) GrepFile(ctx context.Context, in *GrepFileRequest, opts ...grpc.CallOption) (API_GrepFileClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[4], c.cc, "/pfs.API/GrepFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGrepFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_GrepFileClient interface {
+	Recv() (*GrepFileResponse, error)
+	grpc.ClientStream
+}
+
+type aPIGrepFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGrepFileClient) Recv() (*GrepFileResponse, error) {
+	m := new(GrepFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) ListFileHistory(ctx context.Context, in *ListFileHistoryRequest, opts ...grpc.CallOption) (*FileInfos, error) {
+	out := new(FileInfos)
+	err := grpc.Invoke(ctx, "/pfs.API/ListFileHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
 	out := new(DiffFileResponse)
 	err := grpc.Invoke(ctx, "/pfs.API/DiffFile", in, out, c.cc, opts...)
@@ -1951,19 +2817,60 @@ func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, op
 	return out, nil
 }
 
-// Server API for API service
+func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[5], c.cc, "/pfs.API/Fsck", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFsckClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
 
-type APIServer interface {
-	// Repo rpcs
-	// CreateRepo creates a new repo.
-	// An error is returned if the repo already exists.
-	CreateRepo(context.Context, *CreateRepoRequest) (*google_protobuf.Empty, error)
+type API_FsckClient interface {
+	Recv() (*FsckResponse, error)
+	grpc.ClientStream
+}
+
+type aPIFsckClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
+	m := new(FsckResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for API service
+
+type APIServer interface {
+	// Repo rpcs
+	// CreateRepo creates a new repo.
+	// An error is returned if the repo already exists.
+	CreateRepo(context.Context, *CreateRepoRequest) (*google_protobuf.Empty, error)
 	// InspectRepo returns info about a repo.
 	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
 	// ListRepo returns info about all repos.
 	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
 	// DeleteRepo deletes a repo.
 	DeleteRepo(context.Context, *DeleteRepoRequest) (*google_protobuf.Empty, error)
+	// ForkRepo creates a new repo whose commits and branches reference the
+	// same trees as those of an existing repo. No data is copied.
+	ForkRepo(context.Context, *ForkRepoRequest) (*google_protobuf.Empty, error)
+	// SetQuota sets the storage quota of a repo or user. If the auth system is
+	// activated, only cluster admins may set quotas.
+	SetQuota(context.Context, *SetQuotaRequest) (*google_protobuf.Empty, error)
+	// ListQuota returns the storage used by every repo and user, along with
+	// their quotas.
+	ListQuota(context.Context, *ListQuotaRequest) (*QuotaInfos, error)
 	// Commit rpcs
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
@@ -1998,12 +2905,23 @@ type APIServer interface {
 	ListFile(context.Context, *ListFileRequest) (*FileInfos, error)
 	// GlobFile returns info about all files.
 	GlobFile(context.Context, *GlobFileRequest) (*FileInfos, error)
+	// GrepFile returns the lines that match a regular expression in the files
+	// that match a glob pattern.
+	GrepFile(*GrepFileRequest, API_GrepFileServer) error
+	// ListFileHistory returns info about a file in each commit, starting from
+	// the given commit and going back through its ancestors, in which the file
+	// was changed or deleted.
+	ListFileHistory(context.Context, *ListFileHistoryRequest) (*FileInfos, error)
 	// DiffFile returns the differences between 2 paths at 2 commits.
 	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
 	// DeleteFile deletes a file.
 	DeleteFile(context.Context, *DeleteFileRequest) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	// Fsck checks the consistency of every commit, tree and branch, and
+	// returns the problems that it finds. If auth is activated, only cluster
+	// admins may run it.
+	Fsck(*FsckRequest, API_FsckServer) error
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -2082,6 +3000,60 @@ func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ForkRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ForkRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ForkRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ForkRepo(ctx, req.(*ForkRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListQuota(ctx, req.(*ListQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartCommitRequest)
 	if err := dec(in); err != nil {
@@ -2387,6 +3359,45 @@ func _API_GlobFile_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GrepFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GrepFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).GrepFile(m, &aPIGrepFileServer{stream})
+}
+
+type API_GrepFileServer interface {
+	Send(*GrepFileResponse) error
+	grpc.ServerStream
+}
+
+type aPIGrepFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGrepFileServer) Send(m *GrepFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ListFileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListFileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListFileHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListFileHistory(ctx, req.(*ListFileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DiffFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiffFileRequest)
 	if err := dec(in); err != nil {
@@ -2441,6 +3452,27 @@ func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_Fsck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FsckRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Fsck(m, &aPIFsckServer{stream})
+}
+
+type API_FsckServer interface {
+	Send(*FsckResponse) error
+	grpc.ServerStream
+}
+
+type aPIFsckServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFsckServer) Send(m *FsckResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pfs.API",
 	HandlerType: (*APIServer)(nil),
@@ -2461,6 +3493,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepo",
 			Handler:    _API_DeleteRepo_Handler,
 		},
+		{
+			MethodName: "ForkRepo",
+			Handler:    _API_ForkRepo_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _API_SetQuota_Handler,
+		},
+		{
+			MethodName: "ListQuota",
+			Handler:    _API_ListQuota_Handler,
+		},
 		{
 			MethodName: "StartCommit",
 			Handler:    _API_StartCommit_Handler,
@@ -2509,6 +3553,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobFile",
 			Handler:    _API_GlobFile_Handler,
 		},
+		{
+			MethodName: "ListFileHistory",
+			Handler:    _API_ListFileHistory_Handler,
+		},
 		{
 			MethodName: "DiffFile",
 			Handler:    _API_DiffFile_Handler,
@@ -2543,6 +3591,16 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_GetFile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GrepFile",
+			Handler:       _API_GrepFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Fsck",
+			Handler:       _API_Fsck_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "client/pfs/pfs.proto",
 }
@@ -2565,6 +3623,9 @@ type ObjectAPIClient interface {
 	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error)
 	DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error)
 	Compact(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// InspectCompaction returns the progress of the compaction that's running,
+	// or the results of the last one if none is.
+	InspectCompaction(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*CompactionInfo, error)
 }
 
 type objectAPIClient struct {
@@ -2832,6 +3893,15 @@ func (c *objectAPIClient) Compact(ctx context.Context, in *google_protobuf.Empty
 	return out, nil
 }
 
+func (c *objectAPIClient) InspectCompaction(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*CompactionInfo, error) {
+	out := new(CompactionInfo)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/InspectCompaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ObjectAPI service
 
 type ObjectAPIServer interface {
@@ -2850,6 +3920,9 @@ type ObjectAPIServer interface {
 	ListTags(*ListTagsRequest, ObjectAPI_ListTagsServer) error
 	DeleteTags(context.Context, *DeleteTagsRequest) (*DeleteTagsResponse, error)
 	Compact(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	// InspectCompaction returns the progress of the compaction that's running,
+	// or the results of the last one if none is.
+	InspectCompaction(context.Context, *google_protobuf.Empty) (*CompactionInfo, error)
 }
 
 func RegisterObjectAPIServer(s *grpc.Server, srv ObjectAPIServer) {
@@ -3113,6 +4186,24 @@ func _ObjectAPI_Compact_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectAPI_InspectCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).InspectCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/InspectCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).InspectCompaction(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ObjectAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pfs.ObjectAPI",
 	HandlerType: (*ObjectAPIServer)(nil),
@@ -3145,6 +4236,10 @@ var _ObjectAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Compact",
 			Handler:    _ObjectAPI_Compact_Handler,
 		},
+		{
+			MethodName: "InspectCompaction",
+			Handler:    _ObjectAPI_InspectCompaction_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3438,6 +4533,39 @@ func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Scope))
 	}
+	if m.FileCount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
+	}
+	if len(m.UserUsage) > 0 {
+		for k, _ := range m.UserUsage {
+			dAtA[i] = 0x42
+			i++
+			v := m.UserUsage[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n5, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n5
+			}
+		}
+	}
 	return i, nil
 }
 
@@ -3460,11 +4588,11 @@ func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n5, err := m.Repo.MarshalTo(dAtA[i:])
+		n6, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if len(m.ID) > 0 {
 		dAtA[i] = 0x12
@@ -3494,41 +4622,41 @@ func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n6, err := m.Commit.MarshalTo(dAtA[i:])
+		n7, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.ParentCommit != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.ParentCommit.Size()))
-		n7, err := m.ParentCommit.MarshalTo(dAtA[i:])
+		n8, err := m.ParentCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.Started != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
-		n8, err := m.Started.MarshalTo(dAtA[i:])
+		n9, err := m.Started.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if m.Finished != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
-		n9, err := m.Finished.MarshalTo(dAtA[i:])
+		n10, err := m.Finished.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	if m.SizeBytes != 0 {
 		dAtA[i] = 0x28
@@ -3551,11 +4679,33 @@ func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n10, err := m.Tree.MarshalTo(dAtA[i:])
+		n11, err := m.Tree.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.Author) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Author)))
+		i += copy(dAtA[i:], m.Author)
+	}
+	if m.Usage != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Usage.Size()))
+		n12, err := m.Usage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
 	}
 	return i, nil
 }
@@ -3579,11 +4729,11 @@ func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n11, err := m.File.MarshalTo(dAtA[i:])
+		n13, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	if m.FileType != 0 {
 		dAtA[i] = 0x10
@@ -3628,6 +4778,37 @@ func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Mode != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mtime.Size()))
+		n14, err := m.Mtime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
+	}
+	if m.Deleted {
+		dAtA[i] = 0x60
+		i++
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -3678,21 +4859,21 @@ func (m *BlockRef) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
-		n12, err := m.Block.MarshalTo(dAtA[i:])
+		n15, err := m.Block.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n15
 	}
 	if m.Range != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Range.Size()))
-		n13, err := m.Range.MarshalTo(dAtA[i:])
+		n16, err := m.Range.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n16
 	}
 	return i, nil
 }
@@ -3716,21 +4897,21 @@ func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n14, err := m.Object.MarshalTo(dAtA[i:])
+		n17, err := m.Object.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n17
 	}
 	if m.BlockRef != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.BlockRef.Size()))
-		n15, err := m.BlockRef.MarshalTo(dAtA[i:])
+		n18, err := m.BlockRef.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	return i, nil
 }
@@ -3754,11 +4935,11 @@ func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n16, err := m.Repo.MarshalTo(dAtA[i:])
+		n19, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n19
 	}
 	if len(m.Provenance) > 0 {
 		for _, msg := range m.Provenance {
@@ -3810,11 +4991,11 @@ func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n17, err := m.Repo.MarshalTo(dAtA[i:])
+		n20, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n20
 	}
 	if m.IncludeAuth {
 		dAtA[i] = 0x10
@@ -3866,6 +5047,17 @@ func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
 	return i, nil
 }
 
@@ -3896,6 +5088,12 @@ func (m *ListRepoResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
 	return i, nil
 }
 
@@ -3918,11 +5116,11 @@ func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n18, err := m.Repo.MarshalTo(dAtA[i:])
+		n21, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if m.Force {
 		dAtA[i] = 0x10
@@ -3947,7 +5145,7 @@ func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *Quota) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3957,43 +5155,25 @@ func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Parent != nil {
-		dAtA[i] = 0xa
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n19, err := m.Parent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
-	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x1a
+	if m.FileCount != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
 	}
 	return i, nil
 }
 
-func (m *BuildCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *Usage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4003,53 +5183,25 @@ func (m *BuildCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Usage) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Parent != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n20, err := m.Parent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
-	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Tree != nil {
-		dAtA[i] = 0x1a
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n21, err := m.Tree.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x22
+	if m.FileCount != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
 	}
 	return i, nil
 }
 
-func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetQuotaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4059,25 +5211,41 @@ func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n22, err := m.Commit.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n22, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n22
 	}
+	if len(m.User) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.User)))
+		i += copy(dAtA[i:], m.User)
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n23, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
 	return i, nil
 }
 
-func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListQuotaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4087,25 +5255,15 @@ func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n23, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
-	}
 	return i, nil
 }
 
-func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuotaInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4115,7 +5273,7 @@ func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuotaInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4130,35 +5288,36 @@ func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n24
 	}
-	if m.From != nil {
+	if len(m.User) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n25, err := m.From.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.User)))
+		i += copy(dAtA[i:], m.User)
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n25, err := m.Quota.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n25
 	}
-	if m.To != nil {
-		dAtA[i] = 0x1a
+	if m.Usage != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
-		n26, err := m.To.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Usage.Size()))
+		n26, err := m.Usage.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n26
 	}
-	if m.Number != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
-	}
 	return i, nil
 }
 
-func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
+func (m *QuotaInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4168,13 +5327,13 @@ func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuotaInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.CommitInfo) > 0 {
-		for _, msg := range m.CommitInfo {
+	if len(m.QuotaInfo) > 0 {
+		for _, msg := range m.QuotaInfo {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
@@ -4188,7 +5347,7 @@ func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *ForkRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4198,25 +5357,41 @@ func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ForkRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
+	if m.Src != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n27, err := m.Repo.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
+		n27, err := m.Src.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n27
 	}
+	if m.Dst != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
+		n28, err := m.Dst.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
 	return i, nil
 }
 
-func (m *SetBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4226,31 +5401,49 @@ func (m *SetBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
+	if m.Parent != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n28, err := m.Commit.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
+		n29, err := m.Parent.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n29
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
 		i += copy(dAtA[i:], m.Branch)
 	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
 	return i, nil
 }
 
-func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *BuildCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4260,31 +5453,71 @@ func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
+	if m.Parent != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n29, err := m.Repo.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
+		n30, err := m.Parent.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Tree != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n31, err := m.Tree.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
 	}
 	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
 		i += copy(dAtA[i:], m.Branch)
 	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Author) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Author)))
+		i += copy(dAtA[i:], m.Author)
+	}
 	return i, nil
 }
 
-func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4294,7 +5527,7 @@ func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4303,16 +5536,22 @@ func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n30, err := m.Commit.MarshalTo(dAtA[i:])
+		n32, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n32
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
 	return i, nil
 }
 
-func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4322,39 +5561,25 @@ func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
-		for _, msg := range m.Commits {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.ToRepos) > 0 {
-		for _, msg := range m.ToRepos {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n33, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n33
 	}
 	return i, nil
 }
 
-func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4364,7 +5589,7 @@ func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4373,32 +5598,47 @@ func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n31, err := m.Repo.MarshalTo(dAtA[i:])
+		n34, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n34
 	}
-	if len(m.Branch) > 0 {
+	if m.From != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n35, err := m.From.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
-	if m.From != nil {
+	if m.To != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n32, err := m.From.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
+		n36, err := m.To.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n36
+	}
+	if m.Number != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
 	}
 	return i, nil
 }
 
-func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4408,35 +5648,33 @@ func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n33, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.CommitInfo) > 0 {
+		for _, msg := range m.CommitInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n33
-	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
 	}
 	return i, nil
 }
 
-func (m *PutFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4446,72 +5684,25 @@ func (m *PutFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n34, err := m.File.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n37, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if len(m.Url) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
-		i += copy(dAtA[i:], m.Url)
-	}
-	if m.Recursive {
-		dAtA[i] = 0x30
-		i++
-		if m.Recursive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Delimiter != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Delimiter))
-	}
-	if m.TargetFileDatums != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileDatums))
-	}
-	if m.TargetFileBytes != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileBytes))
-	}
-	if m.Overwrite {
-		dAtA[i] = 0x50
-		i++
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i += n37
 	}
 	return i, nil
 }
 
-func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4521,25 +5712,31 @@ func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
+	if m.Commit != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n35, err := m.File.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n38, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n38
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
 	return i, nil
 }
 
-func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4549,35 +5746,31 @@ func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n36, err := m.File.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n39, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n39
 	}
-	if m.Full {
-		dAtA[i] = 0x10
-		i++
-		if m.Full {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
 		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
 	return i, nil
 }
 
-func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4587,7 +5780,7 @@ func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4596,22 +5789,16 @@ func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n37, err := m.Commit.MarshalTo(dAtA[i:])
+		n40, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
-	}
-	if len(m.Pattern) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
-		i += copy(dAtA[i:], m.Pattern)
+		i += n40
 	}
 	return i, nil
 }
 
-func (m *FileInfos) Marshal() (dAtA []byte, err error) {
+func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4621,13 +5808,13 @@ func (m *FileInfos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FileInfos) MarshalTo(dAtA []byte) (int, error) {
+func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.FileInfo) > 0 {
-		for _, msg := range m.FileInfo {
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
@@ -4638,10 +5825,22 @@ func (m *FileInfos) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.ToRepos) > 0 {
+		for _, msg := range m.ToRepos {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4651,45 +5850,41 @@ func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.NewFile != nil {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
-		n38, err := m.NewFile.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n41, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n41
 	}
-	if m.OldFile != nil {
+	if len(m.Branch) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
-		n39, err := m.OldFile.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n39
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.Shallow {
-		dAtA[i] = 0x18
+	if m.From != nil {
+		dAtA[i] = 0x1a
 		i++
-		if m.Shallow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n42, err := m.From.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		i++
+		i += n42
 	}
 	return i, nil
 }
 
-func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4699,39 +5894,35 @@ func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewFiles) > 0 {
-		for _, msg := range m.NewFiles {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n43, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n43
 	}
-	if len(m.OldFiles) > 0 {
-		for _, msg := range m.OldFiles {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
 	return i, nil
 }
 
-func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *PutFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4741,7 +5932,7 @@ func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4750,132 +5941,84 @@ func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n40, err := m.File.MarshalTo(dAtA[i:])
+		n44, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
-	}
-	return i, nil
-}
-
-func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i += n44
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
 	if len(m.Value) > 0 {
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
 		i += copy(dAtA[i:], m.Value)
 	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if len(m.Url) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
+		i += copy(dAtA[i:], m.Url)
+	}
+	if m.Recursive {
+		dAtA[i] = 0x30
+		i++
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return i, nil
-}
-
-func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Delimiter != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Delimiter))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.TargetFileDatums != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileDatums))
 	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
+	if m.TargetFileBytes != 0 {
+		dAtA[i] = 0x48
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileBytes))
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
+	if m.Overwrite {
+		dAtA[i] = 0x50
+		i++
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
-	return i, nil
-}
-
-func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Mode != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0xa
+	if m.Mtime != nil {
+		dAtA[i] = 0x62
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n41, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mtime.Size()))
+		n45, err := m.Mtime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n45
 	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
 	}
 	return i, nil
 }
 
-func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4885,15 +6028,25 @@ func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n46, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
 	return i, nil
 }
 
-func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4903,21 +6056,25 @@ func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prefix) > 0 {
+	if m.File != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
-		i += copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n47, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
 	}
-	if m.IncludeObject {
+	if m.Full {
 		dAtA[i] = 0x10
 		i++
-		if m.IncludeObject {
+		if m.Full {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -4927,7 +6084,7 @@ func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListFileHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4937,31 +6094,30 @@ func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListFileHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tag) > 0 {
+	if m.File != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
-		i += copy(dAtA[i:], m.Tag)
-	}
-	if m.Object != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n42, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n48, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n48
+	}
+	if m.N != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.N))
 	}
 	return i, nil
 }
 
-func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4971,27 +6127,31 @@ func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n49, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n49
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
 	}
 	return i, nil
 }
 
-func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GrepFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5001,15 +6161,42 @@ func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GrepFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n50, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if len(m.Regex) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Regex)))
+		i += copy(dAtA[i:], m.Regex)
+	}
+	if m.MaxMatches != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxMatches))
+	}
 	return i, nil
 }
 
-func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GrepFileResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5019,30 +6206,36 @@ func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GrepFileResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n51, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n51
+	}
+	if m.LineNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.LineNumber))
+	}
+	if len(m.Line) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Line)))
+		i += copy(dAtA[i:], m.Line)
 	}
 	return i, nil
 }
 
-func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *FileInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5052,15 +6245,27 @@ func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.FileInfo) > 0 {
+		for _, msg := range m.FileInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5070,25 +6275,60 @@ func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
+	if m.NewFile != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n43, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
+		n52, err := m.NewFile.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n52
 	}
-	return i, nil
-}
-
-func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+	if m.OldFile != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
+		n53, err := m.OldFile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if m.Shallow {
+		dAtA[i] = 0x18
+		i++
+		if m.Shallow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Content {
+		dAtA[i] = 0x20
+		i++
+		if m.Content {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ContentSizeLimit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ContentSizeLimit))
+	}
+	return i, nil
+}
+
+func (m *JSONKeyDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5098,25 +6338,33 @@ func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONKeyDiff) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Exists {
-		dAtA[i] = 0x8
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
 		i++
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.OldValue) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.OldValue)))
+		i += copy(dAtA[i:], m.OldValue)
+	}
+	if len(m.NewValue) > 0 {
+		dAtA[i] = 0x1a
 		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.NewValue)))
+		i += copy(dAtA[i:], m.NewValue)
 	}
 	return i, nil
 }
 
-func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+func (m *FileContentDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5126,870 +6374,1838 @@ func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileContentDiff) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for k, _ := range m.Objects {
-			dAtA[i] = 0xa
+	if len(m.NewPath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.NewPath)))
+		i += copy(dAtA[i:], m.NewPath)
+	}
+	if len(m.OldPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.OldPath)))
+		i += copy(dAtA[i:], m.OldPath)
+	}
+	if len(m.UnifiedDiff) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.UnifiedDiff)))
+		i += copy(dAtA[i:], m.UnifiedDiff)
+	}
+	if len(m.JsonDiffs) > 0 {
+		for _, msg := range m.JsonDiffs {
+			dAtA[i] = 0x22
 			i++
-			v := m.Objects[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFiles) > 0 {
+		for _, msg := range m.NewFiles {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n44, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n44
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
+			i += n
 		}
 	}
-	if len(m.Tags) > 0 {
-		for k, _ := range m.Tags {
+	if len(m.OldFiles) > 0 {
+		for _, msg := range m.OldFiles {
 			dAtA[i] = 0x12
 			i++
-			v := m.Tags[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
+			i += n
+		}
+	}
+	if len(m.ContentDiffs) > 0 {
+		for _, msg := range m.ContentDiffs {
+			dAtA[i] = 0x1a
 			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n45, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n45
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
+			i += n
 		}
 	}
 	return i, nil
 }
 
-func encodeFixed64Pfs(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Pfs(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Repo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *FsckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BranchInfo) Size() (n int) {
+func (m *FsckRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Fix {
+		dAtA[i] = 0x8
+		i++
+		if m.Fix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *BranchInfos) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.BranchInfo) > 0 {
-		for _, e := range m.BranchInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+func (m *FsckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *File) Size() (n int) {
+func (m *FsckResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Fixed {
+		dAtA[i] = 0x10
+		i++
+		if m.Fixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *Block) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Object) Size() (n int) {
+func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n54, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
 	}
-	return n
+	return i, nil
 }
 
-func (m *Tag) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RepoInfo) Size() (n int) {
+func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Scope != 0 {
-		n += 1 + sovPfs(uint64(m.Scope))
-	}
-	return n
+	return i, nil
 }
 
-func (m *Commit) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitInfo) Size() (n int) {
+func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.ParentCommit != nil {
-		l = m.ParentCommit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
 	}
 	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
-	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	return i, nil
+}
+
+func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FileInfo) Size() (n int) {
+func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.FileType != 0 {
-		n += 1 + sovPfs(uint64(m.FileType))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if len(m.Children) > 0 {
-		for _, s := range m.Children {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n55, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n55
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *ByteRange) Size() (n int) {
-	var l int
-	_ = l
-	if m.Lower != 0 {
-		n += 1 + sovPfs(uint64(m.Lower))
-	}
-	if m.Upper != 0 {
-		n += 1 + sovPfs(uint64(m.Upper))
+func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BlockRef) Size() (n int) {
+func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *ObjectInfo) Size() (n int) {
-	var l int
-	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.BlockRef != nil {
-		l = m.BlockRef.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateRepoRequest) Size() (n int) {
+func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.IncludeObject {
+		dAtA[i] = 0x10
+		i++
+		if m.IncludeObject {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Update {
-		n += 2
-	}
-	return n
+	return i, nil
 }
 
-func (m *InspectRepoRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.IncludeAuth {
-		n += 2
+func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListRepoRequest) Size() (n int) {
+func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Tag) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
+		i += copy(dAtA[i:], m.Tag)
+	}
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n56, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n56
 	}
-	if m.IncludeAuth {
-		n += 2
+	return i, nil
+}
+
+func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListRepoResponse) Size() (n int) {
+func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.RepoInfo) > 0 {
-		for _, e := range m.RepoInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteRepoRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Force {
-		n += 2
-	}
-	if m.All {
-		n += 2
+func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StartCommitRequest) Size() (n int) {
+func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Bytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Bytes))
 	}
-	return n
+	return i, nil
 }
 
-func (m *BuildCommitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FinishCommitRequest) Size() (n int) {
+func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectCommitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListCommitRequest) Size() (n int) {
+func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Number != 0 {
-		n += 1 + sovPfs(uint64(m.Number))
+	return i, nil
+}
+
+func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitInfos) Size() (n int) {
+func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.CommitInfo) > 0 {
-		for _, e := range m.CommitInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n57, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n57
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListBranchRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetBranchRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		dAtA[i] = 0x8
+		i++
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	return i, nil
+}
+
+func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteBranchRequest) Size() (n int) {
+func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Objects) > 0 {
+		for k, _ := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			v := m.Objects[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n58, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n58
+			}
+		}
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Tags) > 0 {
+		for k, _ := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			v := m.Tags[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n59, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n59
+			}
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteCommitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *CompactionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FlushCommitRequest) Size() (n int) {
+func (m *CompactionInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Running {
+		dAtA[i] = 0x8
+		i++
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.ToRepos) > 0 {
-		for _, e := range m.ToRepos {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Started != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
+		n60, err := m.Started.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n60
 	}
-	return n
+	if m.Finished != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
+		n61, err := m.Finished.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
+	}
+	if m.ObjectsCompacted != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ObjectsCompacted))
+	}
+	if m.TagsCompacted != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TagsCompacted))
+	}
+	if m.IndexesMerged != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.IndexesMerged))
+	}
+	if m.BytesRewritten != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.BytesRewritten))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
 }
 
-func (m *SubscribeCommitRequest) Size() (n int) {
+func encodeFixed64Pfs(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Pfs(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Repo) Size() (n int) {
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	l = len(m.Branch)
+	return n
+}
+
+func (m *BranchInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.From != nil {
-		l = m.From.Size()
+	if m.Head != nil {
+		l = m.Head.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
 }
 
-func (m *GetFileRequest) Size() (n int) {
+func (m *BranchInfos) Size() (n int) {
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	if len(m.BranchInfo) > 0 {
+		for _, e := range m.BranchInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *PutFileRequest) Size() (n int) {
+func (m *File) Size() (n int) {
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
+	if m.Commit != nil {
+		l = m.Commit.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	l = len(m.Url)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Recursive {
-		n += 2
-	}
-	if m.Delimiter != 0 {
-		n += 1 + sovPfs(uint64(m.Delimiter))
-	}
-	if m.TargetFileDatums != 0 {
-		n += 1 + sovPfs(uint64(m.TargetFileDatums))
-	}
-	if m.TargetFileBytes != 0 {
-		n += 1 + sovPfs(uint64(m.TargetFileBytes))
-	}
-	if m.Overwrite {
-		n += 2
-	}
 	return n
 }
 
-func (m *InspectFileRequest) Size() (n int) {
+func (m *Block) Size() (n int) {
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
+	l = len(m.Hash)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
 }
 
-func (m *ListFileRequest) Size() (n int) {
+func (m *Object) Size() (n int) {
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
+	l = len(m.Hash)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Full {
-		n += 2
-	}
 	return n
 }
 
-func (m *GlobFileRequest) Size() (n int) {
+func (m *Tag) Size() (n int) {
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Pattern)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
 }
 
-func (m *FileInfos) Size() (n int) {
+func (m *RepoInfo) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.FileInfo) > 0 {
-		for _, e := range m.FileInfo {
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
 			l = e.Size()
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovPfs(uint64(m.Scope))
+	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
+	}
+	if len(m.UserUsage) > 0 {
+		for k, v := range m.UserUsage {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *DiffFileRequest) Size() (n int) {
+func (m *Commit) Size() (n int) {
 	var l int
 	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
+	if m.Repo != nil {
+		l = m.Repo.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.OldFile != nil {
-		l = m.OldFile.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Shallow {
-		n += 2
-	}
 	return n
 }
 
-func (m *DiffFileResponse) Size() (n int) {
+func (m *CommitInfo) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.NewFiles) > 0 {
-		for _, e := range m.NewFiles {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
 	}
-	if len(m.OldFiles) > 0 {
-		for _, e := range m.OldFiles {
+	if m.ParentCommit != nil {
+		l = m.ParentCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
 			l = e.Size()
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
-func (m *DeleteFileRequest) Size() (n int) {
+func (m *FileInfo) Size() (n int) {
 	var l int
 	_ = l
 	if m.File != nil {
 		l = m.File.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	return n
-}
-
-func (m *PutObjectRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Value)
+	if m.FileType != 0 {
+		n += 1 + sovPfs(uint64(m.FileType))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if len(m.Children) > 0 {
+		for _, s := range m.Children {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Hash)
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
 			l = e.Size()
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		l = m.Mtime.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
-func (m *GetObjectsRequest) Size() (n int) {
+func (m *ByteRange) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	if m.Lower != 0 {
+		n += 1 + sovPfs(uint64(m.Lower))
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	if m.Upper != 0 {
+		n += 1 + sovPfs(uint64(m.Upper))
 	}
 	return n
 }
 
-func (m *TagObjectRequest) Size() (n int) {
+func (m *BlockRef) Size() (n int) {
 	var l int
 	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
+	if m.Block != nil {
+		l = m.Block.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
 }
 
-func (m *ListObjectsRequest) Size() (n int) {
+func (m *ObjectInfo) Size() (n int) {
 	var l int
 	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BlockRef != nil {
+		l = m.BlockRef.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
-func (m *ListTagsRequest) Size() (n int) {
+func (m *CreateRepoRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Prefix)
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.IncludeObject {
+	if m.Update {
 		n += 2
 	}
 	return n
 }
 
-func (m *ListTagsResponse) Size() (n int) {
+func (m *InspectRepoRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Tag)
-	if l > 0 {
+	if m.Repo != nil {
+		l = m.Repo.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.IncludeAuth {
+		n += 2
 	}
 	return n
 }
 
-func (m *DeleteObjectsRequest) Size() (n int) {
+func (m *ListRepoRequest) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
 			l = e.Size()
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if m.IncludeAuth {
+		n += 2
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovPfs(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
-func (m *DeleteObjectsResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *DeleteTagsRequest) Size() (n int) {
+func (m *ListRepoResponse) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
+	if len(m.RepoInfo) > 0 {
+		for _, e := range m.RepoInfo {
+			l = e.Size()
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
-func (m *DeleteTagsResponse) Size() (n int) {
+func (m *DeleteRepoRequest) Size() (n int) {
 	var l int
 	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.All {
+		n += 2
+	}
 	return n
 }
 
-func (m *CheckObjectRequest) Size() (n int) {
+func (m *Quota) Size() (n int) {
 	var l int
 	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
 	}
 	return n
 }
 
-func (m *CheckObjectResponse) Size() (n int) {
+func (m *Usage) Size() (n int) {
 	var l int
 	_ = l
-	if m.Exists {
-		n += 2
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
 	}
 	return n
 }
 
-func (m *ObjectIndex) Size() (n int) {
+func (m *SetQuotaRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListQuotaRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuotaInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *QuotaInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.QuotaInfo) > 0 {
+		for _, e := range m.QuotaInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForkRepoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Dst != nil {
+		l = m.Dst.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *StartCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *BuildCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FinishCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *InspectCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.CommitInfo) > 0 {
+		for _, e := range m.CommitInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FlushCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.ToRepos) > 0 {
+		for _, e := range m.ToRepos {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	return n
+}
+
+func (m *PutFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	if m.Delimiter != 0 {
+		n += 1 + sovPfs(uint64(m.Delimiter))
+	}
+	if m.TargetFileDatums != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileDatums))
+	}
+	if m.TargetFileBytes != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileBytes))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		l = m.Mtime.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *InspectFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Full {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListFileHistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.N != 0 {
+		n += 1 + sovPfs(uint64(m.N))
+	}
+	return n
+}
+
+func (m *GlobFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *GrepFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Regex)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.MaxMatches != 0 {
+		n += 1 + sovPfs(uint64(m.MaxMatches))
+	}
+	return n
+}
+
+func (m *GrepFileResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.LineNumber != 0 {
+		n += 1 + sovPfs(uint64(m.LineNumber))
+	}
+	l = len(m.Line)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FileInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.FileInfo) > 0 {
+		for _, e := range m.FileInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DiffFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Shallow {
+		n += 2
+	}
+	if m.Content {
+		n += 2
+	}
+	if m.ContentSizeLimit != 0 {
+		n += 1 + sovPfs(uint64(m.ContentSizeLimit))
+	}
+	return n
+}
+
+func (m *JSONKeyDiff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FileContentDiff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NewPath)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.OldPath)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.UnifiedDiff)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.JsonDiffs) > 0 {
+		for _, e := range m.JsonDiffs {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DiffFileResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.NewFiles) > 0 {
+		for _, e := range m.NewFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.OldFiles) > 0 {
+		for _, e := range m.OldFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.ContentDiffs) > 0 {
+		for _, e := range m.ContentDiffs {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FsckRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Fix {
+		n += 2
+	}
+	return n
+}
+
+func (m *FsckResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Fixed {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *PutObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	return n
+}
+
+func (m *TagObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListTagsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.IncludeObject {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListTagsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteObjectsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovPfs(uint64(m.Bytes))
+	}
+	return n
+}
+
+func (m *DeleteTagsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteTagsResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CheckObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckObjectResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	return n
+}
+
+func (m *ObjectIndex) Size() (n int) {
 	var l int
 	_ = l
 	if len(m.Objects) > 0 {
@@ -6001,40 +8217,2312 @@ func (m *ObjectIndex) Size() (n int) {
 				l = v.Size()
 				l += 1 + sovPfs(uint64(l))
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Tags) > 0 {
-		for k, v := range m.Tags {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *CompactionInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Running {
+		n += 2
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ObjectsCompacted != 0 {
+		n += 1 + sovPfs(uint64(m.ObjectsCompacted))
+	}
+	if m.TagsCompacted != 0 {
+		n += 1 + sovPfs(uint64(m.TagsCompacted))
+	}
+	if m.IndexesMerged != 0 {
+		n += 1 + sovPfs(uint64(m.IndexesMerged))
+	}
+	if m.BytesRewritten != 0 {
+		n += 1 + sovPfs(uint64(m.BytesRewritten))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func sovPfs(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPfs(x uint64) (n int) {
+	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Repo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
+			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *File) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= (auth.Scope(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.UserUsage == nil {
+				m.UserUsage = make(map[string]*Usage)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthPfs
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthPfs
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Usage{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.UserUsage[mapkey] = mapvalue
+			} else {
+				var mapvalue *Usage
+				m.UserUsage[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &Usage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			}
+			m.FileType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileType |= (FileType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mtime == nil {
+				m.Mtime = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Mtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByteRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			m.Lower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lower |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			m.Upper = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upper |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &ByteRange{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRef == nil {
+				m.BlockRef = &BlockRef{}
+			}
+			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovPfs(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozPfs(x uint64) (n int) {
-	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Repo) Unmarshal(dAtA []byte) error {
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6057,17 +10545,17 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6077,21 +10565,45 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeAuth = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -6113,7 +10625,7 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6136,17 +10648,17 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6156,26 +10668,67 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeAuth = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6185,24 +10738,20 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6225,7 +10774,7 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6248,15 +10797,15 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6276,14 +10825,43 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
+			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
-			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6306,7 +10884,7 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *File) Unmarshal(dAtA []byte) error {
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6329,15 +10907,15 @@ func (m *File) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6361,18 +10939,18 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6382,21 +10960,32 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -6418,7 +11007,7 @@ func (m *File) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *Quota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6441,17 +11030,17 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6461,21 +11050,30 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -6497,7 +11095,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Object) Unmarshal(dAtA []byte) error {
+func (m *Usage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6520,17 +11118,17 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+			return fmt.Errorf("proto: Usage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Usage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6540,21 +11138,30 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -6576,7 +11183,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tag) Unmarshal(dAtA []byte) error {
+func (m *SetQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6599,15 +11206,48 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6632,7 +11272,40 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6655,7 +11328,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *ListQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6678,48 +11351,65 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6743,18 +11433,18 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &google_protobuf1.Timestamp{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.SizeBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6764,14 +11454,24 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6795,16 +11495,18 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6814,40 +11516,25 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			if m.Usage == nil {
+				m.Usage = &Usage{}
 			}
-			m.Scope = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Scope |= (auth.Scope(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -6869,7 +11556,7 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *QuotaInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6892,15 +11579,15 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6924,42 +11611,11 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuotaInfo = append(m.QuotaInfo, &QuotaInfo{})
+			if err := m.QuotaInfo[len(m.QuotaInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -6981,7 +11637,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *ForkRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7004,15 +11660,15 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForkRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForkRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7036,16 +11692,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Src == nil {
+				m.Src = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7069,18 +11725,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
+			if m.Dst == nil {
+				m.Dst = &Repo{}
 			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7090,28 +11746,74 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7135,18 +11837,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &google_protobuf1.Timestamp{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7156,16 +11858,28 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7175,28 +11889,26 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7206,24 +11918,20 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
-			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7246,7 +11954,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7269,15 +11977,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7301,18 +12009,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			m.FileType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7322,16 +12030,28 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileType |= (FileType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7341,14 +12061,28 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7373,13 +12107,13 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7389,28 +12123,26 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7420,22 +12152,49 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7458,7 +12217,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ByteRange) Unmarshal(dAtA []byte) error {
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7481,17 +12240,17 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			m.Lower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7501,16 +12260,30 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lower |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.Upper = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7520,11 +12293,21 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Upper |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7546,7 +12329,7 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRef) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7569,48 +12352,15 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7634,10 +12384,10 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &ByteRange{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7662,7 +12412,7 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7675,25 +12425,58 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7717,16 +12500,16 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7750,13 +12533,61 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRef == nil {
-				m.BlockRef = &BlockRef{}
+			if m.To == nil {
+				m.To = &Commit{}
 			}
-			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7778,7 +12609,7 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7801,15 +12632,15 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7833,47 +12664,14 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
+			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7898,28 +12696,8 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7941,7 +12719,7 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7964,10 +12742,10 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8003,26 +12781,6 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeAuth", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeAuth = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8044,7 +12802,7 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8067,15 +12825,15 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8099,16 +12857,18 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeAuth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8118,12 +12878,21 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeAuth = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8145,7 +12914,7 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8168,15 +12937,15 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8200,11 +12969,42 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
-			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8226,7 +13026,7 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8249,15 +13049,15 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8281,53 +13081,13 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8349,7 +13109,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8372,15 +13132,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8404,16 +13164,14 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
-			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8437,40 +13195,11 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ToRepos = append(m.ToRepos, &Repo{})
+			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8492,7 +13221,7 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8515,15 +13244,15 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8547,18 +13276,18 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8568,26 +13297,24 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8611,42 +13338,13 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8668,7 +13366,7 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8691,15 +13389,15 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8723,68 +13421,37 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.OffsetBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var msglen int
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8794,25 +13461,11 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8834,7 +13487,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8857,15 +13510,15 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8889,18 +13542,18 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8910,30 +13563,28 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8943,30 +13594,46 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &Commit{}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+			m.Recursive = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
-			m.Number = 0
+			m.Delimiter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8976,64 +13643,91 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= (uint64(b) & 0x7F) << shift
+				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			m.TargetFileDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			m.TargetFileBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Overwrite = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9057,11 +13751,42 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
-			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Mtime == nil {
+				m.Mtime = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Mtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9083,7 +13808,7 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9106,15 +13831,15 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9138,10 +13863,10 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9166,7 +13891,7 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9189,15 +13914,15 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9221,18 +13946,18 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9242,21 +13967,12 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9278,7 +13994,7 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFileHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9301,15 +14017,15 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFileHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFileHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9333,18 +14049,18 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
 			}
-			var stringLen uint64
+			m.N = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9354,21 +14070,11 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.N |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9390,7 +14096,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9413,10 +14119,10 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9452,6 +14158,35 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9473,7 +14208,7 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GrepFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9496,15 +14231,15 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrepFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrepFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9528,16 +14263,18 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9547,23 +14284,69 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToRepos = append(m.ToRepos, &Repo{})
-			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMatches", wireType)
+			}
+			m.MaxMatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMatches |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9585,7 +14368,7 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GrepFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9608,15 +14391,15 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrepFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrepFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9640,18 +14423,18 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineNumber", wireType)
 			}
-			var stringLen uint64
+			m.LineNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9661,26 +14444,16 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.LineNumber |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9690,24 +14463,20 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Line = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9730,7 +14499,7 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+func (m *FileInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9753,15 +14522,15 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9785,51 +14554,11 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FileInfo = append(m.FileInfo, &FileInfo{})
+			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
-			}
-			m.OffsetBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OffsetBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9851,7 +14580,7 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9874,15 +14603,15 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9906,18 +14635,18 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.NewFile == nil {
+				m.NewFile = &File{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9927,55 +14656,28 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
+			if m.OldFile == nil {
+				m.OldFile = &File{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9992,50 +14694,12 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Recursive = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
-			}
-			m.Delimiter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
-			}
-			m.TargetFileDatums = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+			m.Shallow = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			m.TargetFileBytes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10045,16 +14709,17 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			m.Content = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSizeLimit", wireType)
 			}
-			var v int
+			m.ContentSizeLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10064,12 +14729,11 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.ContentSizeLimit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10091,7 +14755,7 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
+func (m *JSONKeyDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10114,17 +14778,17 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONKeyDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONKeyDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10134,24 +14798,78 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10174,7 +14892,7 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+func (m *FileContentDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10197,17 +14915,17 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileContentDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileContentDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10217,30 +14935,84 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			m.NewPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPath", wireType)
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnifiedDiff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.UnifiedDiff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonDiffs", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10250,12 +15022,23 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Full = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonDiffs = append(m.JsonDiffs, &JSONKeyDiff{})
+			if err := m.JsonDiffs[len(m.JsonDiffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10277,7 +15060,7 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10300,15 +15083,15 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10332,18 +15115,16 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewFiles = append(m.NewFiles, &FileInfo{})
+			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10353,74 +15134,26 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
+			m.OldFiles = append(m.OldFiles, &FileInfo{})
+			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FileInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDiffs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10444,8 +15177,8 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileInfo = append(m.FileInfo, &FileInfo{})
-			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContentDiffs = append(m.ContentDiffs, &FileContentDiff{})
+			if err := m.ContentDiffs[len(m.ContentDiffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10470,7 +15203,7 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
+func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10493,81 +15226,15 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FsckRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FsckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NewFile == nil {
-				m.NewFile = &File{}
-			}
-			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OldFile == nil {
-				m.OldFile = &File{}
-			}
-			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10584,7 +15251,7 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Shallow = bool(v != 0)
+			m.Fix = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10606,7 +15273,7 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10629,17 +15296,17 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FsckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FsckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10649,28 +15316,26 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFiles = append(m.NewFiles, &FileInfo{})
-			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10680,23 +15345,12 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OldFiles = append(m.OldFiles, &FileInfo{})
-			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Fixed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11498,25 +16152,75 @@ func (m *DeleteObjectsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowPfs
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteObjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteObjectsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12112,6 +16816,247 @@ func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CompactionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Running = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsCompacted", wireType)
+			}
+			m.ObjectsCompacted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectsCompacted |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsCompacted", wireType)
+			}
+			m.TagsCompacted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TagsCompacted |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexesMerged", wireType)
+			}
+			m.IndexesMerged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexesMerged |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRewritten", wireType)
+			}
+			m.BytesRewritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRewritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPfs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12220,151 +17165,208 @@ var (
 func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptorPfs) }
 
 var fileDescriptorPfs = []byte{
-	// 2324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x17, 0x25, 0x59, 0xa2, 0x9e, 0xfc, 0x21, 0x8f, 0xb5, 0x8e, 0x56, 0x4e, 0x6c, 0x67, 0xe2,
-	0x45, 0x1d, 0xef, 0xd6, 0x0e, 0x9c, 0xdd, 0x66, 0x93, 0x6c, 0xd6, 0xf0, 0x87, 0x9c, 0x7a, 0xe1,
-	0xb5, 0x03, 0xda, 0x9b, 0x43, 0x81, 0x42, 0xa0, 0xa4, 0x91, 0xc4, 0x0d, 0x45, 0x32, 0x24, 0x15,
-	0xc7, 0x45, 0xd1, 0x6b, 0x7b, 0xef, 0xa1, 0xbd, 0x15, 0xfd, 0x47, 0x7a, 0x2e, 0xd0, 0x4b, 0xff,
-	0x82, 0xa2, 0x48, 0xef, 0xfd, 0x13, 0x8a, 0x62, 0x3e, 0x48, 0x0e, 0x49, 0xc9, 0x92, 0x03, 0xec,
-	0x21, 0xf1, 0x70, 0xe6, 0xbd, 0x37, 0xef, 0xbd, 0x79, 0x1f, 0xbf, 0x27, 0xa8, 0xb6, 0x4d, 0x83,
-	0x58, 0xfe, 0x8e, 0xd3, 0xf5, 0xe8, 0xbf, 0x6d, 0xc7, 0xb5, 0x7d, 0x1b, 0xe5, 0x9c, 0xae, 0x57,
-	0x5f, 0xe9, 0xd9, 0x76, 0xcf, 0x24, 0x3b, 0x6c, 0xab, 0x35, 0xec, 0xee, 0x90, 0x81, 0xe3, 0x5f,
-	0x73, 0x8a, 0xfa, 0x5a, 0xf2, 0xd0, 0x37, 0x06, 0xc4, 0xf3, 0xf5, 0x81, 0x23, 0x08, 0x56, 0x93,
-	0x04, 0x57, 0xae, 0xee, 0x38, 0xc4, 0x15, 0x57, 0xd4, 0xab, 0x3d, 0xbb, 0x67, 0xb3, 0xe5, 0x0e,
-	0x5d, 0x89, 0xdd, 0x65, 0xa1, 0x8e, 0x3e, 0xf4, 0xfb, 0xec, 0x3f, 0xbe, 0x8f, 0xeb, 0x90, 0xd7,
-	0x88, 0x63, 0x23, 0x04, 0x79, 0x4b, 0x1f, 0x90, 0x9a, 0xb2, 0xae, 0x6c, 0x96, 0x34, 0xb6, 0xc6,
-	0xfb, 0x00, 0x07, 0xae, 0x6e, 0xb5, 0xfb, 0x27, 0x56, 0x77, 0x24, 0x05, 0x5a, 0x83, 0x7c, 0x9f,
-	0xe8, 0x9d, 0x5a, 0x76, 0x5d, 0xd9, 0x2c, 0xef, 0x96, 0xb7, 0xa9, 0xa1, 0x87, 0xf6, 0x60, 0x60,
-	0xf8, 0x1a, 0x3b, 0xc0, 0x7b, 0x50, 0x8e, 0x44, 0x78, 0xe8, 0x11, 0x94, 0x5b, 0xec, 0xb3, 0x69,
-	0x58, 0x5d, 0xbb, 0xa6, 0xac, 0xe7, 0x36, 0xcb, 0xbb, 0x0b, 0x8c, 0x2d, 0x22, 0xd3, 0xa0, 0x15,
-	0xae, 0xf1, 0x1e, 0xe4, 0x8f, 0x0d, 0x93, 0xa0, 0x07, 0x50, 0x68, 0x33, 0xc1, 0xec, 0xfe, 0xc4,
-	0x5d, 0xe2, 0x88, 0xaa, 0xe8, 0xe8, 0x7e, 0x9f, 0xa9, 0x53, 0xd2, 0xd8, 0x1a, 0xaf, 0xc0, 0xcc,
-	0x81, 0x69, 0xb7, 0xdf, 0xd0, 0xc3, 0xbe, 0xee, 0xf5, 0x03, 0xfd, 0xe9, 0x1a, 0xdf, 0x85, 0xc2,
-	0x79, 0xeb, 0x47, 0xd2, 0xf6, 0x47, 0x9e, 0x7e, 0x0a, 0xb9, 0x4b, 0xbd, 0x37, 0xd2, 0x35, 0xff,
-	0x55, 0x40, 0xa5, 0x7e, 0x63, 0x9e, 0xb9, 0x07, 0x79, 0x97, 0x38, 0xb6, 0xd0, 0xac, 0xc4, 0x34,
-	0xa3, 0x87, 0x1a, 0xdb, 0x46, 0x5f, 0x42, 0xb1, 0xed, 0x12, 0xdd, 0x27, 0x81, 0x9f, 0xea, 0xdb,
-	0xfc, 0x09, 0xb7, 0x83, 0x27, 0xdc, 0xbe, 0x0c, 0xde, 0x58, 0x0b, 0x48, 0xd1, 0x3d, 0x00, 0xcf,
-	0xf8, 0x0d, 0x69, 0xb6, 0xae, 0x7d, 0xe2, 0xd5, 0x72, 0xeb, 0xca, 0x66, 0x5e, 0x2b, 0xd1, 0x9d,
-	0x03, 0xba, 0x81, 0x1e, 0x02, 0x38, 0xae, 0xfd, 0x8e, 0x58, 0xba, 0xd5, 0x26, 0xb5, 0x3c, 0x73,
-	0xa4, 0x74, 0xb3, 0x74, 0x88, 0xd6, 0xa1, 0xdc, 0x21, 0x5e, 0xdb, 0x35, 0x1c, 0xdf, 0xb0, 0xad,
-	0xda, 0x0c, 0x33, 0x43, 0xde, 0x42, 0xf7, 0x61, 0xc6, 0x6b, 0xdb, 0x0e, 0xa9, 0x15, 0xd6, 0x95,
-	0xcd, 0xf9, 0xdd, 0xf2, 0x36, 0x0b, 0x90, 0x0b, 0xba, 0xa5, 0xf1, 0x13, 0xbc, 0x07, 0x05, 0xee,
-	0xec, 0x49, 0xd6, 0x2e, 0x43, 0xd6, 0xe0, 0x86, 0x96, 0x0e, 0x0a, 0x1f, 0xfe, 0xb5, 0x96, 0x3d,
-	0x39, 0xd2, 0xb2, 0x46, 0x07, 0xff, 0x2d, 0x0b, 0xc0, 0x25, 0x30, 0x9f, 0x4d, 0xf5, 0x9e, 0x8f,
-	0x60, 0xce, 0xd1, 0x5d, 0x62, 0xf9, 0x4d, 0x41, 0x3b, 0x22, 0xce, 0x66, 0x39, 0x85, 0x50, 0xee,
-	0x4b, 0x28, 0x7a, 0xbe, 0xee, 0x52, 0x5f, 0xe7, 0x26, 0xfb, 0x5a, 0x90, 0xa2, 0x5f, 0x80, 0xda,
-	0x35, 0x2c, 0xc3, 0xeb, 0x93, 0x4e, 0x2d, 0x3f, 0x91, 0x2d, 0xa4, 0x4d, 0xbc, 0xd1, 0x4c, 0xf2,
-	0x8d, 0x3e, 0x8f, 0xbd, 0x51, 0x81, 0xbd, 0x51, 0x4c, 0x77, 0xf9, 0x95, 0xd6, 0x20, 0xef, 0xbb,
-	0x84, 0xd4, 0x8a, 0x92, 0x89, 0x3c, 0x36, 0x35, 0x76, 0x80, 0xff, 0xa1, 0x80, 0x4a, 0x53, 0x21,
-	0x08, 0xb9, 0xae, 0x61, 0x92, 0xd8, 0x23, 0xd0, 0x43, 0x8d, 0x6d, 0xa3, 0x2d, 0x28, 0xd1, 0xbf,
-	0x4d, 0xff, 0xda, 0x21, 0xcc, 0x69, 0xf3, 0xbb, 0x73, 0x21, 0xcd, 0xe5, 0xb5, 0x43, 0xa8, 0x11,
-	0x7c, 0x35, 0x29, 0xd0, 0xea, 0xa0, 0xb6, 0xfb, 0x86, 0xd9, 0x71, 0x89, 0xc5, 0x4c, 0x28, 0x69,
-	0xe1, 0x77, 0x98, 0x34, 0x54, 0xe7, 0x59, 0x9e, 0x34, 0xe8, 0x33, 0x28, 0xda, 0x4c, 0x6d, 0xaf,
-	0xa6, 0x4a, 0x16, 0x0b, 0x53, 0x82, 0x33, 0xfc, 0x04, 0x4a, 0x54, 0xbe, 0xa6, 0x5b, 0x3d, 0x82,
-	0xaa, 0x30, 0x63, 0xda, 0x57, 0xc4, 0x65, 0xe6, 0xe4, 0x35, 0xfe, 0x41, 0x77, 0x87, 0xb4, 0xb0,
-	0x31, 0x03, 0xf2, 0x1a, 0xff, 0xc0, 0x1a, 0xa8, 0x2c, 0x9f, 0x35, 0xd2, 0x45, 0xeb, 0x30, 0xd3,
-	0xa2, 0x6b, 0xe1, 0x06, 0xe0, 0x85, 0x84, 0x9d, 0xf2, 0x03, 0xb4, 0x01, 0x33, 0x2e, 0xbd, 0x42,
-	0x44, 0xce, 0x3c, 0xa7, 0x08, 0x2e, 0xd6, 0xf8, 0x21, 0xfe, 0x35, 0x00, 0xd7, 0x2f, 0x08, 0x4d,
-	0xae, 0x65, 0x2c, 0x34, 0x85, 0x01, 0xe2, 0x88, 0x7a, 0x98, 0xdd, 0xd0, 0x74, 0x49, 0x57, 0x08,
-	0x9f, 0x93, 0xae, 0x27, 0x5d, 0x4d, 0x6d, 0x89, 0x15, 0xfe, 0x93, 0x02, 0x8b, 0x87, 0x2c, 0xad,
-	0x59, 0x9e, 0x90, 0xb7, 0x43, 0xe2, 0x4d, 0xcc, 0xa3, 0x78, 0x82, 0x67, 0x6f, 0x91, 0xe0, 0xb9,
-	0x74, 0x82, 0x2f, 0x43, 0x61, 0xe8, 0x74, 0x74, 0x9f, 0xb0, 0xf0, 0x56, 0x35, 0xf1, 0x85, 0x5f,
-	0x03, 0x3a, 0xb1, 0x3c, 0x87, 0x1a, 0x36, 0xbd, 0x66, 0xf7, 0x61, 0xd6, 0xb0, 0xda, 0xe6, 0xb0,
-	0x43, 0x9a, 0xb4, 0x4e, 0x30, 0xeb, 0x55, 0xad, 0x2c, 0xf6, 0xf6, 0x87, 0x7e, 0x1f, 0x37, 0x61,
-	0xe1, 0xd4, 0xf0, 0x62, 0x42, 0xe3, 0xf6, 0x28, 0x37, 0xd9, 0x33, 0xc5, 0x05, 0xdf, 0x42, 0x25,
-	0xba, 0xc0, 0x73, 0x6c, 0xcb, 0x63, 0x41, 0x4f, 0xf5, 0x93, 0x5b, 0xcb, 0x5c, 0x78, 0x01, 0x6b,
-	0x2c, 0xaa, 0x2b, 0x56, 0xf8, 0x57, 0xb0, 0x78, 0x44, 0x4c, 0x72, 0xab, 0x17, 0xa9, 0xc2, 0x4c,
-	0xd7, 0x76, 0xdb, 0x44, 0xe8, 0xc3, 0x3f, 0x50, 0x05, 0x72, 0xba, 0x69, 0x32, 0xa7, 0xab, 0x1a,
-	0x5d, 0xe2, 0xdf, 0x01, 0xba, 0xa0, 0x85, 0x45, 0x24, 0xb9, 0x10, 0xfe, 0x00, 0x0a, 0xbc, 0x52,
-	0x8d, 0x2c, 0x78, 0xfc, 0x28, 0x51, 0x31, 0xb2, 0x37, 0x57, 0x8c, 0x65, 0x28, 0xf0, 0x46, 0x29,
-	0x5e, 0x5c, 0x7c, 0xe1, 0xbf, 0x28, 0x80, 0x0e, 0x86, 0x86, 0xd9, 0xf9, 0xa9, 0x15, 0x08, 0x4a,
-	0x56, 0x6e, 0x4c, 0xc9, 0x92, 0x34, 0xcc, 0xc7, 0x34, 0x7c, 0x06, 0x4b, 0xc7, 0xac, 0x86, 0xa6,
-	0x34, 0x9c, 0xd8, 0x13, 0xf0, 0x73, 0xa8, 0x8a, 0x90, 0xfd, 0x08, 0xe6, 0x3f, 0x28, 0xb0, 0x48,
-	0xe3, 0x26, 0xce, 0x3a, 0xe1, 0xdd, 0xd7, 0x20, 0xdf, 0x75, 0xed, 0xc1, 0x48, 0x90, 0x43, 0x0f,
-	0xd0, 0x0a, 0x64, 0x7d, 0x3b, 0xe6, 0x05, 0x71, 0x9c, 0xf5, 0x69, 0x3f, 0x2c, 0x58, 0xc3, 0x41,
-	0x8b, 0xb8, 0xcc, 0x07, 0x79, 0x4d, 0x7c, 0x51, 0x64, 0x14, 0xb5, 0x43, 0x86, 0x8c, 0xb8, 0x8e,
-	0x69, 0x64, 0x14, 0x91, 0x69, 0xd0, 0x0e, 0xd7, 0x78, 0x97, 0x9b, 0xc2, 0x71, 0xd3, 0x74, 0xa6,
-	0xe0, 0x73, 0xa8, 0x5c, 0x90, 0x04, 0xcb, 0x54, 0x9d, 0x38, 0x7a, 0xc9, 0x6c, 0xec, 0x25, 0x4f,
-	0x61, 0x89, 0xe7, 0xd1, 0x6d, 0xd4, 0x18, 0x2b, 0xed, 0x59, 0x20, 0xed, 0x23, 0x9e, 0x56, 0x07,
-	0x74, 0x6c, 0x0e, 0x93, 0x21, 0xf5, 0x19, 0x14, 0xf9, 0xb9, 0x27, 0x5c, 0x1a, 0xe3, 0x0d, 0xce,
-	0xd0, 0x06, 0xa8, 0xbe, 0xdd, 0xa4, 0xba, 0x79, 0xe9, 0x52, 0x5b, 0xf4, 0x6d, 0xfa, 0xd7, 0xc3,
-	0x0e, 0x2c, 0x5f, 0x0c, 0x5b, 0xb4, 0xaa, 0xb6, 0xc8, 0xad, 0x22, 0x68, 0x8c, 0xbd, 0x61, 0x64,
-	0xe5, 0xc6, 0x44, 0x16, 0x7e, 0x0b, 0xf3, 0x2f, 0x89, 0xcf, 0x1a, 0x7b, 0x74, 0xd3, 0x4d, 0x8d,
-	0xff, 0x3e, 0xcc, 0xda, 0xdd, 0xae, 0x47, 0x7c, 0xd1, 0xce, 0xe9, 0x7d, 0x39, 0xad, 0xcc, 0xf7,
-	0x78, 0x43, 0x4f, 0xf7, 0xfb, 0x9c, 0xd4, 0xef, 0xf1, 0x5f, 0xb3, 0x30, 0xff, 0x6a, 0x78, 0x9b,
-	0x3b, 0xab, 0x30, 0xf3, 0x4e, 0x37, 0x87, 0xbc, 0x0e, 0xcc, 0x6a, 0xfc, 0x83, 0xd6, 0xc5, 0xa1,
-	0x6b, 0x0a, 0xb4, 0x49, 0x97, 0xe8, 0x2e, 0xad, 0xcf, 0xed, 0xa1, 0xeb, 0x19, 0xef, 0x38, 0xd2,
-	0x54, 0xb5, 0x68, 0x03, 0x7d, 0x01, 0xa5, 0x0e, 0x31, 0x8d, 0x81, 0xe1, 0x13, 0x97, 0x01, 0x8a,
-	0x79, 0xd1, 0xad, 0x8f, 0x82, 0x5d, 0x2d, 0x22, 0x40, 0x5f, 0x00, 0xf2, 0x75, 0xb7, 0x47, 0xfc,
-	0x26, 0xc3, 0x39, 0x1d, 0xdd, 0x1f, 0x0e, 0x28, 0xe0, 0xa0, 0xc6, 0x54, 0xf8, 0x09, 0xd5, 0xf0,
-	0x88, 0xed, 0xa3, 0x2d, 0x58, 0x94, 0xa9, 0xb9, 0xe5, 0x25, 0x46, 0xbc, 0x10, 0x11, 0x73, 0xf7,
-	0xdc, 0x85, 0x92, 0xfd, 0x8e, 0xb8, 0x57, 0xae, 0xe1, 0x93, 0x1a, 0x70, 0x2d, 0xc3, 0x8d, 0xef,
-	0xf2, 0x6a, 0xb6, 0x92, 0xc3, 0x8f, 0xc3, 0xb6, 0x39, 0xbd, 0x9b, 0xf0, 0x11, 0xef, 0x89, 0xb7,
-	0x70, 0x2c, 0x82, 0x7c, 0x77, 0x68, 0x9a, 0xa2, 0xdf, 0xb0, 0x35, 0x7e, 0x05, 0x0b, 0x2f, 0x4d,
-	0xbb, 0x25, 0x4b, 0x99, 0x2a, 0x81, 0x6b, 0x50, 0x74, 0x74, 0xdf, 0x27, 0xae, 0x25, 0x62, 0x30,
-	0xf8, 0xa4, 0x48, 0x2c, 0x80, 0x95, 0x5e, 0x08, 0x1c, 0x53, 0x3d, 0x34, 0x20, 0xe1, 0xc0, 0x91,
-	0x15, 0xa0, 0x2b, 0x58, 0x38, 0x32, 0xba, 0x5d, 0x59, 0x95, 0x0d, 0x50, 0x2d, 0x72, 0xd5, 0x1c,
-	0x6d, 0x54, 0xd1, 0x22, 0x57, 0x6c, 0x96, 0xdb, 0x00, 0xd5, 0x36, 0x3b, 0x9c, 0x2a, 0x9b, 0xa2,
-	0xb2, 0xcd, 0x0e, 0xa3, 0xaa, 0x41, 0xd1, 0xeb, 0xeb, 0xa6, 0x69, 0x5f, 0x89, 0xe6, 0x1a, 0x7c,
-	0xe2, 0x1f, 0xa1, 0x12, 0x5d, 0x1c, 0x35, 0xff, 0xe0, 0x66, 0x6f, 0x8c, 0xe2, 0xe2, 0x7a, 0x66,
-	0x64, 0x70, 0x7f, 0x90, 0xee, 0x49, 0x5a, 0xa1, 0x84, 0x47, 0xab, 0x2c, 0x2f, 0x49, 0xb7, 0x78,
-	0xe9, 0x63, 0xa8, 0xbc, 0x1a, 0xfa, 0xa2, 0x13, 0x0a, 0x96, 0x30, 0x49, 0x14, 0x39, 0x49, 0xee,
-	0x42, 0xde, 0xd7, 0x7b, 0x81, 0x12, 0x2a, 0x13, 0x74, 0xa9, 0xf7, 0x34, 0xb6, 0x8b, 0x7f, 0x0b,
-	0x8b, 0x2f, 0x89, 0x90, 0xe3, 0x49, 0x15, 0x2d, 0xc0, 0xd7, 0xca, 0x78, 0x7c, 0x3d, 0xb2, 0x10,
-	0xe4, 0x27, 0x15, 0x02, 0x19, 0xf8, 0xe3, 0x1f, 0xa0, 0x72, 0xa9, 0xf7, 0xe2, 0x56, 0x4c, 0x05,
-	0x8d, 0x6f, 0x36, 0xaa, 0x0a, 0x88, 0xa6, 0x41, 0xdc, 0x2a, 0x7c, 0xce, 0x93, 0xe3, 0x52, 0xef,
-	0x85, 0x86, 0x2e, 0x43, 0xc1, 0x71, 0x49, 0xd7, 0x78, 0x2f, 0x06, 0x6f, 0xf1, 0x85, 0x36, 0x60,
-	0x4e, 0x20, 0x41, 0x2e, 0x43, 0xa4, 0x47, 0x7c, 0x13, 0x9f, 0x70, 0x80, 0xc8, 0x05, 0x8a, 0x18,
-	0xa9, 0x40, 0xce, 0xd7, 0x7b, 0x42, 0x1c, 0x5d, 0x4a, 0xf6, 0x64, 0xc7, 0xda, 0x83, 0x5f, 0x40,
-	0x95, 0x87, 0xc0, 0x47, 0xbd, 0x04, 0xbe, 0x03, 0x9f, 0x24, 0xd8, 0xb9, 0x3a, 0xf8, 0x67, 0x41,
-	0x68, 0xc9, 0x56, 0x23, 0xe1, 0x3c, 0x85, 0x8d, 0x5a, 0xa1, 0xcb, 0x64, 0x42, 0xc1, 0xfe, 0x14,
-	0xd0, 0x61, 0x9f, 0xb4, 0xdf, 0xdc, 0xfe, 0x85, 0xf0, 0xcf, 0x61, 0x29, 0xc6, 0x2a, 0xfc, 0xb3,
-	0x0c, 0x05, 0xf2, 0xde, 0xf0, 0x98, 0x3d, 0x6c, 0x4a, 0xe0, 0x5f, 0xf8, 0xf7, 0x59, 0x28, 0x07,
-	0xf3, 0x51, 0x87, 0xbc, 0x47, 0x4f, 0x92, 0x86, 0xdf, 0x93, 0x2e, 0x61, 0x24, 0x62, 0xed, 0x35,
-	0x2c, 0xdf, 0xbd, 0x8e, 0x82, 0x72, 0x3b, 0x16, 0x19, 0xf5, 0x14, 0x17, 0xb5, 0x8f, 0xb3, 0x30,
-	0xba, 0xfa, 0x09, 0xcc, 0xca, 0x82, 0xe8, 0x03, 0xbe, 0x21, 0xd7, 0xc1, 0x03, 0xbe, 0x21, 0xd7,
-	0xe8, 0x41, 0x90, 0x56, 0x23, 0x47, 0x30, 0x7e, 0xf6, 0x2c, 0xfb, 0xb5, 0x52, 0x3f, 0x82, 0x52,
-	0x28, 0x7d, 0x84, 0x9c, 0xfb, 0x71, 0x39, 0x31, 0xaf, 0x45, 0x52, 0xb6, 0x3e, 0xe7, 0x23, 0x38,
-	0x9b, 0x9b, 0x67, 0x41, 0xd5, 0x1a, 0x17, 0x0d, 0xed, 0x75, 0xe3, 0xa8, 0x92, 0x41, 0x2a, 0xe4,
-	0x8f, 0x4f, 0x4e, 0x1b, 0x15, 0x05, 0x15, 0x21, 0x77, 0x74, 0xa2, 0x55, 0xb2, 0x5b, 0x0f, 0xa1,
-	0x14, 0xf6, 0x2e, 0x7a, 0x7e, 0x76, 0x7e, 0xd6, 0xe0, 0x94, 0xdf, 0x5d, 0x9c, 0x9f, 0x55, 0x14,
-	0xba, 0x3a, 0x3d, 0x39, 0x6b, 0x54, 0xb2, 0x5b, 0xa7, 0x30, 0x1b, 0xf4, 0x86, 0xef, 0xed, 0x0e,
-	0x41, 0x4b, 0x51, 0xaf, 0x68, 0x9e, 0x9d, 0x6b, 0xdf, 0xef, 0x9f, 0x56, 0x32, 0x68, 0x11, 0xe6,
-	0xc2, 0xcd, 0xe3, 0xfd, 0x8b, 0xcb, 0x8a, 0x82, 0xaa, 0x3c, 0xca, 0xd9, 0x96, 0xd6, 0x38, 0xfc,
-	0x41, 0xbb, 0x68, 0x54, 0xb2, 0xbb, 0xff, 0x03, 0xc8, 0xed, 0xbf, 0x3a, 0x41, 0xdf, 0x02, 0x44,
-	0x63, 0x27, 0x5a, 0xe6, 0x6d, 0x21, 0x39, 0x87, 0xd6, 0x97, 0x53, 0x3f, 0x75, 0x34, 0x06, 0x8e,
-	0x7f, 0x8d, 0x33, 0xe8, 0x09, 0x94, 0xa5, 0xe9, 0x10, 0xdd, 0x61, 0x02, 0xd2, 0xf3, 0x62, 0x3d,
-	0x3e, 0x65, 0xe1, 0x0c, 0x7a, 0x0a, 0x6a, 0x30, 0x9d, 0xa1, 0x2a, 0x3b, 0x4c, 0x4c, 0x83, 0xf5,
-	0x4f, 0x12, 0xbb, 0x22, 0xa6, 0x33, 0x54, 0xe7, 0x68, 0x30, 0x13, 0x3a, 0xa7, 0x26, 0xb5, 0x1b,
-	0x74, 0xfe, 0x0a, 0xca, 0xd2, 0xf0, 0x25, 0x74, 0x4e, 0x8f, 0x63, 0x75, 0xb9, 0x49, 0xe2, 0x0c,
-	0x3a, 0x80, 0x59, 0x79, 0x22, 0x41, 0x35, 0x51, 0xd3, 0x53, 0x43, 0xca, 0x0d, 0x57, 0xbf, 0x80,
-	0xb9, 0xd8, 0x64, 0x82, 0x3e, 0x95, 0x1d, 0x16, 0x97, 0x92, 0x44, 0xf6, 0x38, 0x83, 0xbe, 0x06,
-	0x88, 0x46, 0x13, 0x61, 0x79, 0x6a, 0x56, 0xa9, 0x57, 0x12, 0x8c, 0x1e, 0x57, 0x5e, 0x86, 0xcd,
-	0x42, 0xf9, 0x11, 0x48, 0xfa, 0x06, 0xe5, 0x9f, 0x43, 0x59, 0x82, 0xcf, 0xc2, 0x6f, 0x69, 0x40,
-	0x3d, 0x42, 0xf1, 0x47, 0x0a, 0x3a, 0x84, 0x85, 0x04, 0x30, 0x46, 0x2b, 0xdc, 0xf1, 0x23, 0xe1,
-	0xf2, 0x68, 0x21, 0x5f, 0x41, 0x59, 0x9a, 0x5a, 0x85, 0x06, 0xe9, 0x39, 0x36, 0xf9, 0x72, 0xc2,
-	0x6d, 0x7c, 0xfe, 0x90, 0xdc, 0x16, 0x1b, 0x48, 0x84, 0xdb, 0xa4, 0x9f, 0xa2, 0x71, 0x06, 0x7d,
-	0x03, 0xa5, 0x70, 0x18, 0x42, 0x3c, 0x20, 0x93, 0xc3, 0xd1, 0x0d, 0x0e, 0x0b, 0x9d, 0x2e, 0x04,
-	0xc8, 0x4e, 0x9f, 0x56, 0xc6, 0x33, 0x28, 0x0a, 0xa8, 0x8d, 0x96, 0x18, 0x7b, 0x1c, 0x78, 0x8f,
-	0xe7, 0xdc, 0x54, 0xd0, 0x1e, 0x14, 0xc5, 0x68, 0x20, 0x78, 0xe3, 0x83, 0x42, 0x7d, 0x25, 0xc5,
-	0xcb, 0xda, 0xfa, 0x6b, 0x5a, 0xcb, 0x98, 0xbf, 0xa3, 0xec, 0x66, 0x42, 0x62, 0xd9, 0x2d, 0x0b,
-	0x8a, 0x43, 0x23, 0x9c, 0x41, 0xbb, 0x3c, 0xbb, 0x19, 0x57, 0x94, 0xdd, 0x32, 0xcb, 0x7c, 0x8c,
-	0xc5, 0xe3, 0x3c, 0x01, 0x6c, 0x15, 0x3c, 0x09, 0x14, 0x3b, 0x82, 0xe7, 0x29, 0xa8, 0x01, 0xcc,
-	0x13, 0x3c, 0x09, 0xb8, 0x29, 0xaa, 0x48, 0x12, 0x0b, 0xca, 0x55, 0x84, 0x31, 0xcb, 0x55, 0x64,
-	0x2a, 0xf7, 0xa2, 0x17, 0xac, 0x74, 0x13, 0x9f, 0xec, 0x9b, 0x26, 0x1a, 0x43, 0x36, 0x9e, 0x7d,
-	0xf7, 0x8f, 0x05, 0x28, 0xf1, 0xe6, 0x41, 0xcb, 0xf0, 0x63, 0x28, 0x85, 0x70, 0x50, 0xc4, 0x59,
-	0x12, 0x1e, 0xd6, 0xe5, 0x86, 0xc3, 0x9e, 0xf7, 0x29, 0x94, 0x42, 0xec, 0x87, 0xe4, 0xd3, 0xc9,
-	0x0f, 0xdb, 0x00, 0x88, 0x60, 0xa3, 0x30, 0x3e, 0x85, 0x23, 0x27, 0x8b, 0xf9, 0x86, 0x75, 0xcc,
-	0x98, 0xda, 0x49, 0x3c, 0x78, 0x83, 0x07, 0x77, 0xc2, 0x62, 0x38, 0xca, 0x86, 0x85, 0x58, 0xeb,
-	0x67, 0x51, 0x75, 0x00, 0x65, 0x09, 0x93, 0x88, 0x70, 0x4c, 0x03, 0x9c, 0x7a, 0x2d, 0x7d, 0x10,
-	0x3e, 0xfb, 0x13, 0x28, 0x4b, 0xd8, 0x52, 0xc8, 0x48, 0xa3, 0xcd, 0x84, 0xb7, 0x1f, 0x29, 0xe8,
-	0x97, 0x30, 0x17, 0xc3, 0x68, 0xa2, 0x74, 0x8f, 0x82, 0x7d, 0xf5, 0xfa, 0xa8, 0xa3, 0x50, 0x85,
-	0xc7, 0x50, 0x78, 0x49, 0x28, 0xec, 0x44, 0x21, 0xf0, 0x9d, 0xec, 0xea, 0x87, 0x00, 0xc2, 0x59,
-	0x71, 0xc6, 0x11, 0x6e, 0x7a, 0xce, 0x93, 0x8f, 0x62, 0x19, 0x29, 0xf9, 0x24, 0x04, 0x29, 0xb5,
-	0xd6, 0x18, 0x5c, 0xa4, 0xf7, 0xec, 0x05, 0x69, 0xc1, 0xd8, 0xe5, 0xb4, 0x90, 0x05, 0xdc, 0x49,
-	0xed, 0x87, 0xd6, 0x3d, 0x87, 0xe2, 0xa1, 0x3d, 0x70, 0xf4, 0xb6, 0x7f, 0xfb, 0xac, 0x38, 0xa8,
-	0xfc, 0xfd, 0xc3, 0xaa, 0xf2, 0xcf, 0x0f, 0xab, 0xca, 0xbf, 0x3f, 0xac, 0x2a, 0x7f, 0xfe, 0xcf,
-	0x6a, 0xa6, 0x55, 0x60, 0x34, 0x8f, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xac, 0xfc, 0x90, 0xfc,
-	0x2c, 0x1d, 0x00, 0x00,
+	// 3246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x73, 0x1b, 0xc7,
+	0xb1, 0x5c, 0x2c, 0x3e, 0x76, 0x1b, 0x00, 0x01, 0x8e, 0x28, 0x1a, 0x06, 0x65, 0x91, 0x5a, 0xcb,
+	0xcf, 0xb4, 0x6c, 0x53, 0x2a, 0xca, 0x7e, 0xb2, 0x44, 0xfb, 0xe9, 0x89, 0x5f, 0x32, 0x6d, 0x8a,
+	0xd2, 0x5b, 0x52, 0xae, 0xf2, 0xab, 0xa4, 0x50, 0x4b, 0x60, 0x00, 0xae, 0x09, 0xec, 0x42, 0xbb,
+	0x0b, 0x89, 0x74, 0xe5, 0x94, 0x8b, 0x6f, 0x39, 0xa5, 0x2a, 0xa9, 0xe4, 0x92, 0x4b, 0x2a, 0x3f,
+	0x23, 0xd7, 0x1c, 0x53, 0xb9, 0xe4, 0x94, 0x54, 0x4a, 0xf9, 0x05, 0xf9, 0x07, 0xa9, 0x9e, 0x99,
+	0xdd, 0x9d, 0xdd, 0x05, 0x09, 0x50, 0x49, 0x0e, 0x24, 0x67, 0xba, 0x67, 0x7a, 0xfa, 0x6b, 0x7a,
+	0xba, 0x7b, 0x09, 0xf3, 0xed, 0xbe, 0x4d, 0x9d, 0xe0, 0xf6, 0xb0, 0xeb, 0xe3, 0xcf, 0xea, 0xd0,
+	0x73, 0x03, 0x97, 0xa8, 0xc3, 0xae, 0xdf, 0x5c, 0xec, 0xb9, 0x6e, 0xaf, 0x4f, 0x6f, 0x33, 0xd0,
+	0xd1, 0xa8, 0x7b, 0x9b, 0x0e, 0x86, 0xc1, 0x19, 0x5f, 0xd1, 0x5c, 0x4a, 0x23, 0x03, 0x7b, 0x40,
+	0xfd, 0xc0, 0x1a, 0x0c, 0xc5, 0x82, 0xeb, 0xe9, 0x05, 0xaf, 0x3c, 0x6b, 0x38, 0xa4, 0x9e, 0x38,
+	0xa2, 0x39, 0xdf, 0x73, 0x7b, 0x2e, 0x1b, 0xde, 0xc6, 0x91, 0x80, 0x2e, 0x08, 0x76, 0xac, 0x51,
+	0x70, 0xcc, 0x7e, 0x71, 0xb8, 0xd1, 0x84, 0xbc, 0x49, 0x87, 0x2e, 0x21, 0x90, 0x77, 0xac, 0x01,
+	0x6d, 0x28, 0xcb, 0xca, 0x8a, 0x6e, 0xb2, 0xb1, 0xf1, 0x08, 0x60, 0xc3, 0xb3, 0x9c, 0xf6, 0xf1,
+	0xae, 0xd3, 0x1d, 0xbb, 0x82, 0x2c, 0x41, 0xfe, 0x98, 0x5a, 0x9d, 0x46, 0x6e, 0x59, 0x59, 0x29,
+	0xaf, 0x95, 0x57, 0x51, 0xd0, 0x4d, 0x77, 0x30, 0xb0, 0x03, 0x93, 0x21, 0x8c, 0x87, 0x50, 0x8e,
+	0x49, 0xf8, 0xe4, 0x0e, 0x94, 0x8f, 0xd8, 0xb4, 0x65, 0x3b, 0x5d, 0xb7, 0xa1, 0x2c, 0xab, 0x2b,
+	0xe5, 0xb5, 0x1a, 0xdb, 0x16, 0x2f, 0x33, 0xe1, 0x28, 0x1a, 0x1b, 0x0f, 0x21, 0xbf, 0x63, 0xf7,
+	0x29, 0x79, 0x17, 0x8a, 0x6d, 0x46, 0xb8, 0xa1, 0x64, 0xcf, 0x12, 0x28, 0x64, 0x71, 0x68, 0x05,
+	0xc7, 0x8c, 0x1d, 0xdd, 0x64, 0x63, 0x63, 0x11, 0x0a, 0x1b, 0x7d, 0xb7, 0x7d, 0x82, 0xc8, 0x63,
+	0xcb, 0x3f, 0x0e, 0xf9, 0xc7, 0xb1, 0x71, 0x0d, 0x8a, 0x4f, 0x8f, 0xbe, 0xa3, 0xed, 0x60, 0x2c,
+	0xf6, 0x6d, 0x50, 0x0f, 0xad, 0xde, 0x58, 0xd5, 0xfc, 0x5c, 0x05, 0x0d, 0xf5, 0xc6, 0x34, 0xf3,
+	0x0e, 0xe4, 0x3d, 0x3a, 0x74, 0x05, 0x67, 0x3a, 0xe3, 0x0c, 0x91, 0x26, 0x03, 0x93, 0x4f, 0xa0,
+	0xd4, 0xf6, 0xa8, 0x15, 0xd0, 0x50, 0x4f, 0xcd, 0x55, 0x6e, 0xc2, 0xd5, 0xd0, 0x84, 0xab, 0x87,
+	0xa1, 0x8d, 0xcd, 0x70, 0x29, 0x79, 0x07, 0xc0, 0xb7, 0xbf, 0xa7, 0xad, 0xa3, 0xb3, 0x80, 0xfa,
+	0x0d, 0x75, 0x59, 0x59, 0xc9, 0x9b, 0x3a, 0x42, 0x36, 0x10, 0x40, 0x3e, 0x00, 0x18, 0x7a, 0xee,
+	0x4b, 0xea, 0x58, 0x4e, 0x9b, 0x36, 0xf2, 0xcb, 0x6a, 0xf2, 0x64, 0x09, 0x49, 0x96, 0xa1, 0xdc,
+	0xa1, 0x7e, 0xdb, 0xb3, 0x87, 0x81, 0xed, 0x3a, 0x8d, 0x02, 0x13, 0x43, 0x06, 0x91, 0x1b, 0x50,
+	0xf0, 0xdb, 0xee, 0x90, 0x36, 0x8a, 0xcb, 0xca, 0xca, 0xec, 0x5a, 0x79, 0x95, 0x39, 0xc8, 0x01,
+	0x82, 0x4c, 0x8e, 0x41, 0x76, 0xba, 0x76, 0x9f, 0xb6, 0xda, 0xee, 0xc8, 0x09, 0x1a, 0x25, 0xce,
+	0x0e, 0x42, 0x36, 0x11, 0x40, 0xd6, 0x01, 0x46, 0x3e, 0xf5, 0x5a, 0x23, 0xdf, 0xea, 0xd1, 0x86,
+	0xc6, 0xd8, 0xb9, 0x16, 0xb1, 0x83, 0x5a, 0x5a, 0x7d, 0xee, 0x53, 0xef, 0x39, 0xa2, 0xb7, 0x9d,
+	0xc0, 0x3b, 0x33, 0xf5, 0x51, 0x38, 0x6f, 0x7e, 0x09, 0xb3, 0x49, 0x24, 0xa9, 0x83, 0x7a, 0x42,
+	0xcf, 0x84, 0xc6, 0x71, 0x48, 0x96, 0xa1, 0xf0, 0xd2, 0xea, 0x8f, 0xa8, 0x50, 0x21, 0x30, 0xda,
+	0x6c, 0x87, 0xc9, 0x11, 0x0f, 0x72, 0x9f, 0x29, 0xc6, 0x43, 0x28, 0x72, 0x97, 0x98, 0x64, 0x93,
+	0x05, 0xc8, 0xd9, 0xdc, 0x1c, 0xfa, 0x46, 0xf1, 0xf5, 0x5f, 0x97, 0x72, 0xbb, 0x5b, 0x66, 0xce,
+	0xee, 0x18, 0xbf, 0x52, 0x01, 0x38, 0x05, 0x66, 0xd9, 0xa9, 0xbc, 0xee, 0x0e, 0x54, 0x87, 0x96,
+	0x47, 0x9d, 0xa0, 0x25, 0xd6, 0x8e, 0xb9, 0x0d, 0x15, 0xbe, 0x42, 0x30, 0xf7, 0x09, 0x94, 0xfc,
+	0xc0, 0xf2, 0xd0, 0x23, 0xd4, 0xc9, 0x1e, 0x21, 0x96, 0x92, 0xff, 0x06, 0xad, 0x6b, 0x3b, 0xb6,
+	0x7f, 0x4c, 0x3b, 0x8d, 0xfc, 0xc4, 0x6d, 0xd1, 0xda, 0x94, 0x27, 0x15, 0xd2, 0x9e, 0xf4, 0x61,
+	0xc2, 0x93, 0x8a, 0xcb, 0x6a, 0x9a, 0x77, 0x09, 0x8d, 0x17, 0x3e, 0xf0, 0x28, 0x65, 0x0e, 0x10,
+	0x2e, 0xe3, 0x37, 0xc8, 0x64, 0x88, 0xb4, 0xb3, 0x69, 0x59, 0x67, 0x5b, 0x80, 0x22, 0xba, 0x97,
+	0xeb, 0x35, 0x74, 0x86, 0x14, 0x33, 0xb4, 0x30, 0xf7, 0x1e, 0xc8, 0x5a, 0x98, 0x21, 0x8c, 0x3f,
+	0xe5, 0x40, 0xc3, 0x60, 0x10, 0x5e, 0x3a, 0x74, 0xbf, 0x84, 0x81, 0x11, 0x69, 0x32, 0x30, 0xb9,
+	0x05, 0xcc, 0x3b, 0x5b, 0xc1, 0xd9, 0x90, 0xfb, 0xcc, 0xec, 0x5a, 0x35, 0x5a, 0x73, 0x78, 0x36,
+	0xa4, 0xa8, 0x20, 0x3e, 0x9a, 0x74, 0xd5, 0x9a, 0xa0, 0xb5, 0x8f, 0xed, 0x7e, 0xc7, 0xa3, 0x0e,
+	0x53, 0x8f, 0x6e, 0x46, 0xf3, 0x28, 0x6c, 0xa0, 0x3e, 0x2a, 0x3c, 0x6c, 0x90, 0xf7, 0xa0, 0xe4,
+	0x32, 0x95, 0xf8, 0xe2, 0x22, 0x24, 0xd4, 0x14, 0xe2, 0x70, 0xeb, 0xc0, 0xed, 0x50, 0xa6, 0x85,
+	0xaa, 0xc9, 0xc6, 0xe4, 0x0e, 0x14, 0x06, 0x18, 0xef, 0x1b, 0x30, 0xd1, 0xbe, 0x7c, 0x21, 0x79,
+	0x0f, 0x66, 0xfd, 0xb3, 0x41, 0xdf, 0x76, 0x4e, 0x5a, 0x81, 0xe5, 0xf5, 0x68, 0xd0, 0x28, 0x33,
+	0xad, 0x56, 0x05, 0xf4, 0x90, 0x01, 0x49, 0x03, 0x4a, 0x1d, 0xda, 0xa7, 0xe8, 0x71, 0x95, 0x65,
+	0x65, 0x45, 0x33, 0xc3, 0xa9, 0x71, 0x0f, 0x74, 0x14, 0xd3, 0xb4, 0x9c, 0x1e, 0x25, 0xf3, 0x50,
+	0xe8, 0xbb, 0xaf, 0xa8, 0xc7, 0xb4, 0x9a, 0x37, 0xf9, 0x04, 0xa1, 0x23, 0x7c, 0x61, 0x98, 0x1e,
+	0xf3, 0x26, 0x9f, 0x18, 0x26, 0x68, 0x2c, 0xb0, 0x9a, 0xb4, 0x8b, 0xb6, 0x3b, 0xc2, 0x71, 0x43,
+	0x91, 0x6c, 0xc7, 0xb1, 0x1c, 0x41, 0x6e, 0x42, 0xc1, 0xc3, 0x23, 0xc4, 0xe5, 0x98, 0xe5, 0x2b,
+	0xc2, 0x83, 0x4d, 0x8e, 0x34, 0x7e, 0x0c, 0xc0, 0xd5, 0x14, 0xde, 0x3e, 0xae, 0xac, 0xc4, 0xed,
+	0x13, 0x7a, 0x14, 0x28, 0x34, 0x34, 0x3b, 0xa1, 0xe5, 0xd1, 0xae, 0x20, 0x5e, 0x95, 0x8e, 0xa7,
+	0x5d, 0x53, 0x3b, 0x12, 0x23, 0xe3, 0x17, 0x0a, 0xcc, 0x6d, 0xb2, 0xf8, 0xca, 0x42, 0x01, 0x7d,
+	0x31, 0xa2, 0xfe, 0xc4, 0x50, 0x91, 0x8c, 0xb4, 0xb9, 0x4b, 0x44, 0x5a, 0x75, 0xac, 0xf3, 0x8f,
+	0x86, 0x1d, 0x2b, 0xa0, 0xec, 0x06, 0x6b, 0xa6, 0x98, 0x19, 0xdf, 0x00, 0xd9, 0x75, 0xfc, 0x21,
+	0x0a, 0x36, 0x3d, 0x67, 0x37, 0xa0, 0x62, 0x3b, 0xed, 0xfe, 0xa8, 0x43, 0x5b, 0x78, 0x87, 0x98,
+	0xf4, 0x9a, 0x59, 0x16, 0xb0, 0x47, 0xa3, 0xe0, 0xd8, 0xf8, 0xb5, 0x02, 0xb5, 0x3d, 0xdb, 0x4f,
+	0x50, 0x4d, 0x0a, 0xa4, 0x5c, 0x24, 0xd0, 0xe4, 0x13, 0xc8, 0x22, 0xe8, 0x43, 0xab, 0x47, 0x5b,
+	0x78, 0x5f, 0x98, 0xc4, 0xaa, 0xa9, 0x21, 0xe0, 0xc0, 0xfe, 0x9e, 0xdd, 0x2c, 0x86, 0x0c, 0xdc,
+	0x13, 0xea, 0x30, 0x91, 0x75, 0x93, 0x2d, 0x3f, 0x44, 0x80, 0xd1, 0x85, 0x7a, 0xcc, 0x9c, 0x3f,
+	0x74, 0x1d, 0x9f, 0x5d, 0x5c, 0x14, 0x4e, 0x4e, 0x10, 0xaa, 0x89, 0x87, 0xc4, 0xd4, 0x3c, 0x31,
+	0x22, 0xff, 0x05, 0x35, 0x87, 0x9e, 0x06, 0x2d, 0xe9, 0x0c, 0xfe, 0xf4, 0x57, 0x11, 0xfc, 0x2c,
+	0x3a, 0xe7, 0xff, 0x61, 0x6e, 0x8b, 0xb9, 0xfb, 0x25, 0x94, 0x3b, 0x0f, 0x85, 0xae, 0xeb, 0xb5,
+	0xa9, 0x90, 0x99, 0x4f, 0xf0, 0x61, 0xb2, 0xfa, 0x7d, 0x26, 0xa7, 0x66, 0xe2, 0xd0, 0xd8, 0x86,
+	0xc2, 0xff, 0x8d, 0xdc, 0xc0, 0x4a, 0x45, 0x11, 0x25, 0x1d, 0x45, 0x92, 0x0f, 0x68, 0x2e, 0xf5,
+	0x80, 0x22, 0x19, 0x16, 0xeb, 0xfe, 0x45, 0x32, 0x5d, 0xa8, 0x1d, 0xd0, 0x80, 0x31, 0x34, 0xa5,
+	0x9c, 0x04, 0xf2, 0xf8, 0x12, 0x87, 0x39, 0x13, 0x8e, 0xf1, 0x3a, 0xbf, 0x40, 0x12, 0x0d, 0x55,
+	0xba, 0xce, 0x9c, 0x28, 0x47, 0x18, 0x84, 0x5b, 0x4e, 0x3e, 0xc8, 0xf8, 0xa9, 0x02, 0x3a, 0x03,
+	0x4c, 0x93, 0x14, 0xbd, 0xd1, 0xb1, 0xf1, 0x1b, 0x91, 0x3f, 0xef, 0x8d, 0x58, 0x07, 0x88, 0x78,
+	0xf0, 0xc9, 0xc7, 0x00, 0x6c, 0xa3, 0xec, 0x4d, 0xb3, 0x31, 0x59, 0xe6, 0x4e, 0xfa, 0x8b, 0x70,
+	0x68, 0x0c, 0xa0, 0xb6, 0xe3, 0x7a, 0x27, 0xb2, 0x97, 0x2c, 0x82, 0xea, 0x7b, 0xed, 0xac, 0x14,
+	0x08, 0x45, 0x64, 0xc7, 0x0f, 0xdf, 0x7b, 0x19, 0xd9, 0xf1, 0x83, 0xc9, 0xc1, 0xc0, 0xf8, 0x8d,
+	0x02, 0xe4, 0x00, 0x1f, 0x77, 0xf1, 0xd0, 0x8a, 0x23, 0xdf, 0x85, 0x22, 0xcf, 0x16, 0xc6, 0x26,
+	0x1d, 0x1c, 0x45, 0x3e, 0x1c, 0x13, 0x95, 0xce, 0x7d, 0xb5, 0x17, 0xa0, 0xc8, 0x53, 0x6a, 0xc1,
+	0x85, 0x98, 0xa5, 0x59, 0xcc, 0x67, 0x59, 0xfc, 0x87, 0x02, 0x64, 0x63, 0x64, 0xf7, 0x3b, 0xff,
+	0x69, 0x16, 0xc3, 0xc4, 0x42, 0x3d, 0x2f, 0xb1, 0x88, 0x65, 0xc8, 0x5f, 0x24, 0x43, 0x61, 0x5c,
+	0xcc, 0xc5, 0x5c, 0xaf, 0x98, 0xce, 0xf5, 0xa4, 0x44, 0xa4, 0x24, 0x27, 0x22, 0xc6, 0x8f, 0xe0,
+	0xca, 0x0e, 0xcb, 0x9d, 0x32, 0x32, 0x4f, 0xce, 0x05, 0x53, 0xdc, 0xe4, 0xb2, 0x1a, 0x5d, 0x87,
+	0x79, 0x11, 0xe9, 0x2f, 0x4f, 0xde, 0xf8, 0x9d, 0x02, 0x73, 0x78, 0xef, 0x92, 0x5b, 0x27, 0x5c,
+	0xb5, 0x25, 0xc8, 0x77, 0x3d, 0x77, 0x30, 0xb6, 0x48, 0x43, 0x04, 0x59, 0x84, 0x5c, 0xe0, 0x36,
+	0xd4, 0x2c, 0x3a, 0x17, 0x60, 0xa6, 0x5c, 0x74, 0x46, 0x83, 0x23, 0xea, 0x31, 0xbd, 0xe7, 0x4d,
+	0x31, 0x4b, 0x85, 0xf6, 0x42, 0x3a, 0xb4, 0xf7, 0xa0, 0x1c, 0xe7, 0xd1, 0xac, 0xf0, 0xe3, 0x22,
+	0x64, 0x0b, 0xbf, 0x78, 0x99, 0x09, 0xed, 0x68, 0x3c, 0x75, 0x6c, 0x5f, 0xe3, 0x1a, 0xe1, 0xe5,
+	0xe3, 0x74, 0x1a, 0x31, 0x9e, 0x42, 0xfd, 0x80, 0xa6, 0xb6, 0x4c, 0x65, 0xde, 0xd8, 0x09, 0x73,
+	0xb2, 0x13, 0x1a, 0x7b, 0x70, 0x85, 0x3f, 0x30, 0x97, 0x61, 0xe3, 0x5c, 0x6a, 0x0f, 0x42, 0x6a,
+	0x6f, 0xe0, 0x21, 0x16, 0x90, 0x9d, 0xfe, 0x28, 0xed, 0xbb, 0xef, 0x41, 0x89, 0xe3, 0x7d, 0xa1,
+	0xfa, 0xc4, 0xde, 0x10, 0x47, 0x6e, 0x82, 0x16, 0xb8, 0x2d, 0xe4, 0xcd, 0xcf, 0x26, 0x3a, 0xa5,
+	0xc0, 0xc5, 0xbf, 0xbe, 0x31, 0x84, 0x85, 0x83, 0xd1, 0x11, 0x7a, 0xf4, 0x11, 0xbd, 0x94, 0x23,
+	0x9e, 0x23, 0x6f, 0xe4, 0xa0, 0xea, 0x39, 0x0e, 0x6a, 0xbc, 0x80, 0xd9, 0xc7, 0x34, 0x60, 0xd9,
+	0x7d, 0x7c, 0xd2, 0x45, 0xd9, 0xff, 0x0d, 0xa8, 0xb8, 0xdd, 0xae, 0x4f, 0x03, 0xf1, 0x8c, 0xe6,
+	0x58, 0x5e, 0x52, 0xe6, 0xb0, 0xe8, 0x21, 0x4d, 0x25, 0xfd, 0xaa, 0xf4, 0xce, 0x1a, 0x3f, 0x53,
+	0x61, 0xf6, 0xd9, 0xe8, 0x32, 0x67, 0xce, 0x87, 0x15, 0xaa, 0xca, 0x6a, 0x01, 0x3e, 0xc1, 0x84,
+	0x61, 0xe4, 0xf5, 0xc5, 0xfd, 0xc0, 0x21, 0xb9, 0x86, 0x09, 0x4e, 0x7b, 0xe4, 0xf9, 0xf6, 0x4b,
+	0x5e, 0x70, 0x6b, 0x66, 0x0c, 0x20, 0x1f, 0x81, 0xde, 0xa1, 0x7d, 0x7b, 0x60, 0x07, 0x94, 0xc7,
+	0xa5, 0x59, 0xf1, 0x60, 0x6d, 0x85, 0x50, 0x33, 0x5e, 0x40, 0x3e, 0x02, 0xc2, 0xb3, 0xfe, 0x16,
+	0x4b, 0x0a, 0x3a, 0x56, 0x30, 0x1a, 0xf8, 0xac, 0xe8, 0x52, 0xcd, 0x3a, 0xc7, 0x20, 0x87, 0x5b,
+	0x0c, 0x4e, 0x6e, 0xc1, 0x9c, 0xbc, 0x9a, 0x4b, 0xae, 0xb3, 0xc5, 0xb5, 0x78, 0x31, 0x57, 0xcf,
+	0x35, 0xd0, 0xdd, 0x97, 0xd4, 0x7b, 0xe5, 0xd9, 0x01, 0xaf, 0x46, 0x34, 0x33, 0x06, 0x44, 0xb5,
+	0x4b, 0x79, 0x5c, 0xed, 0x52, 0x79, 0xf3, 0xda, 0xa5, 0x3a, 0xa6, 0x76, 0xf9, 0x2a, 0xaf, 0xe5,
+	0xea, 0xaa, 0x71, 0x37, 0xca, 0x90, 0xa7, 0xb7, 0x89, 0xb1, 0xc5, 0xb3, 0xdf, 0x4b, 0x58, 0x91,
+	0x40, 0xbe, 0x3b, 0xea, 0xf7, 0x45, 0xd6, 0xc7, 0xc6, 0xc6, 0x36, 0x2c, 0x84, 0x54, 0xbe, 0xb4,
+	0xfd, 0xc0, 0xf5, 0xce, 0xa6, 0x24, 0x56, 0x01, 0xc5, 0x11, 0xbe, 0xa7, 0x38, 0xc6, 0x33, 0xa8,
+	0x3d, 0xee, 0xbb, 0x47, 0x32, 0x33, 0x53, 0x05, 0x9d, 0x06, 0x94, 0x86, 0x56, 0x10, 0x50, 0x2f,
+	0x8c, 0x80, 0xe1, 0xd4, 0xf8, 0x41, 0x81, 0xda, 0x63, 0x8f, 0x0e, 0xff, 0x7d, 0x24, 0xd1, 0x8b,
+	0x3d, 0xda, 0xa3, 0xa7, 0x22, 0x53, 0xe0, 0x13, 0xb2, 0x04, 0xe5, 0x81, 0x75, 0xda, 0x1a, 0x58,
+	0x41, 0xfb, 0x98, 0xfa, 0xec, 0x25, 0x50, 0x4d, 0x18, 0x58, 0xa7, 0x4f, 0x38, 0x04, 0x33, 0xf9,
+	0x98, 0x11, 0x91, 0xc9, 0x4f, 0x50, 0xce, 0x12, 0x94, 0xfb, 0xb6, 0x43, 0x5b, 0xe2, 0x75, 0xe1,
+	0x6a, 0x02, 0x04, 0xed, 0x33, 0x08, 0x9a, 0x02, 0x67, 0x82, 0x13, 0x36, 0xc6, 0x6a, 0x35, 0xec,
+	0x00, 0xf8, 0x51, 0x8d, 0x9f, 0x29, 0x15, 0xc2, 0x25, 0xbc, 0xc6, 0xc7, 0x91, 0xf1, 0x7b, 0x05,
+	0x6a, 0x5b, 0x76, 0xb7, 0x2b, 0xab, 0xea, 0x26, 0x68, 0x0e, 0x7d, 0xd5, 0x1a, 0xcf, 0x64, 0xc9,
+	0xa1, 0xaf, 0x70, 0x80, 0xab, 0xdc, 0x7e, 0x87, 0xaf, 0xca, 0x65, 0x56, 0xb9, 0xfd, 0x0e, 0x5b,
+	0xd5, 0x80, 0x92, 0x7f, 0x6c, 0xf5, 0xfb, 0xee, 0x2b, 0x51, 0x1c, 0x84, 0x53, 0xc4, 0xb4, 0x5d,
+	0x27, 0xc0, 0x64, 0x89, 0xd7, 0x7c, 0xe1, 0x14, 0x6f, 0xaf, 0x18, 0xb2, 0xea, 0xa9, 0xc5, 0x6e,
+	0xb5, 0x68, 0xd0, 0xd4, 0x05, 0x06, 0xcb, 0xa8, 0x3d, 0x84, 0x1b, 0xdf, 0x42, 0xf9, 0xab, 0x83,
+	0xa7, 0xfb, 0x5f, 0xd3, 0x33, 0x94, 0x63, 0x4c, 0x8b, 0x6c, 0x11, 0x74, 0x64, 0x34, 0x6e, 0x93,
+	0xe9, 0x26, 0x72, 0xfe, 0x0d, 0xce, 0x11, 0x89, 0xb2, 0xc6, 0x11, 0x4a, 0x37, 0x51, 0x78, 0x86,
+	0x64, 0x55, 0xe2, 0x0e, 0xab, 0x21, 0xd8, 0x99, 0x8c, 0xfe, 0xdb, 0x5c, 0x39, 0xac, 0x9f, 0xca,
+	0x0f, 0x41, 0x8d, 0x3c, 0xb3, 0x82, 0x63, 0x44, 0xe1, 0x41, 0x52, 0xab, 0x15, 0xd5, 0xc0, 0x50,
+	0x37, 0xa0, 0x32, 0x72, 0xec, 0xae, 0x4d, 0x3b, 0xad, 0x8e, 0xdd, 0xed, 0x86, 0x59, 0xaf, 0x80,
+	0x31, 0xc2, 0xb7, 0x01, 0xbe, 0xf3, 0x5d, 0x87, 0xe1, 0x7d, 0xd1, 0xb9, 0xac, 0x33, 0x8d, 0x4a,
+	0xe2, 0x99, 0x3a, 0xae, 0xc1, 0x91, 0x6f, 0xfc, 0x56, 0x81, 0x7a, 0x6c, 0xba, 0xb8, 0x4c, 0x0c,
+	0x6d, 0xe7, 0x9f, 0x63, 0x7b, 0x61, 0x40, 0xe6, 0x27, 0xa1, 0x05, 0xc3, 0x77, 0x2d, 0xbd, 0x56,
+	0x98, 0xd1, 0x27, 0xf7, 0xa1, 0x1a, 0xda, 0x84, 0x33, 0xa8, 0xb2, 0xf5, 0xf3, 0xd1, 0x7a, 0x49,
+	0x47, 0x66, 0xa5, 0x1d, 0x4f, 0x7c, 0x63, 0x09, 0xca, 0x3b, 0x7e, 0xfb, 0x24, 0xf4, 0xae, 0x3a,
+	0xa8, 0x5d, 0xfb, 0x94, 0xe9, 0x4e, 0x33, 0x71, 0x68, 0x3c, 0x80, 0x0a, 0x5f, 0x20, 0x64, 0x98,
+	0x87, 0x02, 0xf5, 0x3c, 0xd7, 0x13, 0xfa, 0xe5, 0x13, 0x84, 0x76, 0xed, 0x53, 0xda, 0x89, 0x0a,
+	0x4f, 0x9c, 0x60, 0x9a, 0xc3, 0x73, 0x82, 0x4b, 0x44, 0xbf, 0x1d, 0xa8, 0x3f, 0x1b, 0x05, 0x22,
+	0x8b, 0x16, 0x5b, 0xa2, 0x57, 0x4a, 0x91, 0x5f, 0xa9, 0x6b, 0x90, 0x0f, 0xac, 0x5e, 0xa8, 0x1c,
+	0x8d, 0x11, 0x3a, 0xb4, 0x7a, 0x26, 0x83, 0x1a, 0x3f, 0x81, 0xb9, 0xc7, 0x54, 0xd0, 0xf1, 0xa5,
+	0x94, 0x22, 0xec, 0x72, 0x29, 0x17, 0x74, 0xb9, 0xc6, 0xbd, 0xc4, 0xf9, 0x49, 0x2f, 0xb1, 0x5c,
+	0xf1, 0x1a, 0xcf, 0xa1, 0x7e, 0x68, 0xf5, 0x92, 0x52, 0x4c, 0xd5, 0x19, 0xba, 0x58, 0xa8, 0x79,
+	0x20, 0x18, 0xd4, 0x93, 0x52, 0x19, 0x4f, 0xf9, 0x83, 0x71, 0x68, 0xf5, 0x22, 0x41, 0x17, 0xa0,
+	0x38, 0xf4, 0x68, 0x68, 0x4a, 0xdd, 0x14, 0x33, 0x72, 0x13, 0xaa, 0xa2, 0x0f, 0xc2, 0x69, 0x08,
+	0x7b, 0x25, 0x81, 0xc6, 0x2e, 0xd4, 0x63, 0x82, 0xc2, 0xee, 0x75, 0x50, 0x03, 0xab, 0x17, 0x5e,
+	0xdd, 0xc0, 0xea, 0x49, 0xf2, 0xe4, 0xce, 0x95, 0xc7, 0xf8, 0x02, 0xe6, 0xb9, 0x0b, 0xbc, 0x91,
+	0x25, 0x8c, 0x43, 0xb8, 0x9a, 0xda, 0x2e, 0xd8, 0x99, 0xd2, 0x92, 0xf3, 0x50, 0x90, 0x4d, 0xc8,
+	0x27, 0xc6, 0xfb, 0xa1, 0x5f, 0xca, 0x2a, 0x23, 0x42, 0xf3, 0x0a, 0xeb, 0x96, 0x46, 0xfa, 0x96,
+	0x17, 0xf2, 0xb3, 0x8d, 0xfb, 0x40, 0x36, 0x8f, 0x69, 0xfb, 0xe4, 0xf2, 0xe6, 0x35, 0x3e, 0x86,
+	0x2b, 0x89, 0xad, 0x42, 0x9a, 0x05, 0x28, 0xd2, 0x53, 0xdb, 0x0f, 0x7c, 0x71, 0xf3, 0xc4, 0xcc,
+	0xf8, 0x21, 0x07, 0xe5, 0xb0, 0xb7, 0xd8, 0xa1, 0xa7, 0xe4, 0x5e, 0x5a, 0xea, 0x77, 0xa4, 0x43,
+	0xd8, 0x12, 0x31, 0xf6, 0xf9, 0xf7, 0x8a, 0x48, 0x0f, 0xab, 0x09, 0xb7, 0x6a, 0x66, 0x76, 0xa1,
+	0x7c, 0x7c, 0x0b, 0x5b, 0xd7, 0xdc, 0x85, 0x8a, 0x4c, 0x68, 0x4c, 0xe0, 0x7e, 0x37, 0xf9, 0x6d,
+	0x23, 0xd5, 0xbe, 0x8c, 0x3f, 0x6f, 0x34, 0xb7, 0x40, 0x8f, 0xa8, 0x8f, 0xa1, 0x73, 0x23, 0x49,
+	0x27, 0xa1, 0xb5, 0x98, 0x0a, 0xb6, 0xd1, 0x67, 0x37, 0xdd, 0xc1, 0xd0, 0x6a, 0x63, 0x41, 0xca,
+	0x8a, 0xad, 0x06, 0x94, 0xbc, 0x91, 0xe3, 0xd8, 0x4e, 0x4f, 0x68, 0x2d, 0x9c, 0xca, 0x9f, 0x2a,
+	0x72, 0x6f, 0xf6, 0xa9, 0x42, 0xbd, 0xc4, 0xa7, 0x8a, 0x0f, 0x61, 0x4e, 0xa8, 0xb9, 0xd5, 0xe6,
+	0x1c, 0x8a, 0x6f, 0x1d, 0x79, 0xb3, 0x2e, 0x10, 0x9b, 0x21, 0x1c, 0xd3, 0x47, 0x54, 0xb0, 0xb4,
+	0x92, 0x3f, 0x9d, 0x55, 0x84, 0x26, 0x96, 0xd9, 0x68, 0x18, 0xea, 0xb7, 0x06, 0xd4, 0xeb, 0x51,
+	0xde, 0x0a, 0xc8, 0x9b, 0x55, 0x01, 0x7d, 0xc2, 0x80, 0xe4, 0x7d, 0xa8, 0x31, 0x8f, 0x6e, 0x79,
+	0x14, 0x73, 0xdc, 0x80, 0x3a, 0xe2, 0x2b, 0xd7, 0x2c, 0x03, 0x9b, 0x21, 0x34, 0x8e, 0xda, 0x9a,
+	0x14, 0xb5, 0x6f, 0x7d, 0xc6, 0x3f, 0x4d, 0xb0, 0xef, 0x09, 0x15, 0xd0, 0xcc, 0xed, 0x83, 0x6d,
+	0xf3, 0x9b, 0xed, 0xad, 0xfa, 0x0c, 0xd1, 0x20, 0xbf, 0xb3, 0xbb, 0xb7, 0x5d, 0x57, 0x48, 0x09,
+	0xd4, 0xad, 0x5d, 0xb3, 0x9e, 0x23, 0x65, 0x28, 0x1d, 0x7c, 0xfb, 0x64, 0x6f, 0x77, 0xff, 0xeb,
+	0xba, 0x7a, 0xeb, 0x03, 0xd0, 0xa3, 0xdc, 0x1e, 0x17, 0xef, 0x3f, 0xdd, 0xdf, 0xe6, 0xdb, 0xf0,
+	0x3d, 0xac, 0x2b, 0x38, 0xda, 0xdb, 0xdd, 0xdf, 0xae, 0xe7, 0x6e, 0xed, 0x41, 0x25, 0x4c, 0x44,
+	0x9f, 0x60, 0xca, 0x7d, 0x25, 0x4e, 0x6f, 0x5b, 0xfb, 0x4f, 0xcd, 0x27, 0x8f, 0xf6, 0xea, 0x33,
+	0x64, 0x0e, 0xaa, 0x11, 0x70, 0xe7, 0xd1, 0xc1, 0x61, 0x5d, 0x21, 0xf3, 0x50, 0x8f, 0x40, 0xe6,
+	0xf6, 0xe6, 0x73, 0xf3, 0x60, 0xbb, 0x9e, 0x5b, 0xfb, 0x4b, 0x15, 0xd4, 0x47, 0xcf, 0x76, 0xc9,
+	0xff, 0x00, 0xc4, 0x4d, 0x71, 0xb2, 0xc0, 0xf3, 0xc5, 0x74, 0x97, 0xbc, 0xb9, 0x90, 0x31, 0xe0,
+	0x36, 0x7e, 0xb5, 0x36, 0x66, 0xc8, 0x3d, 0x28, 0x4b, 0xbd, 0x6b, 0xf2, 0x16, 0x23, 0x90, 0xed,
+	0x66, 0x37, 0x93, 0x6d, 0x5c, 0x63, 0x86, 0xdc, 0x07, 0x2d, 0x6c, 0xff, 0x12, 0xfe, 0xc0, 0xa6,
+	0x5a, 0xd5, 0xcd, 0xab, 0x29, 0xa8, 0x88, 0x1a, 0x33, 0xc8, 0x73, 0xdc, 0xd1, 0x15, 0x3c, 0x67,
+	0x5a, 0xbc, 0x17, 0xf0, 0xfc, 0x00, 0xb4, 0xb0, 0xd3, 0x27, 0x8e, 0x4e, 0x35, 0xfe, 0x2e, 0xde,
+	0x1b, 0xf6, 0x58, 0xc5, 0xde, 0x54, 0xcb, 0xf5, 0x82, 0xbd, 0x9f, 0x82, 0x1e, 0xf5, 0x4d, 0x49,
+	0x2c, 0x5d, 0x62, 0x77, 0x2d, 0xd9, 0xa0, 0xf4, 0xd9, 0xb6, 0xb2, 0xd4, 0x28, 0x14, 0x2a, 0xce,
+	0xb6, 0x0e, 0x9b, 0x72, 0xb2, 0x6f, 0xcc, 0x90, 0x0d, 0xa8, 0xc8, 0x9d, 0x2c, 0xd2, 0x10, 0x19,
+	0x42, 0xa6, 0xb9, 0x75, 0x01, 0xc7, 0x5f, 0x40, 0x35, 0xd1, 0xaf, 0x22, 0x6f, 0xcb, 0xf6, 0x4d,
+	0x52, 0x49, 0x37, 0x74, 0x8c, 0x19, 0xf2, 0x19, 0x40, 0xdc, 0xb0, 0x12, 0x86, 0xca, 0x74, 0xb0,
+	0x9a, 0xf5, 0xd4, 0x46, 0x9f, 0x33, 0x2f, 0x77, 0x41, 0x04, 0xf3, 0x63, 0x1a, 0x23, 0x17, 0x30,
+	0xbf, 0x0e, 0x65, 0xa9, 0x1b, 0x22, 0xf4, 0x96, 0xed, 0x8f, 0x8c, 0x61, 0xfc, 0x8e, 0x42, 0x36,
+	0xa1, 0x96, 0xea, 0x73, 0x90, 0x45, 0xae, 0xf8, 0xb1, 0xdd, 0x8f, 0xf1, 0x44, 0x3e, 0x85, 0xb2,
+	0xd4, 0x3f, 0x15, 0x1c, 0x64, 0x3b, 0xaa, 0x69, 0xcb, 0x09, 0xb5, 0xf1, 0x76, 0x92, 0xa4, 0xb6,
+	0x44, 0x7f, 0x49, 0xa8, 0x4d, 0xfa, 0x07, 0x0b, 0x63, 0x86, 0x7c, 0x0e, 0x7a, 0xd4, 0xdb, 0x12,
+	0x1e, 0x96, 0xee, 0x75, 0x5d, 0xa0, 0xb0, 0x48, 0xe9, 0x82, 0x80, 0xac, 0xf4, 0x69, 0x69, 0x3c,
+	0x80, 0x92, 0xe8, 0x9c, 0x90, 0x2b, 0x6c, 0x7b, 0xb2, 0x8f, 0x72, 0xfe, 0xce, 0x15, 0x85, 0x3c,
+	0x84, 0xd2, 0x63, 0x2a, 0xef, 0x4d, 0xf6, 0x7d, 0x9a, 0x8b, 0x99, 0xbd, 0x2c, 0x49, 0xe4, 0x65,
+	0x0c, 0xea, 0x3b, 0x0e, 0x46, 0x8c, 0x48, 0x22, 0x18, 0xc9, 0x84, 0x92, 0x05, 0x80, 0x31, 0x43,
+	0xd6, 0x78, 0x30, 0x62, 0xbb, 0xe2, 0x60, 0x24, 0x6f, 0x99, 0x4d, 0x6c, 0xf1, 0xf9, 0x9e, 0xb0,
+	0xa2, 0x17, 0x7b, 0x52, 0x05, 0xfe, 0x98, 0x3d, 0xeb, 0xa0, 0x85, 0x95, 0x72, 0xb8, 0x27, 0x59,
+	0xc1, 0x37, 0xaf, 0xa6, 0xa0, 0x61, 0xd0, 0xbb, 0xa3, 0x90, 0xff, 0x85, 0x5a, 0xaa, 0x13, 0x21,
+	0x5c, 0x72, 0x7c, 0x7f, 0x62, 0xcc, 0xf1, 0xf7, 0x41, 0x0b, 0x6b, 0x29, 0x71, 0x7c, 0xaa, 0x2a,
+	0x6e, 0x5e, 0x4d, 0x41, 0xb3, 0x31, 0x97, 0x6d, 0x96, 0x63, 0xee, 0x54, 0xd6, 0x25, 0x5f, 0xb0,
+	0x87, 0x8e, 0x06, 0xf4, 0x51, 0xbf, 0x4f, 0xce, 0x59, 0x76, 0xc1, 0xf6, 0xdb, 0x90, 0xc7, 0xea,
+	0x89, 0x70, 0xa7, 0x97, 0x2a, 0xad, 0xe6, 0x9c, 0x04, 0x89, 0x95, 0xb5, 0xf6, 0xe7, 0x22, 0xe8,
+	0x3c, 0xfb, 0xc1, 0x57, 0xee, 0x2e, 0xe8, 0x51, 0x31, 0x24, 0xee, 0x45, 0xba, 0x38, 0x6a, 0xca,
+	0x19, 0x13, 0x73, 0xc7, 0xfb, 0xa0, 0x47, 0x95, 0x0f, 0x91, 0xb1, 0x93, 0x1d, 0x71, 0x1b, 0x20,
+	0xda, 0xea, 0x0b, 0x6d, 0x65, 0xaa, 0xa8, 0xc9, 0x64, 0x3e, 0x67, 0x29, 0x5f, 0x82, 0xed, 0x74,
+	0x35, 0x74, 0xa1, 0xce, 0xc2, 0xe0, 0x3d, 0x4e, 0x86, 0x5a, 0x22, 0x77, 0x65, 0xb7, 0x60, 0x03,
+	0xca, 0x52, 0x52, 0x2d, 0xae, 0x4f, 0x36, 0x43, 0x6f, 0x36, 0xb2, 0x88, 0xc8, 0x4f, 0xee, 0x41,
+	0x59, 0xaa, 0xac, 0x04, 0x8d, 0x6c, 0xad, 0x95, 0xd2, 0xf6, 0x1d, 0x85, 0x7c, 0x09, 0xd5, 0x44,
+	0x85, 0x22, 0x9e, 0x9a, 0x71, 0x45, 0x4f, 0xb3, 0x39, 0x0e, 0x15, 0xb1, 0x70, 0x17, 0x8a, 0x8f,
+	0x29, 0x16, 0x5d, 0x24, 0x2a, 0xfb, 0x26, 0xab, 0xfa, 0x03, 0x00, 0xa1, 0xac, 0xe4, 0xc6, 0x31,
+	0x6a, 0x5a, 0xe7, 0xc1, 0x02, 0x93, 0x71, 0x29, 0x58, 0x48, 0x25, 0x50, 0xf3, 0x6a, 0x0a, 0x2a,
+	0x5d, 0xe2, 0x87, 0xe1, 0x3d, 0x62, 0xdb, 0xe5, 0x7b, 0x24, 0x13, 0x78, 0x2b, 0x03, 0x8f, 0xa4,
+	0x5b, 0x87, 0x92, 0x48, 0x6f, 0xdf, 0xe0, 0x1a, 0x6d, 0xc0, 0x5c, 0xfc, 0x76, 0x8b, 0x1a, 0xe0,
+	0x5c, 0x32, 0x57, 0xc2, 0x57, 0x49, 0x2a, 0x16, 0x8c, 0x99, 0x8d, 0xfa, 0x1f, 0x5e, 0x5f, 0x57,
+	0xfe, 0xf8, 0xfa, 0xba, 0xf2, 0xb7, 0xd7, 0xd7, 0x95, 0x5f, 0xfe, 0xfd, 0xfa, 0xcc, 0x51, 0x91,
+	0x6d, 0xbc, 0xfb, 0xcf, 0x01, 0x00, 0x54, 0xbe, 0x3b, 0xbe, 0xf6, 0x28, 0x00, 0x00,
 }
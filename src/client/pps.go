@@ -59,6 +59,19 @@ const (
 	// GCGenerationKey is the etcd key that stores a counter that the
 	// GC utility increments when it runs, so as to invalidate all cache.
 	GCGenerationKey = "gc-generation"
+	// CompactionLockKey is the etcd key of the lock that object compaction
+	// and garbage collection hold, so that neither of them moves or deletes
+	// objects while the other is running.
+	CompactionLockKey = "pachyderm_pfs_compaction_lock"
+	// GCTouchedPrefix is the prefix of the etcd keys that record when an
+	// object that already existed was put again, so that garbage collection
+	// doesn't delete it while it's being referenced again.
+	GCTouchedPrefix = "gc-touched/"
+	// GCDeletingPrefix is the prefix of the etcd keys that garbage
+	// collection writes for the objects that it's about to delete. An object
+	// that's put again while its key exists must be written again once
+	// garbage collection has deleted it.
+	GCDeletingPrefix = "gc-deleting/"
 )
 
 // DatumTagPrefix hashes a pipeline salt to a string of a fixed size for use as
@@ -234,6 +247,29 @@ func (c APIClient) ListJob(pipelineName string, inputCommit []*pfs.Commit) ([]*p
 	return jobInfos.JobInfo, nil
 }
 
+// ListJobPage is like ListJob, except that it returns at most 'pageSize' jobs,
+// newest first, starting after the job 'pageToken' (if it's set). It also
+// returns the token of the next page, which is empty if there are no more
+// jobs.
+func (c APIClient) ListJobPage(pipelineName string, inputCommit []*pfs.Commit, pageSize int64, pageToken string) ([]*pps.JobInfo, string, error) {
+	var pipeline *pps.Pipeline
+	if pipelineName != "" {
+		pipeline = NewPipeline(pipelineName)
+	}
+	jobInfos, err := c.PpsAPIClient.ListJob(
+		c.Ctx(),
+		&pps.ListJobRequest{
+			Pipeline:    pipeline,
+			InputCommit: inputCommit,
+			PageSize:    pageSize,
+			PageToken:   pageToken,
+		})
+	if err != nil {
+		return nil, "", sanitizeErr(err)
+	}
+	return jobInfos.JobInfo, jobInfos.NextPageToken, nil
+}
+
 // DeleteJob deletes a job.
 func (c APIClient) DeleteJob(jobID string) error {
 	_, err := c.PpsAPIClient.DeleteJob(
@@ -490,15 +526,40 @@ func (c APIClient) RerunPipeline(name string, include []*pfs.Commit, exclude []*
 	return sanitizeErr(err)
 }
 
-// GarbageCollect garbage collects unused data.  Currently GC needs to be
-// run while no data is being added or removed (which, among other things,
-// implies that there shouldn't be jobs actively running).
+// GarbageCollect garbage collects unused data.  GC deletes unused data
+// immediately, so it needs to be run while no data is being added or removed
+// (which, among other things, implies that there shouldn't be jobs actively
+// running). Use GarbageCollectWithGracePeriod to run it while data is being
+// added.
 func (c APIClient) GarbageCollect() error {
-	_, err := c.PpsAPIClient.GarbageCollect(
+	_, err := c.GarbageCollectWithGracePeriod(0, false)
+	return err
+}
+
+// GarbageCollectWithGracePeriod garbage collects data that has been unused
+// for at least gracePeriod. Data that's unused but still within the grace
+// period is deleted by a later run. If dryRun is set, nothing is deleted, and
+// the response reports what would have been.
+func (c APIClient) GarbageCollectWithGracePeriod(gracePeriod time.Duration, dryRun bool) (*pps.GarbageCollectResponse, error) {
+	request := &pps.GarbageCollectRequest{DryRun: dryRun}
+	if gracePeriod > 0 {
+		request.GracePeriod = types.DurationProto(gracePeriod)
+	}
+	response, err := c.PpsAPIClient.GarbageCollect(
 		c.Ctx(),
-		&pps.GarbageCollectRequest{},
+		request,
 	)
-	return sanitizeErr(err)
+	return response, sanitizeErr(err)
+}
+
+// StorageReport reports how much object storage each repo, commit and
+// pipeline uses, and how much deleting them would reclaim.
+func (c APIClient) StorageReport() (*pps.StorageReportResponse, error) {
+	response, err := c.PpsAPIClient.StorageReport(
+		c.Ctx(),
+		&pps.StorageReportRequest{},
+	)
+	return response, sanitizeErr(err)
 }
 
 // GetDatumTotalTime sums the timing stats from a DatumInfo
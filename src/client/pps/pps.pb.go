@@ -54,6 +54,13 @@
 		RerunPipelineRequest
 		GarbageCollectRequest
 		GarbageCollectResponse
+		StorageReportRequest
+		CommitStorage
+		RepoStorage
+		PipelineStorage
+		StorageReportResponse
+		GCCandidate
+		GCCheckpoint
 */
 package pps
 
@@ -1233,6 +1240,8 @@ func (m *Worker) GetState() WorkerState {
 
 type JobInfos struct {
 	JobInfo []*JobInfo `protobuf:"bytes,1,rep,name=job_info,json=jobInfo" json:"job_info,omitempty"`
+	// next_page_token is set if there may be more jobs after this page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (m *JobInfos) Reset()                    { *m = JobInfos{} }
@@ -1247,6 +1256,13 @@ func (m *JobInfos) GetJobInfo() []*JobInfo {
 	return nil
 }
 
+func (m *JobInfos) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type Pipeline struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
@@ -1700,6 +1716,11 @@ func (m *InspectJobRequest) GetBlockState() bool {
 type ListJobRequest struct {
 	Pipeline    *Pipeline     `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 	InputCommit []*pfs.Commit `protobuf:"bytes,2,rep,name=input_commit,json=inputCommit" json:"input_commit,omitempty"`
+	// If page_size isn't 0, at most page_size jobs are returned, newest first.
+	// To list the next page, set page_token to the previous response's
+	// next_page_token.
+	PageSize  int64  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (m *ListJobRequest) Reset()                    { *m = ListJobRequest{} }
@@ -1721,6 +1742,20 @@ func (m *ListJobRequest) GetInputCommit() []*pfs.Commit {
 	return nil
 }
 
+func (m *ListJobRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListJobRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type DeleteJobRequest struct {
 	Job *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
 }
@@ -2268,6 +2303,14 @@ func (m *RerunPipelineRequest) GetInclude() []*pfs.Commit {
 }
 
 type GarbageCollectRequest struct {
+	// If dry_run is set, nothing is deleted, and the response reports what
+	// would have been.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Unreferenced objects and tags are only deleted once they've been
+	// unreferenced for at least grace_period, so that garbage collection can
+	// run while data is being added. If it's unset, they're deleted
+	// immediately, which is only safe while no data is being added.
+	GracePeriod *google_protobuf2.Duration `protobuf:"bytes,2,opt,name=grace_period,json=gracePeriod" json:"grace_period,omitempty"`
 }
 
 func (m *GarbageCollectRequest) Reset()                    { *m = GarbageCollectRequest{} }
@@ -2275,7 +2318,28 @@ func (m *GarbageCollectRequest) String() string            { return proto.Compac
 func (*GarbageCollectRequest) ProtoMessage()               {}
 func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
 
+func (m *GarbageCollectRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *GarbageCollectRequest) GetGracePeriod() *google_protobuf2.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return nil
+}
+
 type GarbageCollectResponse struct {
+	ObjectsDeleted int64 `protobuf:"varint,1,opt,name=objects_deleted,json=objectsDeleted,proto3" json:"objects_deleted,omitempty"`
+	TagsDeleted    int64 `protobuf:"varint,2,opt,name=tags_deleted,json=tagsDeleted,proto3" json:"tags_deleted,omitempty"`
+	BytesReclaimed int64 `protobuf:"varint,3,opt,name=bytes_reclaimed,json=bytesReclaimed,proto3" json:"bytes_reclaimed,omitempty"`
+	// The number of unreferenced objects and tags that are still within the
+	// grace period, and so will be deleted by a later run.
+	ObjectsPending int64 `protobuf:"varint,4,opt,name=objects_pending,json=objectsPending,proto3" json:"objects_pending,omitempty"`
+	TagsPending    int64 `protobuf:"varint,5,opt,name=tags_pending,json=tagsPending,proto3" json:"tags_pending,omitempty"`
 }
 
 func (m *GarbageCollectResponse) Reset()                    { *m = GarbageCollectResponse{} }
@@ -2283,6 +2347,266 @@ func (m *GarbageCollectResponse) String() string            { return proto.Compa
 func (*GarbageCollectResponse) ProtoMessage()               {}
 func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
 
+func (m *GarbageCollectResponse) GetObjectsDeleted() int64 {
+	if m != nil {
+		return m.ObjectsDeleted
+	}
+	return 0
+}
+
+func (m *GarbageCollectResponse) GetTagsDeleted() int64 {
+	if m != nil {
+		return m.TagsDeleted
+	}
+	return 0
+}
+
+func (m *GarbageCollectResponse) GetBytesReclaimed() int64 {
+	if m != nil {
+		return m.BytesReclaimed
+	}
+	return 0
+}
+
+func (m *GarbageCollectResponse) GetObjectsPending() int64 {
+	if m != nil {
+		return m.ObjectsPending
+	}
+	return 0
+}
+
+func (m *GarbageCollectResponse) GetTagsPending() int64 {
+	if m != nil {
+		return m.TagsPending
+	}
+	return 0
+}
+
+type StorageReportRequest struct {
+}
+
+func (m *StorageReportRequest) Reset()                    { *m = StorageReportRequest{} }
+func (m *StorageReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*StorageReportRequest) ProtoMessage()               {}
+func (*StorageReportRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{46} }
+
+// CommitStorage is the storage used by a commit. size_bytes counts each of the
+// objects that the commit references, and exclusive_bytes counts the ones
+// that nothing else references, which deleting the commit would reclaim.
+type CommitStorage struct {
+	Commit         *pfs.Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	SizeBytes      int64       `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ExclusiveBytes int64       `protobuf:"varint,3,opt,name=exclusive_bytes,json=exclusiveBytes,proto3" json:"exclusive_bytes,omitempty"`
+	SharedBytes    int64       `protobuf:"varint,4,opt,name=shared_bytes,json=sharedBytes,proto3" json:"shared_bytes,omitempty"`
+}
+
+func (m *CommitStorage) Reset()                    { *m = CommitStorage{} }
+func (m *CommitStorage) String() string            { return proto.CompactTextString(m) }
+func (*CommitStorage) ProtoMessage()               {}
+func (*CommitStorage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{47} }
+
+func (m *CommitStorage) GetCommit() *pfs.Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *CommitStorage) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *CommitStorage) GetExclusiveBytes() int64 {
+	if m != nil {
+		return m.ExclusiveBytes
+	}
+	return 0
+}
+
+func (m *CommitStorage) GetSharedBytes() int64 {
+	if m != nil {
+		return m.SharedBytes
+	}
+	return 0
+}
+
+// RepoStorage is the storage used by a repo's commits. exclusive_bytes is
+// what deleting the repo would reclaim.
+type RepoStorage struct {
+	Repo           *pfs.Repo        `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	SizeBytes      int64            `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ExclusiveBytes int64            `protobuf:"varint,3,opt,name=exclusive_bytes,json=exclusiveBytes,proto3" json:"exclusive_bytes,omitempty"`
+	Commits        []*CommitStorage `protobuf:"bytes,4,rep,name=commits" json:"commits,omitempty"`
+}
+
+func (m *RepoStorage) Reset()                    { *m = RepoStorage{} }
+func (m *RepoStorage) String() string            { return proto.CompactTextString(m) }
+func (*RepoStorage) ProtoMessage()               {}
+func (*RepoStorage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{48} }
+
+func (m *RepoStorage) GetRepo() *pfs.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RepoStorage) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *RepoStorage) GetExclusiveBytes() int64 {
+	if m != nil {
+		return m.ExclusiveBytes
+	}
+	return 0
+}
+
+func (m *RepoStorage) GetCommits() []*CommitStorage {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+// PipelineStorage is the storage used by the objects that a pipeline's datum
+// tags refer to (the pipeline's datum cache). exclusive_bytes is what
+// deleting the pipeline would reclaim.
+type PipelineStorage struct {
+	Pipeline       *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	Tags           int64     `protobuf:"varint,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	SizeBytes      int64     `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ExclusiveBytes int64     `protobuf:"varint,4,opt,name=exclusive_bytes,json=exclusiveBytes,proto3" json:"exclusive_bytes,omitempty"`
+}
+
+func (m *PipelineStorage) Reset()                    { *m = PipelineStorage{} }
+func (m *PipelineStorage) String() string            { return proto.CompactTextString(m) }
+func (*PipelineStorage) ProtoMessage()               {}
+func (*PipelineStorage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{49} }
+
+func (m *PipelineStorage) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *PipelineStorage) GetTags() int64 {
+	if m != nil {
+		return m.Tags
+	}
+	return 0
+}
+
+func (m *PipelineStorage) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *PipelineStorage) GetExclusiveBytes() int64 {
+	if m != nil {
+		return m.ExclusiveBytes
+	}
+	return 0
+}
+
+type StorageReportResponse struct {
+	Repos     []*RepoStorage     `protobuf:"bytes,1,rep,name=repos" json:"repos,omitempty"`
+	Pipelines []*PipelineStorage `protobuf:"bytes,2,rep,name=pipelines" json:"pipelines,omitempty"`
+	// The total size of the objects that are referenced by any commit or tag
+	SizeBytes int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (m *StorageReportResponse) Reset()                    { *m = StorageReportResponse{} }
+func (m *StorageReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*StorageReportResponse) ProtoMessage()               {}
+func (*StorageReportResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{50} }
+
+func (m *StorageReportResponse) GetRepos() []*RepoStorage {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *StorageReportResponse) GetPipelines() []*PipelineStorage {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *StorageReportResponse) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+// GCCandidate records when the garbage collector first found an object or
+// tag to be unreferenced.
+type GCCandidate struct {
+	UnreferencedSince *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=unreferenced_since,json=unreferencedSince" json:"unreferenced_since,omitempty"`
+}
+
+func (m *GCCandidate) Reset()                    { *m = GCCandidate{} }
+func (m *GCCandidate) String() string            { return proto.CompactTextString(m) }
+func (*GCCandidate) ProtoMessage()               {}
+func (*GCCandidate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{51} }
+
+func (m *GCCandidate) GetUnreferencedSince() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.UnreferencedSince
+	}
+	return nil
+}
+
+// GCCheckpoint records the progress of garbage collection's mark phase, so
+// that a run that doesn't finish can be resumed by the next one.
+type GCCheckpoint struct {
+	// started is when the run that's being resumed started marking.
+	Started *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=started" json:"started,omitempty"`
+	// filter is the object holding the Bloom filter of the objects that have
+	// been marked as active.
+	Filter *pfs.Object `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+	// repos are the repos whose commits have all been marked.
+	Repos []string `protobuf:"bytes,3,rep,name=repos" json:"repos,omitempty"`
+}
+
+func (m *GCCheckpoint) Reset()                    { *m = GCCheckpoint{} }
+func (m *GCCheckpoint) String() string            { return proto.CompactTextString(m) }
+func (*GCCheckpoint) ProtoMessage()               {}
+func (*GCCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{52} }
+
+func (m *GCCheckpoint) GetStarted() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *GCCheckpoint) GetFilter() *pfs.Object {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GCCheckpoint) GetRepos() []string {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Secret)(nil), "pps.Secret")
 	proto.RegisterType((*Transform)(nil), "pps.Transform")
@@ -2330,6 +2654,13 @@ func init() {
 	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
 	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
 	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
+	proto.RegisterType((*StorageReportRequest)(nil), "pps.StorageReportRequest")
+	proto.RegisterType((*CommitStorage)(nil), "pps.CommitStorage")
+	proto.RegisterType((*RepoStorage)(nil), "pps.RepoStorage")
+	proto.RegisterType((*PipelineStorage)(nil), "pps.PipelineStorage")
+	proto.RegisterType((*StorageReportResponse)(nil), "pps.StorageReportResponse")
+	proto.RegisterType((*GCCandidate)(nil), "pps.GCCandidate")
+	proto.RegisterType((*GCCheckpoint)(nil), "pps.GCCheckpoint")
 	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
 	proto.RegisterEnum("pps.DatumState", DatumState_name, DatumState_value)
 	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
@@ -2367,6 +2698,8 @@ type APIClient interface {
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
 	// Garbage collection
 	GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error)
+	// StorageReport reports which repos, commits and pipelines use storage
+	StorageReport(ctx context.Context, in *StorageReportRequest, opts ...grpc.CallOption) (*StorageReportResponse, error)
 }
 
 type aPIClient struct {
@@ -2562,6 +2895,15 @@ func (c *aPIClient) GarbageCollect(ctx context.Context, in *GarbageCollectReques
 	return out, nil
 }
 
+func (c *aPIClient) StorageReport(ctx context.Context, in *StorageReportRequest, opts ...grpc.CallOption) (*StorageReportResponse, error) {
+	out := new(StorageReportResponse)
+	err := grpc.Invoke(ctx, "/pps.API/StorageReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for API service
 
 type APIServer interface {
@@ -2585,6 +2927,8 @@ type APIServer interface {
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
 	// Garbage collection
 	GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error)
+	// StorageReport reports which repos, commits and pipelines use storage
+	StorageReport(context.Context, *StorageReportRequest) (*StorageReportResponse, error)
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -2918,6 +3262,24 @@ func _API_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_StorageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).StorageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/StorageReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StorageReport(ctx, req.(*StorageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pps.API",
 	HandlerType: (*APIServer)(nil),
@@ -2990,6 +3352,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GarbageCollect",
 			Handler:    _API_GarbageCollect_Handler,
 		},
+		{
+			MethodName: "StorageReport",
+			Handler:    _API_StorageReport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4300,6 +4666,12 @@ func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
 	return i, nil
 }
 
@@ -4924,6 +5296,17 @@ func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
 	return i, nil
 }
 
@@ -5698,6 +6081,26 @@ func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DryRun {
+		dAtA[i] = 0x8
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.GracePeriod != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.GracePeriod.Size()))
+		n80, err := m.GracePeriod.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
+	}
 	return i, nil
 }
 
@@ -5716,26 +6119,333 @@ func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObjectsDeleted != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ObjectsDeleted))
+	}
+	if m.TagsDeleted != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TagsDeleted))
+	}
+	if m.BytesReclaimed != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.BytesReclaimed))
+	}
+	if m.ObjectsPending != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ObjectsPending))
+	}
+	if m.TagsPending != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TagsPending))
+	}
 	return i, nil
 }
 
-func encodeFixed64Pps(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Pps(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
+func (m *StorageReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *CommitStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitStorage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Commit.Size()))
+		n81, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.ExclusiveBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ExclusiveBytes))
+	}
+	if m.SharedBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SharedBytes))
+	}
+	return i, nil
+}
+
+func (m *RepoStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoStorage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
+		n82, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.ExclusiveBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ExclusiveBytes))
+	}
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PipelineStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineStorage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n83, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	if m.Tags != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Tags))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.ExclusiveBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ExclusiveBytes))
+	}
+	return i, nil
+}
+
+func (m *StorageReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, msg := range m.Pipelines {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	}
+	return i, nil
+}
+
+func (m *GCCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GCCandidate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.UnreferencedSince != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.UnreferencedSince.Size()))
+		n84, err := m.UnreferencedSince.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	return i, nil
+}
+
+func (m *GCCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GCCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Started != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
+		n85, err := m.Started.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	if m.Filter != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Filter.Size()))
+		n86, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
+	}
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Pps(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Pps(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
 }
 func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
@@ -6298,6 +7008,10 @@ func (m *JobInfos) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -6549,6 +7263,13 @@ func (m *ListJobRequest) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -6848,17 +7569,156 @@ func (m *RerunPipelineRequest) Size() (n int) {
 func (m *GarbageCollectRequest) Size() (n int) {
 	var l int
 	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.GracePeriod != nil {
+		l = m.GracePeriod.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
 func (m *GarbageCollectResponse) Size() (n int) {
 	var l int
 	_ = l
+	if m.ObjectsDeleted != 0 {
+		n += 1 + sovPps(uint64(m.ObjectsDeleted))
+	}
+	if m.TagsDeleted != 0 {
+		n += 1 + sovPps(uint64(m.TagsDeleted))
+	}
+	if m.BytesReclaimed != 0 {
+		n += 1 + sovPps(uint64(m.BytesReclaimed))
+	}
+	if m.ObjectsPending != 0 {
+		n += 1 + sovPps(uint64(m.ObjectsPending))
+	}
+	if m.TagsPending != 0 {
+		n += 1 + sovPps(uint64(m.TagsPending))
+	}
 	return n
 }
 
-func sovPps(x uint64) (n int) {
-	for {
+func (m *StorageReportRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommitStorage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	if m.ExclusiveBytes != 0 {
+		n += 1 + sovPps(uint64(m.ExclusiveBytes))
+	}
+	if m.SharedBytes != 0 {
+		n += 1 + sovPps(uint64(m.SharedBytes))
+	}
+	return n
+}
+
+func (m *RepoStorage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	if m.ExclusiveBytes != 0 {
+		n += 1 + sovPps(uint64(m.ExclusiveBytes))
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PipelineStorage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Tags != 0 {
+		n += 1 + sovPps(uint64(m.Tags))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	if m.ExclusiveBytes != 0 {
+		n += 1 + sovPps(uint64(m.ExclusiveBytes))
+	}
+	return n
+}
+
+func (m *StorageReportResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	return n
+}
+
+func (m *GCCandidate) Size() (n int) {
+	var l int
+	_ = l
+	if m.UnreferencedSince != nil {
+		l = m.UnreferencedSince.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *GCCheckpoint) Size() (n int) {
+	var l int
+	_ = l
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPps(x uint64) (n int) {
+	for {
 		n++
 		x >>= 7
 		if x == 0 {
@@ -10986,6 +11846,35 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -12905,6 +13794,54 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15139,6 +16076,59 @@ func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GarbageCollectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GracePeriod == nil {
+				m.GracePeriod = &google_protobuf2.Duration{}
+			}
+			if err := m.GracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15189,6 +16179,942 @@ func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GarbageCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsDeleted", wireType)
+			}
+			m.ObjectsDeleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectsDeleted |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsDeleted", wireType)
+			}
+			m.TagsDeleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TagsDeleted |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesReclaimed", wireType)
+			}
+			m.BytesReclaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesReclaimed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsPending", wireType)
+			}
+			m.ObjectsPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectsPending |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsPending", wireType)
+			}
+			m.TagsPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TagsPending |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveBytes", wireType)
+			}
+			m.ExclusiveBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExclusiveBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedBytes", wireType)
+			}
+			m.SharedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharedBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveBytes", wireType)
+			}
+			m.ExclusiveBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExclusiveBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, &CommitStorage{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			m.Tags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tags |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveBytes", wireType)
+			}
+			m.ExclusiveBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExclusiveBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &RepoStorage{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipelines = append(m.Pipelines, &PipelineStorage{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnreferencedSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnreferencedSince == nil {
+				m.UnreferencedSince = &google_protobuf1.Timestamp{}
+			}
+			if err := m.UnreferencedSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &pfs.Object{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15318,211 +17244,238 @@ var (
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptorPps) }
 
 var fileDescriptorPps = []byte{
-	// 3295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4f, 0x6f, 0xdc, 0xc6,
-	0x15, 0xd7, 0xfe, 0x5f, 0xbe, 0xe5, 0xae, 0x56, 0xa3, 0x3f, 0xa6, 0xd7, 0xb1, 0x24, 0xd3, 0xb0,
-	0x63, 0x1b, 0xa9, 0x9c, 0xc8, 0xa9, 0x9b, 0x26, 0x69, 0x12, 0xfd, 0xb3, 0x21, 0x59, 0x75, 0x16,
-	0x94, 0x9d, 0x1e, 0x09, 0x2e, 0x39, 0xbb, 0xa2, 0xcd, 0x25, 0x19, 0x92, 0x2b, 0x47, 0x39, 0xf5,
-	0x1b, 0x14, 0x6d, 0x81, 0xa2, 0xe8, 0xb5, 0x40, 0x4f, 0x3d, 0xf4, 0x5c, 0xf4, 0xd8, 0x02, 0x39,
-	0xf6, 0x13, 0x18, 0x85, 0x7b, 0xed, 0x07, 0xe8, 0xa5, 0x40, 0x31, 0x6f, 0x86, 0x5c, 0x72, 0x97,
-	0x5a, 0x49, 0x71, 0x7b, 0x10, 0xc0, 0x79, 0xf3, 0x66, 0xe6, 0xcd, 0x9b, 0x79, 0xbf, 0xf7, 0x9b,
-	0xb7, 0x82, 0x25, 0xd3, 0xb1, 0xa9, 0x1b, 0xdd, 0xf7, 0xfd, 0x90, 0xfd, 0x6d, 0xf8, 0x81, 0x17,
-	0x79, 0xa4, 0xe4, 0xfb, 0x61, 0xe7, 0xda, 0xc0, 0xf3, 0x06, 0x0e, 0xbd, 0x8f, 0xa2, 0xde, 0xa8,
-	0x7f, 0x9f, 0x0e, 0xfd, 0xe8, 0x94, 0x6b, 0x74, 0xd6, 0x26, 0x3b, 0x23, 0x7b, 0x48, 0xc3, 0xc8,
-	0x18, 0xfa, 0x42, 0x61, 0x75, 0x52, 0xc1, 0x1a, 0x05, 0x46, 0x64, 0x7b, 0xae, 0xe8, 0x5f, 0x1a,
-	0x78, 0x03, 0x0f, 0x3f, 0xef, 0xb3, 0xaf, 0x58, 0x1a, 0x9b, 0xd3, 0x0f, 0xd9, 0x1f, 0x97, 0xaa,
-	0x7d, 0xa8, 0x1e, 0x51, 0x33, 0xa0, 0x11, 0x21, 0x50, 0x76, 0x8d, 0x21, 0x55, 0x0a, 0xeb, 0x85,
-	0x3b, 0x92, 0x86, 0xdf, 0xe4, 0x3a, 0xc0, 0xd0, 0x1b, 0xb9, 0x91, 0xee, 0x1b, 0xd1, 0xb1, 0x52,
-	0xc4, 0x1e, 0x09, 0x25, 0x5d, 0x23, 0x3a, 0x26, 0x57, 0xa0, 0x46, 0xdd, 0x13, 0xfd, 0xc4, 0x08,
-	0x94, 0x12, 0xf6, 0x55, 0xa9, 0x7b, 0xf2, 0x95, 0x11, 0x90, 0x36, 0x94, 0x5e, 0xd2, 0x53, 0xa5,
-	0x8c, 0x42, 0xf6, 0xa9, 0xfe, 0xad, 0x08, 0xd2, 0xb3, 0xc0, 0x70, 0xc3, 0xbe, 0x17, 0x0c, 0xc9,
-	0x12, 0x54, 0xec, 0xa1, 0x31, 0x88, 0x17, 0xe3, 0x0d, 0x36, 0xca, 0x1c, 0x5a, 0x4a, 0x71, 0xbd,
-	0xc4, 0x46, 0x99, 0x43, 0x8b, 0xdc, 0x85, 0x12, 0x75, 0x4f, 0x94, 0xd2, 0x7a, 0xe9, 0x4e, 0x63,
-	0xf3, 0xca, 0x06, 0xf3, 0x62, 0x32, 0xc9, 0xc6, 0x9e, 0x7b, 0xb2, 0xe7, 0x46, 0xc1, 0xa9, 0xc6,
-	0x74, 0xc8, 0x2d, 0xa8, 0x85, 0xb8, 0x91, 0x50, 0x29, 0xa3, 0x7a, 0x03, 0xd5, 0xf9, 0xe6, 0xb4,
-	0xb8, 0x8f, 0xad, 0x1c, 0x46, 0x96, 0xed, 0x2a, 0x15, 0x5c, 0x85, 0x37, 0xc8, 0x7b, 0x40, 0x0c,
-	0xd3, 0xa4, 0x7e, 0xa4, 0x07, 0x34, 0x1a, 0x05, 0xae, 0x6e, 0x7a, 0x16, 0x55, 0xaa, 0xeb, 0xa5,
-	0x3b, 0x25, 0xad, 0xcd, 0x7b, 0x34, 0xec, 0xd8, 0xf1, 0x2c, 0xca, 0xe6, 0xb0, 0x68, 0x6f, 0x34,
-	0x50, 0x6a, 0xeb, 0x85, 0x3b, 0x75, 0x8d, 0x37, 0xd8, 0x1c, 0xb8, 0x0d, 0xdd, 0x1f, 0x39, 0x8e,
-	0x1e, 0xdb, 0x22, 0xe1, 0x32, 0x6d, 0xec, 0xe9, 0x8e, 0x1c, 0x87, 0xdb, 0x13, 0x76, 0x1e, 0x42,
-	0x3d, 0xb6, 0x3f, 0xf6, 0x56, 0x21, 0xf1, 0x16, 0x5b, 0xe1, 0xc4, 0x70, 0x46, 0x54, 0xb8, 0x9c,
-	0x37, 0x3e, 0x2e, 0x7e, 0x54, 0x50, 0x3b, 0x50, 0xdd, 0x1b, 0x04, 0x34, 0x0c, 0xd9, 0xa8, 0xe7,
-	0xda, 0x61, 0x3c, 0xea, 0xb9, 0x76, 0xa8, 0x5e, 0x87, 0xd2, 0x81, 0xd7, 0x23, 0x2b, 0x50, 0xb4,
-	0x2d, 0x2e, 0xdf, 0xae, 0xbe, 0x79, 0xbd, 0x56, 0xdc, 0xdf, 0xd5, 0x8a, 0xb6, 0xa5, 0x1e, 0x41,
-	0xed, 0x88, 0x06, 0x27, 0xb6, 0x49, 0xc9, 0x4d, 0x68, 0xda, 0x6e, 0x44, 0x03, 0xd7, 0x70, 0x74,
-	0xdf, 0x0b, 0x22, 0xd4, 0xae, 0x68, 0x72, 0x2c, 0xec, 0x7a, 0x41, 0xc4, 0x94, 0xe8, 0x37, 0x69,
-	0xa5, 0x22, 0x57, 0x8a, 0x85, 0x4c, 0x49, 0xfd, 0x63, 0x01, 0xa4, 0xad, 0xc8, 0x1b, 0xee, 0xbb,
-	0xfe, 0x28, 0xff, 0x0e, 0x11, 0x28, 0x07, 0xd4, 0xf7, 0xc4, 0x56, 0xf0, 0x9b, 0xac, 0x40, 0xb5,
-	0x17, 0x18, 0xae, 0x79, 0x1c, 0xdf, 0x1b, 0xde, 0x62, 0x72, 0xd3, 0x1b, 0x0e, 0xed, 0x48, 0x5c,
-	0x1d, 0xd1, 0x62, 0x73, 0x0c, 0x1c, 0xaf, 0xa7, 0x54, 0xf8, 0x1c, 0xec, 0x9b, 0xc9, 0x1c, 0xe3,
-	0xdb, 0x53, 0xa5, 0x8a, 0x87, 0x80, 0xdf, 0x64, 0x0d, 0x1a, 0xfd, 0xc0, 0x1b, 0xea, 0x62, 0x92,
-	0x1a, 0xaa, 0x03, 0x13, 0xed, 0xa0, 0x44, 0xfd, 0x65, 0x01, 0xa4, 0x9d, 0xc0, 0x73, 0x2f, 0x6d,
-	0xae, 0x98, 0xb1, 0x34, 0x69, 0x56, 0xe8, 0x53, 0x53, 0x18, 0x8b, 0xdf, 0xe4, 0x7d, 0x76, 0xc1,
-	0x8c, 0x20, 0x42, 0x5b, 0x1b, 0x9b, 0x9d, 0x0d, 0x1e, 0xac, 0x1b, 0x71, 0xb0, 0x6e, 0x3c, 0x8b,
-	0xa3, 0x59, 0xe3, 0x8a, 0xea, 0xaf, 0x0b, 0x50, 0xe1, 0xf6, 0xa8, 0x50, 0x36, 0x22, 0x6f, 0x88,
-	0xf6, 0x34, 0x36, 0x5b, 0x78, 0x81, 0x13, 0xe7, 0x6a, 0xd8, 0x47, 0xd6, 0xa1, 0x62, 0x06, 0x5e,
-	0x18, 0x62, 0x98, 0x34, 0x36, 0x01, 0x95, 0xb8, 0x02, 0xef, 0x60, 0x1a, 0x23, 0xd7, 0xf6, 0x5c,
-	0x11, 0x36, 0x19, 0x0d, 0xec, 0x60, 0xeb, 0x98, 0x81, 0xe7, 0xa2, 0xdd, 0xf1, 0x3a, 0x89, 0x57,
-	0x34, 0xec, 0x53, 0x5f, 0x42, 0xfd, 0xc0, 0xeb, 0x71, 0xbb, 0x6e, 0x26, 0xfb, 0xe7, 0x96, 0x35,
-	0x36, 0x18, 0x80, 0x70, 0x97, 0x4e, 0x9d, 0x51, 0x31, 0xe7, 0x8c, 0x4a, 0xa9, 0x33, 0x8a, 0x9d,
-	0x5e, 0x1e, 0x3b, 0x5d, 0x7d, 0x0e, 0xf3, 0x5d, 0x23, 0x30, 0x1c, 0x87, 0x3a, 0x76, 0x38, 0x3c,
-	0x62, 0x7e, 0xec, 0x40, 0xdd, 0xf4, 0xdc, 0x30, 0x32, 0x5c, 0x7e, 0xf1, 0xca, 0x5a, 0xd2, 0x26,
-	0xeb, 0xd0, 0x30, 0x3d, 0xda, 0xef, 0xdb, 0x26, 0x43, 0x34, 0x9c, 0xbd, 0xa0, 0xa5, 0x45, 0x07,
-	0xe5, 0x7a, 0xa1, 0x5d, 0x54, 0x1f, 0x80, 0x84, 0x1b, 0x78, 0x64, 0x3b, 0x78, 0xb0, 0x88, 0x62,
-	0x62, 0x5d, 0xf6, 0xcd, 0x64, 0xc7, 0x46, 0x78, 0x8c, 0x67, 0x25, 0x6b, 0xf8, 0xad, 0x7e, 0x02,
-	0x95, 0x5d, 0x23, 0x1a, 0x0d, 0xcf, 0x8a, 0x23, 0xd2, 0x81, 0xd2, 0x0b, 0xb1, 0xcf, 0xc6, 0x66,
-	0x1d, 0x9d, 0x77, 0xe0, 0xf5, 0x34, 0x26, 0x54, 0xbf, 0x2b, 0x80, 0x84, 0xa3, 0xf7, 0xdd, 0xbe,
-	0xc7, 0x4e, 0xc2, 0x62, 0x0d, 0xe1, 0x36, 0x7e, 0x12, 0xd8, 0xad, 0xf1, 0x0e, 0x72, 0x0b, 0x6f,
-	0x4b, 0xc4, 0x03, 0xbd, 0xb5, 0x39, 0x3f, 0xd6, 0x38, 0x62, 0x62, 0x8d, 0xf7, 0x92, 0x77, 0xb9,
-	0x5a, 0x88, 0x5b, 0x6d, 0x6c, 0x2e, 0xa0, 0x5a, 0x37, 0xf0, 0x4c, 0x1a, 0x86, 0x4c, 0x31, 0xe4,
-	0x8a, 0x21, 0xb9, 0x0d, 0x92, 0xdf, 0x0f, 0x75, 0x3e, 0x27, 0x3f, 0x5e, 0x09, 0x0f, 0x8b, 0xb9,
-	0x40, 0xab, 0xfb, 0x7d, 0x54, 0xa7, 0xe4, 0x06, 0x94, 0x2d, 0x23, 0x32, 0x10, 0x05, 0x1b, 0x9b,
-	0xcd, 0x44, 0x85, 0x99, 0xad, 0x61, 0x97, 0xfa, 0x09, 0x40, 0xb2, 0x93, 0x90, 0xfc, 0x00, 0x00,
-	0x2d, 0xd6, 0x6d, 0xb7, 0xef, 0x29, 0x05, 0x1c, 0xd6, 0x1a, 0x5b, 0x8b, 0xe3, 0x24, 0x2b, 0xfe,
-	0x54, 0xff, 0xc4, 0x60, 0x61, 0x30, 0x08, 0xe8, 0x80, 0xad, 0xb6, 0x04, 0x15, 0x93, 0x25, 0x0d,
-	0xf4, 0x43, 0x49, 0xe3, 0x0d, 0xe6, 0xfc, 0x21, 0x35, 0x5c, 0xdc, 0x7a, 0x41, 0xc3, 0x6f, 0x16,
-	0x69, 0x61, 0x64, 0x59, 0xf4, 0x44, 0x1c, 0xaa, 0x68, 0x91, 0xbb, 0xd0, 0xee, 0xdb, 0xfd, 0xe8,
-	0x58, 0xf7, 0x69, 0x60, 0x52, 0x37, 0xb2, 0x1d, 0xbe, 0xbd, 0x82, 0x36, 0x8f, 0xf2, 0x6e, 0x22,
-	0x26, 0x0f, 0xe1, 0x8a, 0x6b, 0xbb, 0x34, 0x3a, 0xd5, 0xa7, 0x46, 0x54, 0x70, 0xc4, 0x32, 0xef,
-	0x7e, 0x94, 0x1d, 0xa7, 0xfe, 0xaa, 0x08, 0x72, 0xda, 0xa5, 0xe4, 0x33, 0x68, 0x5a, 0xde, 0x2b,
-	0xd7, 0xf1, 0x0c, 0x4b, 0x67, 0x29, 0x58, 0x9c, 0xe2, 0xd5, 0xa9, 0x88, 0xde, 0x15, 0xe9, 0x57,
-	0x93, 0x63, 0x7d, 0x16, 0xe3, 0xe4, 0x53, 0x90, 0x7d, 0x3e, 0x1f, 0x1f, 0x5e, 0x3c, 0x6f, 0x78,
-	0x43, 0xa8, 0xe3, 0xe8, 0x8f, 0xa1, 0x31, 0xf2, 0xc7, 0x6b, 0x97, 0xce, 0x1b, 0x0c, 0x5c, 0x1b,
-	0xc7, 0xde, 0x82, 0x56, 0x62, 0x79, 0xef, 0x34, 0xa2, 0x21, 0xfa, 0xaa, 0xac, 0x25, 0xfb, 0xd9,
-	0x66, 0x42, 0x72, 0x03, 0x64, 0xb1, 0x04, 0x57, 0xaa, 0xa0, 0x92, 0x58, 0x16, 0x55, 0xd4, 0xdf,
-	0x15, 0x61, 0x39, 0x39, 0xc7, 0x8c, 0x77, 0x1e, 0xe4, 0x7b, 0x47, 0x80, 0x56, 0x3c, 0x64, 0xc2,
-	0x25, 0x1f, 0xe4, 0xba, 0x64, 0x72, 0x4c, 0xc6, 0x0f, 0xf7, 0xf3, 0xfc, 0x30, 0x39, 0x22, 0xbd,
-	0xf9, 0x1f, 0xe6, 0x6e, 0x7e, 0x7a, 0xcc, 0x84, 0x33, 0x3e, 0xc8, 0x71, 0x46, 0x8e, 0x69, 0x69,
-	0xe7, 0xfc, 0xa7, 0x00, 0xf2, 0xcf, 0xbc, 0xe0, 0x25, 0x0d, 0x98, 0x4b, 0x46, 0x21, 0xb9, 0x0b,
-	0xd2, 0x2b, 0x6c, 0xeb, 0x09, 0x70, 0xc8, 0x6f, 0x5e, 0xaf, 0xd5, 0xb9, 0xd2, 0xfe, 0xae, 0x56,
-	0xe7, 0xdd, 0xfb, 0x16, 0x59, 0x87, 0xea, 0x0b, 0xaf, 0xc7, 0xf4, 0x10, 0x2f, 0xb7, 0xa5, 0x37,
-	0xaf, 0xd7, 0x2a, 0x0c, 0x70, 0x77, 0xb5, 0xca, 0x0b, 0xaf, 0xb7, 0x6f, 0x31, 0x90, 0xc6, 0x10,
-	0x2d, 0xa5, 0x62, 0x2d, 0x41, 0x33, 0x1e, 0xa3, 0xe4, 0x43, 0xa8, 0x61, 0x0e, 0xa1, 0x96, 0xd8,
-	0xe4, 0xac, 0x74, 0x13, 0xab, 0x8e, 0xd1, 0xa4, 0x72, 0x0e, 0x9a, 0x5c, 0x07, 0xf8, 0x7a, 0x44,
-	0x47, 0x54, 0x0f, 0xed, 0x6f, 0x29, 0x26, 0xda, 0x92, 0x26, 0xa1, 0xe4, 0xc8, 0xfe, 0x96, 0xaa,
-	0x07, 0x20, 0x6b, 0x34, 0xf4, 0x46, 0x81, 0x49, 0x11, 0xb2, 0x19, 0x7f, 0xf3, 0x47, 0xb8, 0xf1,
-	0xa2, 0xc6, 0x3e, 0x59, 0x38, 0x0f, 0xe9, 0xd0, 0x0b, 0x4e, 0x45, 0x56, 0x10, 0x2d, 0xa6, 0x39,
-	0xf0, 0x47, 0x78, 0x98, 0x25, 0x8d, 0x7d, 0xaa, 0xdf, 0x49, 0x50, 0xc3, 0x7c, 0xd3, 0xf7, 0x62,
-	0x80, 0x2d, 0xe4, 0x00, 0x2c, 0x79, 0x0f, 0xa4, 0x28, 0x66, 0x80, 0x99, 0xeb, 0x93, 0xf0, 0x42,
-	0x6d, 0xac, 0x40, 0xee, 0x42, 0xdd, 0xb7, 0x7d, 0xea, 0xd8, 0x6e, 0x7c, 0x73, 0x9a, 0x7c, 0xb3,
-	0x42, 0xa8, 0x25, 0xdd, 0xe4, 0x16, 0x54, 0x6d, 0xe6, 0xdd, 0x70, 0x8c, 0x89, 0x7c, 0x5d, 0x9e,
-	0x14, 0x45, 0x27, 0x79, 0x17, 0xc0, 0x37, 0x02, 0xea, 0x46, 0x3a, 0x33, 0xb1, 0x3a, 0x61, 0xa2,
-	0xc4, 0xfb, 0x18, 0x0b, 0x4b, 0x1d, 0x4d, 0xed, 0xe2, 0x47, 0xf3, 0x10, 0xea, 0x7d, 0xdb, 0xb5,
-	0xc3, 0x63, 0x6a, 0x29, 0xf5, 0x73, 0x87, 0x25, 0xba, 0xe4, 0x7d, 0x68, 0x7a, 0xa3, 0xc8, 0x1f,
-	0x45, 0x31, 0xf5, 0x91, 0xa6, 0x13, 0xb5, 0xcc, 0x35, 0x78, 0x8b, 0xdc, 0x8c, 0x33, 0x0f, 0x60,
-	0xe6, 0x49, 0xb6, 0x9b, 0xc9, 0x3b, 0x9f, 0x43, 0xdb, 0x1f, 0xe7, 0x65, 0x1d, 0xc9, 0x8e, 0x8c,
-	0x33, 0x2f, 0x71, 0x3f, 0x66, 0x93, 0xb6, 0x36, 0xef, 0x4f, 0x64, 0xf1, 0xbb, 0xd0, 0x8e, 0x3d,
-	0xac, 0x9f, 0xd0, 0x20, 0x64, 0xb4, 0xa4, 0x89, 0x30, 0x33, 0x1f, 0xcb, 0xbf, 0xe2, 0x62, 0x72,
-	0x9b, 0x11, 0x78, 0xa4, 0xa7, 0x4a, 0x0b, 0x97, 0x90, 0x05, 0x81, 0x47, 0x99, 0x16, 0x77, 0x32,
-	0x32, 0x42, 0x91, 0x01, 0x2b, 0xf3, 0xf1, 0x1e, 0xfd, 0x70, 0x83, 0x93, 0x62, 0x4d, 0x74, 0x31,
-	0xee, 0x2a, 0xfc, 0x21, 0x78, 0xe6, 0x02, 0xde, 0x3f, 0xe1, 0x82, 0x6d, 0xce, 0x36, 0xef, 0x41,
-	0x43, 0x28, 0x21, 0xe3, 0x23, 0xa9, 0x74, 0xa9, 0x51, 0xdf, 0xd3, 0x80, 0xf7, 0xb2, 0x6f, 0xa2,
-	0x40, 0x2d, 0xa0, 0x9c, 0xd8, 0x2d, 0xa1, 0xfd, 0x71, 0x13, 0xc1, 0xd6, 0x88, 0x0c, 0x5d, 0x80,
-	0x16, 0xb5, 0x94, 0x15, 0xbc, 0xd6, 0x4d, 0x26, 0xed, 0xc6, 0x42, 0x16, 0x4b, 0xa8, 0x16, 0x79,
-	0x91, 0xe1, 0x28, 0x57, 0x78, 0x2c, 0x31, 0xc9, 0x33, 0x26, 0x20, 0x0f, 0xa1, 0x29, 0xa0, 0x23,
-	0x44, 0x2c, 0x51, 0x14, 0xbc, 0x85, 0x3c, 0x36, 0xd3, 0x20, 0xa3, 0xc9, 0xaf, 0xd2, 0x90, 0xf3,
-	0x10, 0x9a, 0x81, 0x88, 0x41, 0x7e, 0x3c, 0x57, 0x53, 0x31, 0x9d, 0x8e, 0x4e, 0x4d, 0x0e, 0xd2,
-	0xb1, 0xba, 0x0e, 0x15, 0xbc, 0xd1, 0x4a, 0x27, 0x45, 0x4d, 0x04, 0x49, 0xc4, 0x0e, 0xb2, 0x01,
-	0xe0, 0xd2, 0x57, 0xb1, 0xff, 0xae, 0xa1, 0xda, 0x3c, 0x3a, 0x87, 0xbb, 0x8f, 0xa7, 0x7c, 0x97,
-	0xbe, 0x12, 0xde, 0x5c, 0x87, 0x86, 0xed, 0x9a, 0x01, 0x1d, 0x52, 0x97, 0xed, 0xf0, 0x1d, 0xa4,
-	0x7c, 0x69, 0x11, 0xd9, 0x00, 0x19, 0x71, 0x25, 0xbe, 0xa3, 0xd7, 0xa7, 0xef, 0x68, 0x03, 0x15,
-	0xc4, 0x15, 0xbd, 0x01, 0x32, 0xba, 0x2c, 0x7c, 0x69, 0xfb, 0x3e, 0xb5, 0x94, 0x55, 0x74, 0x5a,
-	0x83, 0xc9, 0x8e, 0xb8, 0x68, 0x0c, 0x65, 0x6b, 0xe7, 0x40, 0xd9, 0x0d, 0x90, 0xa9, 0x6b, 0xf4,
-	0x1c, 0xaa, 0x73, 0xfd, 0x75, 0x6e, 0x1e, 0x97, 0xf1, 0x8c, 0xc6, 0xd8, 0xbc, 0xe1, 0x44, 0xca,
-	0x0d, 0xc1, 0xe6, 0x0d, 0x27, 0x62, 0xcc, 0xa5, 0x67, 0x44, 0xe6, 0xb1, 0xa2, 0xf2, 0xa7, 0x1e,
-	0x36, 0x18, 0xac, 0x05, 0xd4, 0x08, 0x3d, 0x57, 0xb9, 0xc9, 0x61, 0x8d, 0xb7, 0x0e, 0xca, 0xf5,
-	0x72, 0xbb, 0xa2, 0xee, 0x42, 0x95, 0x1f, 0x58, 0xee, 0xfb, 0xe2, 0x76, 0x96, 0xf1, 0xb5, 0x27,
-	0x0e, 0x38, 0x0e, 0x3d, 0xf5, 0x81, 0xe0, 0xdf, 0x8c, 0x7c, 0xbd, 0x0b, 0x75, 0x4c, 0x16, 0x63,
-	0xea, 0x25, 0x8f, 0xd1, 0xa9, 0xef, 0x69, 0xb5, 0x17, 0xfc, 0x43, 0x5d, 0x85, 0x7a, 0x0c, 0x6d,
-	0x79, 0x8b, 0xab, 0xbf, 0x2f, 0x40, 0x33, 0x56, 0xe0, 0xd4, 0xfe, 0xba, 0x78, 0xee, 0x14, 0x26,
-	0x2f, 0xff, 0xe4, 0x43, 0xad, 0x98, 0x79, 0xa8, 0xc5, 0x64, 0xbf, 0x94, 0x43, 0xf6, 0xcb, 0x39,
-	0x64, 0xbf, 0x92, 0xf2, 0xc0, 0x1a, 0x94, 0xd9, 0x8b, 0x4c, 0x80, 0x67, 0xe6, 0xf8, 0xb1, 0x43,
-	0xfd, 0x57, 0x0d, 0xe4, 0xb1, 0x95, 0x7d, 0x2f, 0x03, 0xe3, 0x85, 0xd9, 0x30, 0x7e, 0xb9, 0xfc,
-	0x70, 0x2f, 0x01, 0x7d, 0x5e, 0x33, 0x20, 0x99, 0x69, 0xb3, 0xc8, 0xff, 0x63, 0x00, 0x33, 0xa0,
-	0x46, 0x44, 0x2d, 0xdd, 0x88, 0x84, 0xf1, 0xb3, 0xc0, 0x59, 0x12, 0xda, 0x5b, 0x11, 0xb9, 0x13,
-	0x9f, 0x79, 0x0d, 0xcf, 0x3c, 0xbb, 0x4a, 0x06, 0x70, 0x6f, 0x80, 0x1c, 0x50, 0xc6, 0x48, 0x75,
-	0x1a, 0x04, 0x5e, 0x80, 0x39, 0x40, 0xd2, 0x1a, 0x5c, 0xb6, 0xc7, 0x44, 0xe4, 0x73, 0x00, 0x76,
-	0x19, 0x90, 0x43, 0xf3, 0xfa, 0x42, 0x63, 0x73, 0x7d, 0xc2, 0xee, 0xbe, 0xc7, 0xee, 0xc6, 0x0e,
-	0xaa, 0xf0, 0x1a, 0x89, 0xf4, 0x22, 0x6e, 0xe7, 0x82, 0x3a, 0x5c, 0x06, 0xd4, 0x15, 0xa8, 0xc5,
-	0x58, 0xde, 0xe0, 0x58, 0x28, 0x9a, 0xdf, 0x13, 0x9b, 0xdb, 0x39, 0xd8, 0xcc, 0x1f, 0x5f, 0x0b,
-	0x53, 0x8f, 0xaf, 0x27, 0xb0, 0x14, 0x9a, 0x86, 0x43, 0x75, 0xc6, 0xde, 0xf4, 0xe8, 0x38, 0xa0,
-	0xe1, 0xb1, 0xe7, 0x58, 0x02, 0xbc, 0x67, 0xf0, 0x63, 0x82, 0xc3, 0x76, 0xbd, 0x57, 0xee, 0xb3,
-	0x78, 0xd0, 0x34, 0x78, 0x2e, 0x5e, 0x12, 0x3c, 0x97, 0xce, 0x02, 0xcf, 0x75, 0x68, 0x58, 0x34,
-	0x34, 0x03, 0xdb, 0x67, 0x8b, 0x2b, 0xcb, 0xfc, 0x18, 0x53, 0xa2, 0x49, 0xb8, 0x5c, 0x99, 0x86,
-	0xcb, 0xeb, 0x00, 0xa6, 0x61, 0x1e, 0x0b, 0xf6, 0x75, 0x85, 0x17, 0xdf, 0x50, 0xc2, 0xd8, 0xd7,
-	0x14, 0xa2, 0x29, 0x67, 0x23, 0xda, 0xd5, 0x14, 0xa2, 0xad, 0xb2, 0x59, 0x7d, 0xa3, 0x67, 0x3b,
-	0x76, 0x74, 0x8a, 0xe8, 0x2f, 0x69, 0x29, 0xc9, 0x18, 0xf1, 0xae, 0xe5, 0x23, 0xde, 0x3b, 0x69,
-	0xc4, 0xeb, 0x7c, 0x0a, 0xad, 0xec, 0x45, 0x4b, 0x17, 0xb3, 0x2a, 0x39, 0xc5, 0xac, 0x4a, 0xaa,
-	0x98, 0x75, 0x50, 0xae, 0x97, 0xda, 0x65, 0xf5, 0x71, 0x1a, 0x93, 0x18, 0xdc, 0x3d, 0x84, 0x66,
-	0x42, 0x1a, 0x52, 0x98, 0xb7, 0x30, 0x75, 0xc9, 0x35, 0xd9, 0x4f, 0xb5, 0xd4, 0xbf, 0x56, 0xa0,
-	0xbd, 0x83, 0x41, 0xc7, 0xb8, 0x18, 0xfd, 0x7a, 0x44, 0xc3, 0x28, 0x0b, 0x08, 0x85, 0xcb, 0x10,
-	0xc6, 0xe2, 0x45, 0x09, 0x63, 0x79, 0x16, 0x61, 0xcc, 0x8b, 0xb6, 0xda, 0x65, 0xa2, 0x2d, 0xc5,
-	0x8b, 0xea, 0x17, 0xe3, 0x45, 0xd2, 0xd9, 0xb1, 0x97, 0xc7, 0xc7, 0x20, 0x9f, 0x8f, 0x4d, 0x85,
-	0x69, 0xe3, 0x7c, 0x0a, 0x25, 0xcf, 0xa2, 0x50, 0x59, 0xea, 0xdc, 0x3c, 0x9b, 0x3a, 0x4f, 0x85,
-	0x65, 0xeb, 0x92, 0x61, 0x39, 0x7f, 0x31, 0x4e, 0xd3, 0xbe, 0x2c, 0xa7, 0x59, 0x98, 0x0e, 0xd2,
-	0xc9, 0x28, 0x24, 0x67, 0x47, 0xe1, 0x62, 0x1e, 0xaf, 0x58, 0x4a, 0x45, 0x99, 0x88, 0x87, 0x2e,
-	0x2c, 0xec, 0xbb, 0x6c, 0xdf, 0x51, 0xea, 0x1a, 0xcf, 0x7a, 0x13, 0xad, 0x41, 0xa3, 0xe7, 0x78,
-	0xe6, 0x4b, 0x7d, 0x4c, 0x2c, 0xea, 0x1a, 0xa0, 0x08, 0x93, 0x8b, 0xfa, 0x12, 0x5a, 0x87, 0x76,
-	0x98, 0x9e, 0xee, 0x12, 0x19, 0x75, 0x03, 0x64, 0x74, 0x5e, 0xcc, 0xda, 0x8a, 0x71, 0x75, 0x3d,
-	0xc5, 0xda, 0x50, 0x41, 0x94, 0x58, 0x37, 0xa0, 0xbd, 0x4b, 0x1d, 0x9a, 0x09, 0xc2, 0x19, 0xd6,
-	0xab, 0xef, 0x41, 0xeb, 0x28, 0xf2, 0xfc, 0x0b, 0x6a, 0xff, 0xb9, 0x00, 0xad, 0xc7, 0x34, 0x3a,
-	0xf4, 0x06, 0xe1, 0x45, 0x5c, 0x73, 0x89, 0x78, 0x8e, 0xd9, 0x66, 0xdf, 0x76, 0x22, 0x1a, 0x84,
-	0xf8, 0xee, 0x96, 0x38, 0xdb, 0x7c, 0xc4, 0x45, 0xf8, 0x9c, 0x35, 0xc2, 0x88, 0x06, 0xc8, 0x67,
-	0xea, 0x9a, 0x68, 0x8d, 0xeb, 0x7c, 0xd5, 0x33, 0xea, 0x7c, 0x82, 0x19, 0xfe, 0xa5, 0x08, 0x70,
-	0xe8, 0x0d, 0x7e, 0x4a, 0xc3, 0xd0, 0x18, 0x60, 0x15, 0x3e, 0x09, 0xc6, 0x14, 0x55, 0x4b, 0x40,
-	0xed, 0x29, 0x63, 0x4b, 0xe3, 0x42, 0x41, 0xe9, 0x9c, 0x42, 0x41, 0x79, 0x46, 0xa1, 0xe0, 0x1e,
-	0x14, 0x93, 0xf7, 0xfe, 0x2c, 0xd2, 0x52, 0x8c, 0x42, 0x96, 0xde, 0x87, 0xdc, 0x42, 0xdc, 0x8f,
-	0xa4, 0xc5, 0xcd, 0x6c, 0x7d, 0xa3, 0x36, 0xb3, 0xbe, 0x41, 0xa0, 0x3c, 0x0a, 0x29, 0x27, 0x30,
-	0x75, 0x0d, 0xbf, 0xc9, 0x6d, 0xa8, 0x8b, 0x1a, 0xa2, 0x85, 0x18, 0x25, 0x6d, 0x37, 0xde, 0xbc,
-	0x5e, 0xab, 0xf1, 0x02, 0xe2, 0xae, 0x56, 0xe3, 0xe5, 0x43, 0x2b, 0xe5, 0x66, 0x48, 0xbb, 0x59,
-	0x7d, 0x06, 0x8b, 0x1a, 0x7f, 0x74, 0x71, 0xdf, 0x5e, 0xe0, 0xfc, 0x27, 0x0f, 0xb5, 0x38, 0x75,
-	0xa8, 0xea, 0x8f, 0x60, 0x51, 0x84, 0x5b, 0x66, 0xd6, 0x73, 0x6b, 0xb7, 0xaa, 0x0e, 0x6d, 0x16,
-	0x55, 0x17, 0xb6, 0xe5, 0x1a, 0x48, 0xbe, 0x31, 0x10, 0xe9, 0xbc, 0x88, 0x6f, 0x99, 0x3a, 0x13,
-	0x60, 0x36, 0xc7, 0xea, 0xf4, 0x80, 0x8a, 0x92, 0x08, 0x7e, 0xab, 0xa7, 0xb0, 0x90, 0x5a, 0x20,
-	0xf4, 0x3d, 0x37, 0xc4, 0x7a, 0xd8, 0xb8, 0x10, 0x1b, 0x9e, 0x51, 0x89, 0x05, 0x6b, 0x5c, 0xb9,
-	0x5d, 0x83, 0x06, 0xbe, 0x39, 0x75, 0x36, 0x67, 0x28, 0x16, 0x06, 0x14, 0x75, 0x99, 0x24, 0x77,
-	0xe9, 0x3f, 0x54, 0x60, 0x99, 0xa7, 0xd2, 0x24, 0x52, 0x2e, 0x8f, 0x1c, 0xff, 0x3f, 0x2e, 0xbe,
-	0x02, 0xd5, 0x91, 0x6f, 0x31, 0xb0, 0x13, 0x81, 0xc8, 0x5b, 0x6f, 0x9f, 0x6c, 0x2f, 0x94, 0x44,
-	0xa7, 0x32, 0x23, 0xe4, 0x64, 0xc6, 0xb3, 0x88, 0x6a, 0xe3, 0x7f, 0x42, 0x54, 0xe5, 0x4b, 0x66,
-	0xc4, 0xe6, 0x05, 0x89, 0x6a, 0xeb, 0x5c, 0xa2, 0x3a, 0x7f, 0x1e, 0x51, 0x6d, 0x9f, 0x47, 0x54,
-	0x17, 0xa6, 0x53, 0xe4, 0x3b, 0x20, 0x05, 0x54, 0x14, 0x50, 0x44, 0x0a, 0x1d, 0x0b, 0xc6, 0xc9,
-	0x72, 0x71, 0x3a, 0x59, 0xee, 0xc0, 0x8a, 0x88, 0xde, 0xef, 0x7f, 0x51, 0xd5, 0x65, 0x58, 0x64,
-	0x81, 0x36, 0x31, 0x83, 0xfa, 0x9b, 0x02, 0x2c, 0xf3, 0x54, 0xf6, 0x16, 0x41, 0xb0, 0xc6, 0x1c,
-	0xcc, 0xe6, 0x60, 0xac, 0x27, 0x8c, 0x93, 0xb3, 0x15, 0x67, 0xc8, 0x30, 0xa5, 0x80, 0x14, 0xaa,
-	0x94, 0x56, 0x40, 0xde, 0xd4, 0x86, 0x92, 0xe1, 0x38, 0xe2, 0x59, 0xcd, 0x3e, 0xd5, 0x2d, 0x58,
-	0x3a, 0x62, 0x30, 0xf8, 0x16, 0x5b, 0xfe, 0x02, 0x16, 0x59, 0xd6, 0x7d, 0x8b, 0x19, 0x7e, 0x51,
-	0x80, 0x25, 0x8d, 0x06, 0x23, 0xf7, 0x2d, 0x9c, 0x73, 0x0b, 0x6a, 0xf4, 0x1b, 0xd3, 0x19, 0x59,
-	0x34, 0x8f, 0x56, 0xc4, 0x7d, 0x4c, 0xcd, 0x76, 0xb9, 0x5a, 0x29, 0x47, 0x4d, 0xf4, 0xa9, 0x57,
-	0x60, 0xf9, 0xb1, 0x11, 0xf4, 0x8c, 0x01, 0xdd, 0xf1, 0x1c, 0x87, 0x9a, 0x51, 0x7c, 0x90, 0x0a,
-	0xac, 0x4c, 0x76, 0x70, 0x34, 0xbd, 0xa7, 0x63, 0x95, 0x85, 0xff, 0x26, 0xd6, 0x06, 0xf9, 0xe0,
-	0xcb, 0x6d, 0xfd, 0xe8, 0xd9, 0x96, 0xf6, 0x6c, 0xff, 0xe9, 0xe3, 0xf6, 0x1c, 0x99, 0x87, 0x06,
-	0x93, 0x68, 0xcf, 0x9f, 0x3e, 0x65, 0x82, 0x42, 0x2c, 0x78, 0xb4, 0xb5, 0x7f, 0xf8, 0x5c, 0xdb,
-	0x6b, 0x17, 0x63, 0xc1, 0xd1, 0xf3, 0x9d, 0x9d, 0xbd, 0xa3, 0xa3, 0x76, 0x89, 0xb4, 0x00, 0x98,
-	0xe0, 0xc9, 0xfe, 0xe1, 0xe1, 0xde, 0x6e, 0xbb, 0x7c, 0xef, 0x0b, 0xf1, 0x2b, 0x1a, 0x5f, 0x02,
-	0xa0, 0xca, 0xc6, 0xee, 0xed, 0xb6, 0xe7, 0x48, 0x03, 0x6a, 0xf1, 0xb0, 0x02, 0x36, 0x9e, 0xec,
-	0x77, 0xbb, 0x7b, 0xbb, 0xed, 0x22, 0x91, 0xa1, 0x9e, 0x18, 0x51, 0xba, 0xf7, 0x39, 0x34, 0x52,
-	0xe5, 0x21, 0xb6, 0x62, 0xf7, 0xcb, 0xdd, 0xc4, 0xa6, 0xb9, 0x58, 0x30, 0x9e, 0xab, 0x05, 0xc0,
-	0x04, 0x62, 0xa1, 0xe2, 0xbd, 0x9f, 0xa7, 0x8a, 0x3e, 0x7c, 0x8e, 0x65, 0x58, 0xe8, 0xee, 0x77,
-	0xf7, 0x0e, 0xf7, 0x9f, 0xee, 0xa5, 0xb7, 0xbb, 0x04, 0xed, 0x44, 0x3c, 0xde, 0xf3, 0x15, 0x58,
-	0x1c, 0x4b, 0xf7, 0x12, 0xf5, 0x62, 0x46, 0x3d, 0xf6, 0x48, 0x89, 0x2c, 0xc2, 0x7c, 0x22, 0xed,
-	0x6e, 0x3d, 0x3f, 0x62, 0x5e, 0xd8, 0xfc, 0x77, 0x1d, 0x4a, 0x5b, 0xdd, 0x7d, 0xb2, 0x01, 0x52,
-	0xf2, 0x40, 0x23, 0xcb, 0xe2, 0x77, 0xe7, 0xec, 0x83, 0xad, 0x93, 0x64, 0x4d, 0x75, 0x8e, 0x7c,
-	0x08, 0x30, 0xa6, 0xc2, 0x64, 0x45, 0xc0, 0xd7, 0x04, 0x37, 0xee, 0x64, 0x8a, 0x61, 0xea, 0x1c,
-	0xb9, 0x0f, 0x35, 0x41, 0x77, 0xc9, 0x22, 0x76, 0x65, 0xc9, 0x6f, 0xa7, 0x99, 0xd6, 0x0f, 0xd5,
-	0x39, 0xf2, 0x29, 0x48, 0x09, 0x65, 0x15, 0x66, 0x4d, 0x52, 0xd8, 0xce, 0xca, 0x14, 0x62, 0xef,
-	0x0d, 0xfd, 0xe8, 0x54, 0x9d, 0x23, 0x1f, 0x41, 0x4d, 0x10, 0x58, 0xb1, 0x5c, 0x96, 0xce, 0xce,
-	0x18, 0xf9, 0x31, 0xc8, 0x69, 0xea, 0x41, 0x94, 0xf4, 0x06, 0xd3, 0xbc, 0xa2, 0x33, 0x91, 0xe0,
-	0xb9, 0xcd, 0x09, 0x39, 0x10, 0x36, 0x4f, 0xb2, 0x91, 0xce, 0xca, 0xa4, 0x98, 0xdf, 0x7a, 0x75,
-	0x8e, 0x6c, 0xe3, 0x4f, 0x37, 0x09, 0x95, 0x12, 0x2b, 0xe7, 0xb0, 0xab, 0x19, 0xd6, 0x3f, 0x82,
-	0x56, 0x96, 0x22, 0x90, 0x4e, 0xea, 0x44, 0x27, 0x50, 0x61, 0xc6, 0x3c, 0x3b, 0x30, 0x3f, 0x01,
-	0xe1, 0xe4, 0x5a, 0xda, 0x11, 0x93, 0x33, 0x4d, 0xd7, 0x01, 0xd4, 0x39, 0xf2, 0x19, 0xc8, 0x69,
-	0x08, 0x17, 0x1b, 0xca, 0x41, 0xf5, 0x0e, 0x99, 0x1a, 0x1e, 0xf2, 0xcd, 0x64, 0xa1, 0x5e, 0x6c,
-	0x26, 0x17, 0xff, 0x67, 0x6c, 0x66, 0x17, 0x9a, 0x19, 0x68, 0x26, 0x57, 0xc5, 0x95, 0x98, 0x86,
-	0xeb, 0x19, 0xb3, 0x6c, 0x83, 0x9c, 0x46, 0x67, 0xb1, 0x9b, 0x1c, 0xc0, 0x9e, 0x6d, 0x49, 0x06,
-	0x9e, 0x85, 0x25, 0x79, 0x90, 0x3d, 0x63, 0x96, 0x9f, 0xc4, 0xa1, 0xb1, 0xe5, 0x38, 0xe4, 0x0c,
-	0xb5, 0x19, 0xc3, 0x1f, 0x40, 0x4d, 0xbc, 0xd6, 0x44, 0x6c, 0x64, 0xdf, 0x6e, 0x1d, 0xfe, 0x0f,
-	0x0f, 0xe3, 0x37, 0x91, 0x3a, 0xf7, 0x7e, 0x81, 0x3c, 0x81, 0x56, 0x16, 0xae, 0xc5, 0x59, 0xe4,
-	0x82, 0x7b, 0xe7, 0x5a, 0x6e, 0x5f, 0x7c, 0xd3, 0xb7, 0xdb, 0xdf, 0xbd, 0x59, 0x2d, 0xfc, 0xfd,
-	0xcd, 0x6a, 0xe1, 0x1f, 0x6f, 0x56, 0x0b, 0xbf, 0xfd, 0xe7, 0xea, 0x5c, 0xaf, 0x8a, 0x56, 0x3e,
-	0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x4d, 0x06, 0x9d, 0xa0, 0x27, 0x00, 0x00,
+	// 3717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7a, 0x4b, 0x8f, 0xdc, 0x48,
+	0x72, 0x7f, 0xb3, 0x58, 0x2f, 0x46, 0x55, 0x75, 0x55, 0x67, 0x3f, 0xc4, 0x29, 0x8d, 0xd4, 0x2d,
+	0x0a, 0x7a, 0x8c, 0x30, 0xff, 0xd6, 0x6c, 0xcf, 0xfe, 0xe5, 0xf5, 0xec, 0x78, 0x67, 0xd5, 0x0f,
+	0xc9, 0xad, 0x69, 0x6b, 0x0a, 0x6c, 0x69, 0x6d, 0xc0, 0x07, 0x82, 0x45, 0x66, 0x55, 0x53, 0x62,
+	0x91, 0x5c, 0x92, 0xd5, 0x9a, 0x9e, 0x8b, 0xfd, 0x0d, 0x0c, 0xaf, 0x01, 0x63, 0xe1, 0x8b, 0x01,
+	0x1b, 0xb0, 0x2f, 0x3e, 0xf8, 0x6c, 0xf8, 0x64, 0xd8, 0xc0, 0x1c, 0x7d, 0xf5, 0x65, 0x60, 0xc8,
+	0x57, 0x7f, 0x05, 0x03, 0x46, 0x46, 0x66, 0xb2, 0xc8, 0x2a, 0xf6, 0x6b, 0xb5, 0x3e, 0x14, 0x90,
+	0x19, 0x19, 0x99, 0x19, 0x19, 0x99, 0x11, 0xf1, 0x8b, 0x60, 0xc1, 0x9a, 0xe3, 0x7b, 0x34, 0x48,
+	0x1f, 0x47, 0x51, 0xc2, 0x7e, 0xdb, 0x51, 0x1c, 0xa6, 0x21, 0x51, 0xa3, 0x28, 0xe9, 0xdf, 0x1c,
+	0x87, 0xe1, 0xd8, 0xa7, 0x8f, 0x91, 0x34, 0x9c, 0x8e, 0x1e, 0xd3, 0x49, 0x94, 0x9e, 0x71, 0x8e,
+	0xfe, 0xe6, 0xfc, 0x60, 0xea, 0x4d, 0x68, 0x92, 0xda, 0x93, 0x48, 0x30, 0xdc, 0x9e, 0x67, 0x70,
+	0xa7, 0xb1, 0x9d, 0x7a, 0x61, 0x20, 0xc6, 0xd7, 0xc6, 0xe1, 0x38, 0xc4, 0xe6, 0x63, 0xd6, 0x92,
+	0x54, 0x29, 0xce, 0x28, 0x61, 0x3f, 0x4e, 0x35, 0x46, 0x50, 0x3f, 0xa6, 0x4e, 0x4c, 0x53, 0x42,
+	0xa0, 0x1a, 0xd8, 0x13, 0xaa, 0x2b, 0x5b, 0xca, 0x43, 0xcd, 0xc4, 0x36, 0xb9, 0x05, 0x30, 0x09,
+	0xa7, 0x41, 0x6a, 0x45, 0x76, 0x7a, 0xa2, 0x57, 0x70, 0x44, 0x43, 0xca, 0xc0, 0x4e, 0x4f, 0xc8,
+	0x0d, 0x68, 0xd0, 0xe0, 0xd4, 0x3a, 0xb5, 0x63, 0x5d, 0xc5, 0xb1, 0x3a, 0x0d, 0x4e, 0x7f, 0x61,
+	0xc7, 0xa4, 0x07, 0xea, 0x5b, 0x7a, 0xa6, 0x57, 0x91, 0xc8, 0x9a, 0xc6, 0xbf, 0x55, 0x40, 0x7b,
+	0x15, 0xdb, 0x41, 0x32, 0x0a, 0xe3, 0x09, 0x59, 0x83, 0x9a, 0x37, 0xb1, 0xc7, 0x72, 0x33, 0xde,
+	0x61, 0xb3, 0x9c, 0x89, 0xab, 0x57, 0xb6, 0x54, 0x36, 0xcb, 0x99, 0xb8, 0xe4, 0x13, 0x50, 0x69,
+	0x70, 0xaa, 0xab, 0x5b, 0xea, 0xc3, 0xd6, 0xce, 0x8d, 0x6d, 0xa6, 0xc5, 0x6c, 0x91, 0xed, 0x83,
+	0xe0, 0xf4, 0x20, 0x48, 0xe3, 0x33, 0x93, 0xf1, 0x90, 0x7b, 0xd0, 0x48, 0xf0, 0x20, 0x89, 0x5e,
+	0x45, 0xf6, 0x16, 0xb2, 0xf3, 0xc3, 0x99, 0x72, 0x8c, 0xed, 0x9c, 0xa4, 0xae, 0x17, 0xe8, 0x35,
+	0xdc, 0x85, 0x77, 0xc8, 0xa7, 0x40, 0x6c, 0xc7, 0xa1, 0x51, 0x6a, 0xc5, 0x34, 0x9d, 0xc6, 0x81,
+	0xe5, 0x84, 0x2e, 0xd5, 0xeb, 0x5b, 0xea, 0x43, 0xd5, 0xec, 0xf1, 0x11, 0x13, 0x07, 0xf6, 0x42,
+	0x97, 0xb2, 0x35, 0x5c, 0x3a, 0x9c, 0x8e, 0xf5, 0xc6, 0x96, 0xf2, 0xb0, 0x69, 0xf2, 0x0e, 0x5b,
+	0x03, 0x8f, 0x61, 0x45, 0x53, 0xdf, 0xb7, 0xa4, 0x2c, 0x1a, 0x6e, 0xd3, 0xc3, 0x91, 0xc1, 0xd4,
+	0xf7, 0xb9, 0x3c, 0x49, 0xff, 0x09, 0x34, 0xa5, 0xfc, 0x52, 0x5b, 0x4a, 0xa6, 0x2d, 0xb6, 0xc3,
+	0xa9, 0xed, 0x4f, 0xa9, 0x50, 0x39, 0xef, 0x7c, 0x51, 0xf9, 0x89, 0x62, 0xf4, 0xa1, 0x7e, 0x30,
+	0x8e, 0x69, 0x92, 0xb0, 0x59, 0xaf, 0xcd, 0x23, 0x39, 0xeb, 0xb5, 0x79, 0x64, 0xdc, 0x02, 0xf5,
+	0x45, 0x38, 0x24, 0x1b, 0x50, 0xf1, 0x5c, 0x4e, 0xdf, 0xad, 0xbf, 0xff, 0x61, 0xb3, 0x72, 0xb8,
+	0x6f, 0x56, 0x3c, 0xd7, 0x38, 0x86, 0xc6, 0x31, 0x8d, 0x4f, 0x3d, 0x87, 0x92, 0xbb, 0xd0, 0xf1,
+	0x82, 0x94, 0xc6, 0x81, 0xed, 0x5b, 0x51, 0x18, 0xa7, 0xc8, 0x5d, 0x33, 0xdb, 0x92, 0x38, 0x08,
+	0xe3, 0x94, 0x31, 0xd1, 0x6f, 0xf3, 0x4c, 0x15, 0xce, 0x44, 0xbf, 0x9d, 0x31, 0x19, 0xff, 0xa0,
+	0x80, 0xf6, 0x34, 0x0d, 0x27, 0x87, 0x41, 0x34, 0x2d, 0x7f, 0x43, 0x04, 0xaa, 0x31, 0x8d, 0x42,
+	0x71, 0x14, 0x6c, 0x93, 0x0d, 0xa8, 0x0f, 0x63, 0x3b, 0x70, 0x4e, 0xe4, 0xbb, 0xe1, 0x3d, 0x46,
+	0x77, 0xc2, 0xc9, 0xc4, 0x4b, 0xc5, 0xd3, 0x11, 0x3d, 0xb6, 0xc6, 0xd8, 0x0f, 0x87, 0x7a, 0x8d,
+	0xaf, 0xc1, 0xda, 0x8c, 0xe6, 0xdb, 0xdf, 0x9d, 0xe9, 0x75, 0xbc, 0x04, 0x6c, 0x93, 0x4d, 0x68,
+	0x8d, 0xe2, 0x70, 0x62, 0x89, 0x45, 0x1a, 0xc8, 0x0e, 0x8c, 0xb4, 0x87, 0x14, 0xe3, 0xcf, 0x15,
+	0xd0, 0xf6, 0xe2, 0x30, 0xb8, 0xb6, 0xb8, 0x62, 0x45, 0x75, 0x5e, 0xac, 0x24, 0xa2, 0x8e, 0x10,
+	0x16, 0xdb, 0xe4, 0x33, 0xf6, 0xc0, 0xec, 0x38, 0x45, 0x59, 0x5b, 0x3b, 0xfd, 0x6d, 0x6e, 0xac,
+	0xdb, 0xd2, 0x58, 0xb7, 0x5f, 0x49, 0x6b, 0x36, 0x39, 0xa3, 0xf1, 0x17, 0x0a, 0xd4, 0xb8, 0x3c,
+	0x06, 0x54, 0xed, 0x34, 0x9c, 0xa0, 0x3c, 0xad, 0x9d, 0x65, 0x7c, 0xc0, 0x99, 0x72, 0x4d, 0x1c,
+	0x23, 0x5b, 0x50, 0x73, 0xe2, 0x30, 0x49, 0xd0, 0x4c, 0x5a, 0x3b, 0x80, 0x4c, 0x9c, 0x81, 0x0f,
+	0x30, 0x8e, 0x69, 0xe0, 0x85, 0x81, 0xae, 0x2e, 0x72, 0xe0, 0x00, 0xdb, 0xc7, 0x89, 0xc3, 0x40,
+	0xaf, 0xe6, 0xf6, 0xc9, 0xb4, 0x62, 0xe2, 0x98, 0xf1, 0x16, 0x9a, 0x2f, 0xc2, 0x21, 0x97, 0xeb,
+	0x6e, 0x76, 0x7e, 0x2e, 0x59, 0x6b, 0x9b, 0x39, 0x10, 0xae, 0xd2, 0x85, 0x3b, 0xaa, 0x94, 0xdc,
+	0x91, 0x9a, 0xbb, 0x23, 0xa9, 0xf4, 0xea, 0x4c, 0xe9, 0xc6, 0x6b, 0xe8, 0x0e, 0xec, 0xd8, 0xf6,
+	0x7d, 0xea, 0x7b, 0xc9, 0xe4, 0x98, 0xe9, 0xb1, 0x0f, 0x4d, 0x27, 0x0c, 0x92, 0xd4, 0x0e, 0xf8,
+	0xc3, 0xab, 0x9a, 0x59, 0x9f, 0x6c, 0x41, 0xcb, 0x09, 0xe9, 0x68, 0xe4, 0x39, 0xcc, 0xa3, 0xe1,
+	0xea, 0x8a, 0x99, 0x27, 0xbd, 0xa8, 0x36, 0x95, 0x5e, 0xc5, 0xf8, 0x1c, 0x34, 0x3c, 0xc0, 0x33,
+	0xcf, 0xc7, 0x8b, 0x45, 0x2f, 0x26, 0xf6, 0x65, 0x6d, 0x46, 0x3b, 0xb1, 0x93, 0x13, 0xbc, 0xab,
+	0xb6, 0x89, 0x6d, 0xe3, 0xa7, 0x50, 0xdb, 0xb7, 0xd3, 0xe9, 0xe4, 0x3c, 0x3b, 0x22, 0x7d, 0x50,
+	0xdf, 0x88, 0x73, 0xb6, 0x76, 0x9a, 0xa8, 0xbc, 0x17, 0xe1, 0xd0, 0x64, 0x44, 0xe3, 0x7b, 0x05,
+	0x34, 0x9c, 0x7d, 0x18, 0x8c, 0x42, 0x76, 0x13, 0x2e, 0xeb, 0x08, 0xb5, 0xf1, 0x9b, 0xc0, 0x61,
+	0x93, 0x0f, 0x90, 0x7b, 0xf8, 0x5a, 0x52, 0x6e, 0xe8, 0xcb, 0x3b, 0xdd, 0x19, 0xc7, 0x31, 0x23,
+	0x9b, 0x7c, 0x94, 0x3c, 0xe0, 0x6c, 0x09, 0x1e, 0xb5, 0xb5, 0xb3, 0x82, 0x6c, 0x83, 0x38, 0x74,
+	0x68, 0x92, 0x30, 0xc6, 0x84, 0x33, 0x26, 0xe4, 0x3e, 0x68, 0xd1, 0x28, 0xb1, 0xf8, 0x9a, 0xfc,
+	0x7a, 0x35, 0xbc, 0x2c, 0xa6, 0x02, 0xb3, 0x19, 0x8d, 0x90, 0x9d, 0x92, 0x3b, 0x50, 0x75, 0xed,
+	0xd4, 0x46, 0x2f, 0xd8, 0xda, 0xe9, 0x64, 0x2c, 0x4c, 0x6c, 0x13, 0x87, 0x8c, 0x9f, 0x02, 0x64,
+	0x27, 0x49, 0xc8, 0xff, 0x03, 0x40, 0x89, 0x2d, 0x2f, 0x18, 0x85, 0xba, 0xb2, 0xa5, 0x66, 0x0f,
+	0x27, 0x63, 0x32, 0x35, 0x57, 0x36, 0x8d, 0x7f, 0x64, 0x6e, 0x61, 0x3c, 0x8e, 0xe9, 0x98, 0xed,
+	0xb6, 0x06, 0x35, 0x87, 0x05, 0x0d, 0xd4, 0x83, 0x6a, 0xf2, 0x0e, 0x53, 0xfe, 0x84, 0xda, 0x01,
+	0x1e, 0x5d, 0x31, 0xb1, 0xcd, 0x2c, 0x2d, 0x49, 0x5d, 0x97, 0x9e, 0x8a, 0x4b, 0x15, 0x3d, 0xf2,
+	0x09, 0xf4, 0x46, 0xde, 0x28, 0x3d, 0xb1, 0x22, 0x1a, 0x3b, 0x34, 0x48, 0x3d, 0x9f, 0x1f, 0x4f,
+	0x31, 0xbb, 0x48, 0x1f, 0x64, 0x64, 0xf2, 0x04, 0x6e, 0x04, 0x5e, 0x40, 0xd3, 0x33, 0x6b, 0x61,
+	0x46, 0x0d, 0x67, 0xac, 0xf3, 0xe1, 0x67, 0xc5, 0x79, 0xc6, 0xaf, 0x2a, 0xd0, 0xce, 0xab, 0x94,
+	0xfc, 0x0c, 0x3a, 0x6e, 0xf8, 0x2e, 0xf0, 0x43, 0xdb, 0xb5, 0x58, 0x08, 0x16, 0xb7, 0xf8, 0xd1,
+	0x82, 0x45, 0xef, 0x8b, 0xf0, 0x6b, 0xb6, 0x25, 0x3f, 0xb3, 0x71, 0xf2, 0x25, 0xb4, 0x23, 0xbe,
+	0x1e, 0x9f, 0x5e, 0xb9, 0x6c, 0x7a, 0x4b, 0xb0, 0xe3, 0xec, 0x2f, 0xa0, 0x35, 0x8d, 0x66, 0x7b,
+	0xab, 0x97, 0x4d, 0x06, 0xce, 0x8d, 0x73, 0xef, 0xc1, 0x72, 0x26, 0xf9, 0xf0, 0x2c, 0xa5, 0x09,
+	0xea, 0xaa, 0x6a, 0x66, 0xe7, 0xd9, 0x65, 0x44, 0x72, 0x07, 0xda, 0xd3, 0x28, 0xc7, 0x54, 0x43,
+	0x26, 0xb1, 0x2d, 0xb2, 0x18, 0x7f, 0x55, 0x81, 0xf5, 0xec, 0x1e, 0x0b, 0xda, 0xf9, 0xbc, 0x5c,
+	0x3b, 0xc2, 0x69, 0xc9, 0x29, 0x73, 0x2a, 0xf9, 0x51, 0xa9, 0x4a, 0xe6, 0xe7, 0x14, 0xf4, 0xf0,
+	0xb8, 0x4c, 0x0f, 0xf3, 0x33, 0xf2, 0x87, 0xff, 0xff, 0xa5, 0x87, 0x5f, 0x9c, 0x33, 0xa7, 0x8c,
+	0x1f, 0x95, 0x28, 0xa3, 0x44, 0xb4, 0xbc, 0x72, 0xfe, 0x47, 0x81, 0xf6, 0x1f, 0x86, 0xf1, 0x5b,
+	0x1a, 0x33, 0x95, 0x4c, 0x13, 0xf2, 0x09, 0x68, 0xef, 0xb0, 0x6f, 0x65, 0x8e, 0xa3, 0xfd, 0xfe,
+	0x87, 0xcd, 0x26, 0x67, 0x3a, 0xdc, 0x37, 0x9b, 0x7c, 0xf8, 0xd0, 0x25, 0x5b, 0x50, 0x7f, 0x13,
+	0x0e, 0x19, 0x1f, 0xfa, 0xcb, 0x5d, 0xed, 0xfd, 0x0f, 0x9b, 0x35, 0xe6, 0x70, 0xf7, 0xcd, 0xda,
+	0x9b, 0x70, 0x78, 0xe8, 0x32, 0x27, 0x8d, 0x26, 0xaa, 0xe6, 0x6c, 0x2d, 0xf3, 0x66, 0xdc, 0x46,
+	0xc9, 0x8f, 0xa1, 0x81, 0x31, 0x84, 0xba, 0x7a, 0xf5, 0xd2, 0x70, 0x23, 0x59, 0x67, 0xde, 0xa4,
+	0x76, 0x89, 0x37, 0xb9, 0x05, 0xf0, 0xcb, 0x29, 0x9d, 0x52, 0x2b, 0xf1, 0xbe, 0xa3, 0x18, 0x68,
+	0x55, 0x53, 0x43, 0xca, 0xb1, 0xf7, 0x1d, 0x35, 0x5e, 0x40, 0xdb, 0xa4, 0x49, 0x38, 0x8d, 0x1d,
+	0x8a, 0x2e, 0x9b, 0xe1, 0xb7, 0x68, 0x8a, 0x07, 0xaf, 0x98, 0xac, 0xc9, 0xcc, 0x79, 0x42, 0x27,
+	0x61, 0x7c, 0x26, 0xa2, 0x82, 0xe8, 0x31, 0xce, 0x71, 0x34, 0xc5, 0xcb, 0x54, 0x4d, 0xd6, 0x34,
+	0xbe, 0xd7, 0xa0, 0x81, 0xf1, 0x66, 0x14, 0x4a, 0x07, 0xab, 0x94, 0x38, 0x58, 0xf2, 0x29, 0x68,
+	0xa9, 0x44, 0x80, 0x85, 0xe7, 0x93, 0xe1, 0x42, 0x73, 0xc6, 0x40, 0x3e, 0x81, 0x66, 0xe4, 0x45,
+	0xd4, 0xf7, 0x02, 0xf9, 0x72, 0x3a, 0xfc, 0xb0, 0x82, 0x68, 0x66, 0xc3, 0xe4, 0x1e, 0xd4, 0x3d,
+	0xa6, 0xdd, 0x64, 0xe6, 0x13, 0xf9, 0xbe, 0x3c, 0x28, 0x8a, 0x41, 0xf2, 0x00, 0x20, 0xb2, 0x63,
+	0x1a, 0xa4, 0x16, 0x13, 0xb1, 0x3e, 0x27, 0xa2, 0xc6, 0xc7, 0x18, 0x0a, 0xcb, 0x5d, 0x4d, 0xe3,
+	0xea, 0x57, 0xf3, 0x04, 0x9a, 0x23, 0x2f, 0xf0, 0x92, 0x13, 0xea, 0xea, 0xcd, 0x4b, 0xa7, 0x65,
+	0xbc, 0xe4, 0x33, 0xe8, 0x84, 0xd3, 0x34, 0x9a, 0xa6, 0x12, 0xfa, 0x68, 0x8b, 0x81, 0xba, 0xcd,
+	0x39, 0x78, 0x8f, 0xdc, 0x95, 0x91, 0x07, 0x30, 0xf2, 0x64, 0xc7, 0x2d, 0xc4, 0x9d, 0xaf, 0xa0,
+	0x17, 0xcd, 0xe2, 0xb2, 0x85, 0x60, 0xa7, 0x8d, 0x2b, 0xaf, 0x71, 0x3d, 0x16, 0x83, 0xb6, 0xd9,
+	0x8d, 0x8a, 0x04, 0xe6, 0xb7, 0xa5, 0x86, 0xad, 0x53, 0x1a, 0x27, 0x0c, 0x96, 0x74, 0xd0, 0xcd,
+	0x74, 0x25, 0xfd, 0x17, 0x9c, 0x4c, 0xee, 0x33, 0x00, 0x8f, 0xf0, 0x54, 0x5f, 0xc6, 0x2d, 0xda,
+	0x02, 0xc0, 0x23, 0xcd, 0x94, 0x83, 0x0c, 0x8c, 0x50, 0x44, 0xc0, 0x7a, 0x57, 0x9e, 0x31, 0x4a,
+	0xb6, 0x39, 0x28, 0x36, 0xc5, 0x10, 0xc3, 0xae, 0x42, 0x1f, 0x02, 0x67, 0xae, 0xe0, 0xfb, 0x13,
+	0x2a, 0xd8, 0x45, 0x1a, 0x79, 0x04, 0x2d, 0xc1, 0x84, 0x88, 0x8f, 0xe4, 0xc2, 0xa5, 0x49, 0xa3,
+	0xd0, 0x04, 0x3e, 0xca, 0xda, 0x44, 0x87, 0x46, 0x4c, 0x39, 0xb0, 0x5b, 0x43, 0xf9, 0x65, 0x17,
+	0x9d, 0xad, 0x9d, 0xda, 0x96, 0x70, 0x5a, 0xd4, 0xd5, 0x37, 0xf0, 0x59, 0x77, 0x18, 0x75, 0x20,
+	0x89, 0xcc, 0x96, 0x90, 0x2d, 0x0d, 0x53, 0xdb, 0xd7, 0x6f, 0x70, 0x5b, 0x62, 0x94, 0x57, 0x8c,
+	0x40, 0x9e, 0x40, 0x47, 0xb8, 0x8e, 0x04, 0x7d, 0x89, 0xae, 0x6f, 0xa9, 0x99, 0x6d, 0xe6, 0x9d,
+	0x8c, 0xd9, 0x7e, 0x97, 0xeb, 0xb1, 0x79, 0xb1, 0xb0, 0x41, 0x7e, 0x3d, 0x1f, 0xe5, 0x6c, 0x3a,
+	0x6f, 0x9d, 0x66, 0x3b, 0xce, 0xf5, 0x18, 0x34, 0xc1, 0x17, 0xad, 0xf7, 0x73, 0xd0, 0x44, 0x80,
+	0x44, 0x1c, 0x20, 0xdb, 0x00, 0x01, 0x7d, 0x27, 0xf5, 0x77, 0x13, 0xd9, 0xba, 0xa8, 0x1c, 0xae,
+	0x3e, 0x1e, 0xf2, 0x03, 0xfa, 0x8e, 0x77, 0x19, 0x28, 0xf3, 0x02, 0x27, 0xa6, 0x13, 0x1a, 0xb0,
+	0x13, 0x7e, 0x8c, 0x90, 0x2f, 0x4f, 0x22, 0xdb, 0xd0, 0x46, 0xbf, 0x22, 0xdf, 0xe8, 0xad, 0xc5,
+	0x37, 0xda, 0x42, 0x06, 0xde, 0x61, 0xf1, 0x09, 0x55, 0x96, 0xbc, 0xf5, 0xa2, 0x88, 0xba, 0xfa,
+	0x6d, 0x54, 0x5a, 0x8b, 0xd1, 0x8e, 0x39, 0x69, 0xe6, 0xca, 0x36, 0x2f, 0x71, 0x65, 0x77, 0xa0,
+	0x4d, 0x03, 0x7b, 0xe8, 0x53, 0x8b, 0xf3, 0x6f, 0x71, 0xf1, 0x38, 0x0d, 0x39, 0x11, 0xcd, 0xdb,
+	0x7e, 0xaa, 0xdf, 0x11, 0x68, 0xde, 0xf6, 0x53, 0x86, 0x5c, 0x86, 0x76, 0xea, 0x9c, 0xe8, 0x06,
+	0x4f, 0xf5, 0xb0, 0xc3, 0xdc, 0x5a, 0x4c, 0xed, 0x24, 0x0c, 0xf4, 0xbb, 0xdc, 0xad, 0xf1, 0xde,
+	0x8b, 0x6a, 0xb3, 0xda, 0xab, 0x19, 0xfb, 0x50, 0xe7, 0x17, 0x56, 0x9a, 0x5f, 0xdc, 0x2f, 0x22,
+	0xbe, 0xde, 0xdc, 0x05, 0x4b, 0xd3, 0x33, 0xfe, 0x58, 0xe0, 0x6f, 0x06, 0xbe, 0x1e, 0x40, 0x13,
+	0x83, 0xc5, 0x0c, 0x7a, 0xb5, 0x67, 0xde, 0x69, 0x14, 0x9a, 0x8d, 0x37, 0xbc, 0x41, 0xee, 0x43,
+	0x37, 0xa0, 0xdf, 0xb2, 0x74, 0x7d, 0x4c, 0xad, 0x34, 0x7c, 0x4b, 0x03, 0xe1, 0x78, 0x3b, 0x8c,
+	0x3c, 0xb0, 0xc7, 0xf4, 0x15, 0x23, 0x1a, 0xb7, 0xa1, 0x29, 0x5d, 0x60, 0x99, 0x90, 0xc6, 0xdf,
+	0x2a, 0xd0, 0x91, 0x0c, 0x3c, 0x05, 0xb8, 0x25, 0xd2, 0x22, 0x65, 0xde, 0x48, 0xe6, 0x13, 0xba,
+	0x4a, 0x21, 0xa1, 0x93, 0x49, 0x81, 0x5a, 0x92, 0x14, 0x54, 0x4b, 0x92, 0x82, 0x5a, 0x4e, 0x53,
+	0x9b, 0x50, 0x65, 0x99, 0x9b, 0x5e, 0x5f, 0x7c, 0x26, 0x38, 0x60, 0xfc, 0x77, 0x03, 0xda, 0x33,
+	0x29, 0x47, 0x61, 0xc1, 0xdd, 0x2b, 0x17, 0xbb, 0xfb, 0xeb, 0xc5, 0x91, 0x47, 0x59, 0x70, 0xe0,
+	0xb5, 0x05, 0x52, 0x58, 0xb6, 0x18, 0x21, 0x7e, 0x17, 0xc0, 0x89, 0xa9, 0x9d, 0x52, 0xd7, 0xb2,
+	0x53, 0xbd, 0x7e, 0xa9, 0x13, 0xd7, 0x04, 0xf7, 0xd3, 0x94, 0x3c, 0x94, 0x6f, 0xa3, 0x81, 0x6f,
+	0xa3, 0xb8, 0x4b, 0xc1, 0x31, 0xdf, 0x81, 0x76, 0x4c, 0x19, 0x72, 0xb5, 0x68, 0x1c, 0x87, 0x31,
+	0xc6, 0x0a, 0xcd, 0x6c, 0x71, 0xda, 0x01, 0x23, 0x91, 0xaf, 0x00, 0xd8, 0xa3, 0x41, 0xac, 0xcd,
+	0xeb, 0x10, 0xad, 0x9d, 0xad, 0x39, 0xb9, 0x47, 0x21, 0x7b, 0x43, 0x7b, 0xc8, 0xc2, 0x6b, 0x29,
+	0xda, 0x1b, 0xd9, 0x2f, 0x75, 0xfe, 0x70, 0x1d, 0xe7, 0xaf, 0x43, 0x43, 0xfa, 0xfc, 0x16, 0xf7,
+	0x99, 0xa2, 0xfb, 0x1b, 0xfa, 0xf0, 0x5e, 0x89, 0x0f, 0xe7, 0x49, 0xda, 0xca, 0x42, 0x92, 0xf6,
+	0x35, 0xac, 0x25, 0x8e, 0xed, 0x53, 0x8b, 0xa1, 0x3c, 0x2b, 0x3d, 0x89, 0x69, 0x72, 0x12, 0xfa,
+	0xae, 0x4e, 0x2e, 0xc3, 0xd1, 0x04, 0xa7, 0xed, 0x87, 0xef, 0x82, 0x57, 0x72, 0xd2, 0xa2, 0x93,
+	0x5d, 0xbd, 0xa6, 0x93, 0x5d, 0x3b, 0xcf, 0xc9, 0x6e, 0x41, 0xcb, 0xa5, 0x89, 0x13, 0x7b, 0x11,
+	0xdb, 0x5c, 0x5f, 0xe7, 0xd7, 0x98, 0x23, 0xcd, 0xbb, 0xd5, 0x8d, 0x45, 0xb7, 0x7a, 0x0b, 0xc0,
+	0xb1, 0x9d, 0x13, 0x81, 0xd2, 0x6e, 0xf0, 0x22, 0x1d, 0x52, 0x18, 0x4a, 0x5b, 0xf0, 0x7c, 0xfa,
+	0xf9, 0x9e, 0xef, 0xa3, 0x9c, 0xe7, 0xbb, 0xcd, 0x56, 0x8d, 0xec, 0xa1, 0xe7, 0x7b, 0xe9, 0x19,
+	0x46, 0x09, 0xcd, 0xcc, 0x51, 0x66, 0x9e, 0xf1, 0x66, 0xb9, 0x67, 0xfc, 0x38, 0xef, 0x19, 0xfb,
+	0x5f, 0xc2, 0x72, 0xf1, 0xa1, 0xe5, 0x8b, 0x5e, 0xb5, 0x92, 0xa2, 0x57, 0x2d, 0x57, 0xf4, 0x7a,
+	0x51, 0x6d, 0xaa, 0xbd, 0xaa, 0xf1, 0x3c, 0xef, 0x93, 0x98, 0x5b, 0x7c, 0x02, 0x9d, 0x0c, 0x5c,
+	0xe4, 0x7c, 0xe3, 0xca, 0xc2, 0x23, 0x37, 0xdb, 0x51, 0xae, 0x67, 0xfc, 0x6b, 0x0d, 0x7a, 0x7b,
+	0x68, 0x74, 0x0c, 0xb3, 0xd1, 0x5f, 0x4e, 0x69, 0x92, 0x16, 0x1d, 0x82, 0x72, 0x1d, 0x60, 0x59,
+	0xb9, 0x2a, 0xb0, 0xac, 0x5e, 0x04, 0x2c, 0xcb, 0xac, 0xad, 0x71, 0x1d, 0x6b, 0xcb, 0xe1, 0xa7,
+	0xe6, 0xd5, 0xf0, 0x93, 0x76, 0xbe, 0xed, 0x95, 0xe1, 0x36, 0x28, 0xc7, 0x6d, 0x0b, 0x66, 0xda,
+	0xba, 0x1c, 0x6a, 0xb5, 0x2f, 0x82, 0x5a, 0x45, 0x88, 0xdd, 0x39, 0x1f, 0x62, 0x2f, 0x98, 0xe5,
+	0xf2, 0x35, 0xcd, 0xb2, 0x7b, 0x35, 0xec, 0xd3, 0xbb, 0x2e, 0xf6, 0x59, 0x59, 0x34, 0xd2, 0x79,
+	0x2b, 0x24, 0xe7, 0x5b, 0xe1, 0x6a, 0x19, 0xfe, 0x58, 0xcb, 0x59, 0x99, 0xb0, 0x87, 0x01, 0xac,
+	0x1c, 0x06, 0xec, 0xdc, 0x69, 0xee, 0x19, 0x5f, 0x94, 0x3b, 0x6d, 0x42, 0x6b, 0xe8, 0x87, 0xce,
+	0x5b, 0x6b, 0x06, 0x40, 0x9a, 0x26, 0x20, 0x09, 0x83, 0x8b, 0xf1, 0xf7, 0x0a, 0x2c, 0x1f, 0x79,
+	0x49, 0x7e, 0xbd, 0x6b, 0x84, 0xd4, 0x6d, 0x68, 0xa3, 0xf6, 0x24, 0xbc, 0xab, 0x6c, 0xa9, 0xf3,
+	0x71, 0xbb, 0x85, 0x0c, 0xbc, 0x43, 0x6e, 0x82, 0x86, 0x38, 0x05, 0xdd, 0x16, 0x4f, 0x05, 0x9b,
+	0x8c, 0x80, 0x5e, 0xeb, 0x16, 0x40, 0x0e, 0xc4, 0xf0, 0x9a, 0x9d, 0x16, 0x65, 0x00, 0x66, 0x1b,
+	0x7a, 0xfb, 0xd4, 0xa7, 0x29, 0xbd, 0xda, 0xd1, 0x8d, 0x4f, 0x61, 0xf9, 0x38, 0x0d, 0xa3, 0x2b,
+	0x72, 0xff, 0x93, 0x02, 0xcb, 0xcf, 0x69, 0x7a, 0x14, 0x8e, 0x93, 0xab, 0xe8, 0xf5, 0x1a, 0xce,
+	0x40, 0x42, 0xda, 0x91, 0xe7, 0xa7, 0x34, 0x4e, 0x30, 0xb9, 0xd7, 0x38, 0xa4, 0x7d, 0xc6, 0x49,
+	0x98, 0x33, 0xdb, 0x49, 0x4a, 0x63, 0x04, 0x43, 0x4d, 0x53, 0xf4, 0x66, 0xc5, 0xc4, 0xfa, 0x39,
+	0xc5, 0x44, 0x01, 0x3f, 0xff, 0xb9, 0x02, 0x70, 0x14, 0x8e, 0xff, 0x80, 0x26, 0x09, 0xfb, 0xa8,
+	0x72, 0x37, 0xe7, 0x24, 0x73, 0x38, 0x2f, 0xf3, 0x88, 0x2f, 0x19, 0xd4, 0x9a, 0x55, 0x23, 0xd4,
+	0x4b, 0xaa, 0x11, 0xd5, 0x0b, 0xaa, 0x11, 0x8f, 0xa0, 0x92, 0x15, 0x15, 0x2e, 0x42, 0x3c, 0x95,
+	0x34, 0x61, 0xd8, 0x60, 0xc2, 0x25, 0xc4, 0xf3, 0x68, 0xa6, 0xec, 0x16, 0x8b, 0x28, 0x8d, 0x0b,
+	0x8b, 0x28, 0x04, 0xaa, 0xd3, 0x84, 0x72, 0xf4, 0xd3, 0x34, 0xb1, 0x4d, 0xee, 0x43, 0x53, 0x14,
+	0x2a, 0x5d, 0x74, 0x70, 0xda, 0x6e, 0xeb, 0xfd, 0x0f, 0x9b, 0x0d, 0x5e, 0xa5, 0xdc, 0x37, 0x1b,
+	0x38, 0x78, 0xe8, 0xe6, 0xd4, 0x0c, 0x79, 0x35, 0x1b, 0xaf, 0x60, 0xd5, 0xe4, 0x99, 0x1d, 0xd7,
+	0xed, 0x15, 0xee, 0x7f, 0xfe, 0x52, 0x2b, 0x0b, 0x97, 0x6a, 0xfc, 0x0e, 0xac, 0x0a, 0x5b, 0x2d,
+	0xac, 0x7a, 0x69, 0x81, 0xd8, 0xb0, 0xa0, 0xc7, 0x2c, 0xf2, 0xca, 0xb2, 0x14, 0x8c, 0xaa, 0x32,
+	0x67, 0x54, 0x58, 0x02, 0x1f, 0x4b, 0x63, 0xc3, 0xb6, 0x71, 0x06, 0x2b, 0xb9, 0x0d, 0x92, 0x28,
+	0x0c, 0x12, 0x2c, 0xba, 0xcd, 0xaa, 0xbd, 0xc9, 0x39, 0xe5, 0x5e, 0xc8, 0xca, 0xbd, 0x09, 0x73,
+	0x2d, 0x98, 0xd8, 0x62, 0xe6, 0x91, 0x88, 0x8d, 0x01, 0x49, 0x2c, 0xeb, 0x48, 0x4a, 0xb7, 0xfe,
+	0xbb, 0x1a, 0xac, 0xf3, 0x38, 0x9c, 0x59, 0xca, 0xf5, 0xbd, 0xce, 0xff, 0x1d, 0x90, 0xdf, 0x80,
+	0xfa, 0x34, 0x72, 0x99, 0xa7, 0x14, 0x86, 0xc8, 0x7b, 0x1f, 0x1e, 0xa9, 0xaf, 0x14, 0x81, 0x17,
+	0xc2, 0x2a, 0x94, 0x84, 0xd5, 0xf3, 0x50, 0x6e, 0xeb, 0xb7, 0x82, 0x72, 0xdb, 0xd7, 0x0c, 0xa7,
+	0x9d, 0x2b, 0xa2, 0xdc, 0xe5, 0x4b, 0x51, 0x6e, 0xf7, 0x32, 0x94, 0xdb, 0xbb, 0x0c, 0xe5, 0xae,
+	0x2c, 0xc6, 0xd7, 0x8f, 0x41, 0x8b, 0xa9, 0xa8, 0xd2, 0x88, 0xf8, 0x3b, 0x23, 0xcc, 0x22, 0xed,
+	0xea, 0x62, 0xa4, 0xdd, 0x83, 0x0d, 0x61, 0xbd, 0xbf, 0xf9, 0x43, 0x35, 0xd6, 0x61, 0x95, 0x19,
+	0xda, 0xdc, 0x0a, 0xc6, 0x5f, 0x2a, 0xb0, 0xce, 0x43, 0xd9, 0x07, 0x18, 0xc1, 0x26, 0x53, 0x30,
+	0x5b, 0x83, 0x41, 0xa6, 0x44, 0x46, 0x76, 0x57, 0x46, 0xc8, 0x24, 0xc7, 0x80, 0xf8, 0x4b, 0xcd,
+	0x33, 0x20, 0xe8, 0xea, 0x81, 0x6a, 0xfb, 0xbe, 0xc8, 0xc9, 0x59, 0xd3, 0x78, 0x0a, 0x6b, 0xc7,
+	0xcc, 0x0d, 0x7e, 0xc0, 0x91, 0x7f, 0x0e, 0xab, 0x2c, 0xea, 0x7e, 0xc0, 0x0a, 0x7f, 0xa6, 0xc0,
+	0x9a, 0x49, 0xe3, 0x69, 0xf0, 0x01, 0xca, 0xb9, 0x07, 0x0d, 0xfa, 0xad, 0xe3, 0x4f, 0x5d, 0x5a,
+	0x06, 0x49, 0xe4, 0x18, 0x63, 0xf3, 0x02, 0xce, 0xa6, 0x96, 0xb0, 0x89, 0x31, 0x23, 0x80, 0xf5,
+	0xe7, 0x76, 0x3c, 0xb4, 0xc7, 0x74, 0x2f, 0xf4, 0x7d, 0xea, 0xa4, 0x52, 0xa2, 0x1b, 0xd0, 0x70,
+	0xe3, 0x33, 0x2b, 0x9e, 0x06, 0x28, 0x50, 0xd3, 0xac, 0xbb, 0xf1, 0x99, 0x39, 0x0d, 0xd8, 0x77,
+	0xa0, 0x71, 0x6c, 0x3b, 0x94, 0x7d, 0x89, 0xf2, 0x42, 0xf7, 0x0a, 0xdf, 0x81, 0x90, 0x7d, 0x80,
+	0xdc, 0xc6, 0x7f, 0x28, 0xb0, 0x31, 0xbf, 0xa1, 0xf0, 0xd2, 0x0f, 0xa0, 0x1b, 0x0e, 0xdf, 0x50,
+	0x27, 0x4d, 0x2c, 0x7e, 0x93, 0xae, 0xf8, 0xc0, 0xb6, 0x2c, 0xc8, 0xfc, 0x5d, 0xb9, 0xcc, 0x38,
+	0x52, 0x7b, 0x3c, 0xe3, 0xe2, 0xee, 0xb9, 0xc5, 0x68, 0x92, 0xe5, 0x01, 0x74, 0xf1, 0xbb, 0x87,
+	0x15, 0x53, 0xc7, 0xb7, 0xbd, 0x09, 0x75, 0x85, 0xab, 0x5e, 0x46, 0xb2, 0x29, 0xa9, 0xf9, 0x4d,
+	0x23, 0x1a, 0xb8, 0x5e, 0x30, 0xd6, 0xab, 0x85, 0x4d, 0x07, 0x9c, 0x9a, 0x6d, 0x2a, 0xb9, 0x6a,
+	0xb3, 0x4d, 0x05, 0x8b, 0xb1, 0xc1, 0x9e, 0x58, 0x18, 0xdb, 0x63, 0x7c, 0x83, 0xb1, 0x54, 0xa5,
+	0xf1, 0xd7, 0x0a, 0x74, 0xb8, 0xde, 0xc5, 0xf0, 0xd5, 0xbe, 0x40, 0xdf, 0x02, 0x60, 0xce, 0x41,
+	0x7c, 0xc0, 0xe1, 0x87, 0xd4, 0x18, 0x85, 0x7f, 0xe1, 0x79, 0x00, 0x5d, 0xbc, 0xeb, 0xc4, 0x3b,
+	0x95, 0x3c, 0xe2, 0x88, 0x19, 0x39, 0xfb, 0x2e, 0x96, 0x9c, 0xd8, 0x31, 0xcd, 0x7f, 0x3f, 0x52,
+	0xcd, 0x16, 0xa7, 0x21, 0x8b, 0xf1, 0x37, 0x0a, 0xb4, 0x98, 0xcc, 0x52, 0xbe, 0x4b, 0xca, 0x63,
+	0xbf, 0x2d, 0xc9, 0x3e, 0x85, 0x06, 0x3f, 0x6b, 0x31, 0x7e, 0x15, 0x74, 0x65, 0x4a, 0x16, 0xe3,
+	0xd7, 0x0a, 0x74, 0x67, 0xd5, 0x23, 0x2e, 0xe8, 0x35, 0xec, 0x86, 0x40, 0x95, 0x5d, 0x96, 0x10,
+	0x17, 0xdb, 0x73, 0x07, 0x51, 0xaf, 0x70, 0x90, 0x6a, 0xd9, 0x41, 0x8c, 0x5f, 0x29, 0xb0, 0x3e,
+	0x77, 0xf5, 0xe2, 0x51, 0xdf, 0x87, 0x1a, 0x53, 0x99, 0x04, 0x1d, 0x3d, 0x11, 0x7d, 0x32, 0x55,
+	0x9b, 0x7c, 0x98, 0xec, 0x80, 0x26, 0x25, 0x95, 0xff, 0x85, 0x58, 0x9b, 0xab, 0x97, 0x71, 0xfe,
+	0x19, 0xdb, 0x25, 0xd2, 0x1b, 0x7f, 0x04, 0xad, 0xe7, 0x7b, 0x7b, 0x76, 0xe0, 0x7a, 0x18, 0xe7,
+	0x0f, 0x81, 0x4c, 0x83, 0x98, 0x8e, 0x68, 0x4c, 0x03, 0x87, 0xba, 0x56, 0xe2, 0x05, 0x8e, 0x54,
+	0xda, 0x45, 0xf0, 0x76, 0x25, 0x3f, 0xeb, 0x98, 0x4d, 0x32, 0xfe, 0x04, 0xda, 0xcf, 0xf7, 0xf6,
+	0x4e, 0xa8, 0xf3, 0x36, 0x0a, 0xbd, 0x20, 0xcd, 0x7f, 0x1c, 0x52, 0xae, 0xfe, 0x71, 0xe8, 0x2e,
+	0xd4, 0x39, 0xc4, 0xd4, 0x2b, 0x39, 0x23, 0xf8, 0x06, 0xcd, 0xce, 0x14, 0x43, 0x2c, 0x8e, 0x71,
+	0xfd, 0xf1, 0xd4, 0x82, 0x77, 0x1e, 0x59, 0x58, 0x4d, 0xe6, 0xdf, 0xfe, 0x7b, 0xd0, 0x7e, 0xf1,
+	0xcd, 0xae, 0x75, 0xfc, 0xea, 0xa9, 0xf9, 0xea, 0xf0, 0xe5, 0xf3, 0xde, 0x12, 0xe9, 0x42, 0x8b,
+	0x51, 0xcc, 0xd7, 0x2f, 0x5f, 0x32, 0x82, 0x22, 0x09, 0xcf, 0x9e, 0x1e, 0x1e, 0xbd, 0x36, 0x0f,
+	0x7a, 0x15, 0x49, 0x38, 0x7e, 0xbd, 0xb7, 0x77, 0x70, 0x7c, 0xdc, 0x53, 0xc9, 0x32, 0x00, 0x23,
+	0x7c, 0x7d, 0x78, 0x74, 0x74, 0xb0, 0xdf, 0xab, 0x3e, 0xfa, 0xb9, 0xf8, 0xb7, 0x00, 0xdf, 0x02,
+	0xa0, 0xce, 0xe6, 0x1e, 0xec, 0xf7, 0x96, 0x48, 0x0b, 0x1a, 0x72, 0x9a, 0x82, 0x9d, 0xaf, 0x0f,
+	0x07, 0x83, 0x83, 0xfd, 0x5e, 0x85, 0xb4, 0xa1, 0x99, 0x09, 0xa1, 0x3e, 0xfa, 0x0a, 0x5a, 0xb9,
+	0x32, 0x38, 0xdb, 0x71, 0xf0, 0xcd, 0x7e, 0x26, 0xd3, 0x92, 0x24, 0xcc, 0xd6, 0x5a, 0x06, 0x60,
+	0x04, 0xb1, 0x51, 0xe5, 0xd1, 0x9f, 0xe6, 0x8a, 0xd6, 0x7c, 0x8d, 0x75, 0x58, 0x19, 0x1c, 0x0e,
+	0x0e, 0x8e, 0x0e, 0x5f, 0x1e, 0xe4, 0x8f, 0xbb, 0x06, 0xbd, 0x8c, 0x3c, 0x3b, 0xf3, 0x0d, 0x58,
+	0x9d, 0x51, 0x0f, 0x32, 0xf6, 0x4a, 0x81, 0x5d, 0x6a, 0x44, 0x25, 0xab, 0xd0, 0xcd, 0xa8, 0x83,
+	0xa7, 0xaf, 0x8f, 0x99, 0x16, 0x76, 0xfe, 0x45, 0x03, 0xf5, 0xe9, 0xe0, 0x90, 0x6c, 0x83, 0xc6,
+	0x81, 0x2d, 0x2b, 0x53, 0xac, 0x8b, 0xff, 0xd7, 0x14, 0x0b, 0x4e, 0xfd, 0x0c, 0xb8, 0x1b, 0x4b,
+	0xe4, 0xc7, 0x00, 0xb3, 0x54, 0x9e, 0x6c, 0x08, 0x04, 0x35, 0x97, 0xdb, 0xf7, 0x0b, 0x45, 0x7f,
+	0x63, 0x89, 0x3c, 0x86, 0x86, 0xc8, 0xd6, 0xc9, 0x2a, 0x0e, 0x15, 0x73, 0xf7, 0x7e, 0x27, 0xcf,
+	0x9f, 0x18, 0x4b, 0xe4, 0x4b, 0xd0, 0xb2, 0xac, 0x59, 0x88, 0x35, 0x9f, 0x45, 0xf7, 0x37, 0x16,
+	0x5e, 0xe2, 0x01, 0xfb, 0x6f, 0xa2, 0xb1, 0x44, 0x7e, 0x02, 0x0d, 0x91, 0x43, 0x8b, 0xed, 0x8a,
+	0x19, 0xf5, 0x05, 0x33, 0xbf, 0x80, 0x76, 0x3e, 0xfb, 0x21, 0x7a, 0xfe, 0x80, 0xf9, 0xd4, 0xa6,
+	0x3f, 0x97, 0x63, 0x70, 0x99, 0xb3, 0xfc, 0x44, 0xc8, 0x3c, 0x9f, 0x10, 0xf5, 0x37, 0xe6, 0xc9,
+	0xdc, 0x97, 0x18, 0x4b, 0x64, 0x17, 0x3f, 0x51, 0x67, 0xd9, 0x9c, 0xd8, 0xb9, 0x24, 0xc1, 0xbb,
+	0x40, 0xfa, 0x67, 0xb0, 0x5c, 0xcc, 0x52, 0x48, 0x3f, 0x77, 0xa3, 0x73, 0xc0, 0xe4, 0x82, 0x75,
+	0xf6, 0xa0, 0x3b, 0x87, 0x22, 0xc9, 0xcd, 0xbc, 0x22, 0xe6, 0x57, 0x5a, 0xac, 0x63, 0x1a, 0x4b,
+	0xe4, 0x67, 0xd0, 0xce, 0xa3, 0x48, 0x71, 0xa0, 0x12, 0x60, 0xd9, 0x27, 0x0b, 0xd3, 0x13, 0x7e,
+	0x98, 0x22, 0xda, 0x14, 0x87, 0x29, 0x85, 0xa0, 0x17, 0x1c, 0x66, 0x1f, 0x3a, 0x05, 0x74, 0x48,
+	0x3e, 0x12, 0x4f, 0x62, 0x11, 0x31, 0x5e, 0xb0, 0xca, 0x2e, 0xb4, 0xf3, 0x00, 0x51, 0x9c, 0xa6,
+	0x04, 0x33, 0x5e, 0x2c, 0x49, 0x01, 0x21, 0x0a, 0x49, 0xca, 0x50, 0xe3, 0x05, 0xab, 0xfc, 0x9e,
+	0x34, 0x8d, 0xa7, 0xbe, 0x4f, 0xce, 0x61, 0xbb, 0x60, 0xfa, 0xe7, 0xd0, 0x10, 0x05, 0x23, 0x61,
+	0x1b, 0xc5, 0xf2, 0x51, 0x9f, 0xff, 0xb1, 0x6b, 0x56, 0x96, 0x31, 0x96, 0x3e, 0x53, 0xc8, 0xd7,
+	0xb0, 0x5c, 0x44, 0x76, 0xe2, 0x2e, 0x4a, 0xf1, 0x65, 0xff, 0x66, 0xe9, 0x58, 0xf6, 0xd2, 0x7f,
+	0x1f, 0x3a, 0x32, 0xe2, 0x61, 0x40, 0xcd, 0x2e, 0x64, 0x11, 0x5f, 0xf5, 0xfb, 0x65, 0x43, 0x72,
+	0xa5, 0xdd, 0xde, 0xf7, 0xef, 0x6f, 0x2b, 0xff, 0xfe, 0xfe, 0xb6, 0xf2, 0x9f, 0xef, 0x6f, 0x2b,
+	0xbf, 0xfe, 0xaf, 0xdb, 0x4b, 0xc3, 0x3a, 0x9e, 0xf7, 0xf3, 0xff, 0x1d, 0x00, 0x6e, 0x56, 0x56,
+	0xf4, 0xd2, 0x2c, 0x00, 0x00,
 }
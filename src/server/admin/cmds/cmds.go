@@ -0,0 +1,68 @@
+package cmds
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/spf13/cobra"
+)
+
+// Cmds returns a slice containing admin commands.
+func Cmds() []*cobra.Command {
+	var objects bool
+	extract := &cobra.Command{
+		Use:   "extract",
+		Short: "Extract Pachyderm state to stdout.",
+		Long: `Extract Pachyderm state to stdout.
+
+The output contains the repos, commits, branches, ACLs, jobs and pipelines of
+the cluster, and can be replayed into an empty cluster with "pachctl restore".
+
+Examples:
+
+` + codestart + `# back up the cluster's metadata
+$ pachctl extract > backup
+
+# back up the cluster's metadata and the content of every object
+$ pachctl extract --objects > backup
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %s", err.Error())
+			}
+			return c.ExtractWriter(objects, os.Stdout)
+		}),
+	}
+	extract.Flags().BoolVar(&objects, "objects", false, "Include the content of every object in the backup, so it can be restored into a cluster that uses different object storage.")
+
+	restore := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore Pachyderm state from stdin.",
+		Long: `Restore Pachyderm state from stdin.
+
+The input must be the output of "pachctl extract", and the cluster must be
+empty. ACLs are only restored if auth is activated in the cluster.
+
+Examples:
+
+` + codestart + `$ pachctl restore < backup
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %s", err.Error())
+			}
+			return c.RestoreReader(os.Stdin)
+		}),
+	}
+	return []*cobra.Command{extract, restore}
+}
+
+const (
+	codestart = "```sh\n\n"
+	codeend   = "\n```"
+)
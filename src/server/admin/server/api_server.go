@@ -0,0 +1,606 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/version"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+)
+
+// backupVersion is the version of the backup format written by Extract. It
+// must be incremented whenever the meaning of an existing op changes.
+const backupVersion = 1
+
+type apiServer struct {
+	log.Logger
+	address        string
+	pachClient     *client.APIClient
+	pachClientOnce sync.Once
+	etcdClient     *etcd.Client
+	pfsEtcdPrefix  string
+
+	// collections
+	repos         col.Collection
+	repoRefCounts col.Collection
+	openCommits   col.Collection
+	repoQuotas    col.Collection
+	userQuotas    col.Collection
+	userUsage     col.Collection
+	pipelines     col.Collection
+	jobs          col.Collection
+}
+
+// NewAPIServer returns an implementation of admin.APIServer, which reads and
+// writes the metadata of PFS and PPS under pfsEtcdPrefix and ppsEtcdPrefix.
+func NewAPIServer(address string, etcdAddress string, pfsEtcdPrefix string, ppsEtcdPrefix string) (admin.APIServer, error) {
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{etcdAddress},
+		DialOptions: client.EtcdDialOptions(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error constructing etcdClient: %s", err.Error())
+	}
+	store := kv.NewEtcdStore(etcdClient)
+	return &apiServer{
+		Logger:        log.NewLogger("admin.API"),
+		address:       address,
+		etcdClient:    etcdClient,
+		pfsEtcdPrefix: pfsEtcdPrefix,
+		repos:         pfsdb.Repos(store, pfsEtcdPrefix),
+		repoRefCounts: pfsdb.RepoRefCounts(store, pfsEtcdPrefix),
+		openCommits:   pfsdb.OpenCommits(store, pfsEtcdPrefix),
+		repoQuotas:    pfsdb.RepoQuotas(store, pfsEtcdPrefix),
+		userQuotas:    pfsdb.UserQuotas(store, pfsEtcdPrefix),
+		userUsage:     pfsdb.UserUsage(store, pfsEtcdPrefix),
+		pipelines:     ppsdb.Pipelines(etcdClient, ppsEtcdPrefix),
+		jobs:          ppsdb.Jobs(etcdClient, ppsEtcdPrefix),
+	}, nil
+}
+
+func (a *apiServer) commits(repo string) col.Collection {
+	return pfsdb.Commits(kv.NewEtcdStore(a.etcdClient), a.pfsEtcdPrefix, repo)
+}
+
+func (a *apiServer) branches(repo string) col.Collection {
+	return pfsdb.Branches(kv.NewEtcdStore(a.etcdClient), a.pfsEtcdPrefix, repo)
+}
+
+func (a *apiServer) getPachClient() (*client.APIClient, error) {
+	if a.pachClient == nil {
+		var onceErr error
+		a.pachClientOnce.Do(func() {
+			a.pachClient, onceErr = client.NewFromAddress(a.address)
+		})
+		if onceErr != nil {
+			return nil, onceErr
+		}
+	}
+	return a.pachClient, nil
+}
+
+// checkIsAdmin returns an error if the auth system is activated and the
+// current user (in 'ctx') is not a cluster admin
+func (a *apiServer) checkIsAdmin(ctx context.Context, pachClient *client.APIClient) error {
+	whoAmI, err := pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsNotActivatedError(err) {
+			return nil
+		}
+		return fmt.Errorf("error authenticating: %s", err.Error())
+	}
+	resp, err := pachClient.AuthAPIClient.GetAdmins(auth.In2Out(ctx), &auth.GetAdminsRequest{})
+	if err != nil {
+		return fmt.Errorf("error looking up cluster admins: %s", err.Error())
+	}
+	for _, admin := range resp.Admins {
+		if admin == whoAmI.Username {
+			return nil
+		}
+	}
+	return &auth.NotAuthorizedError{}
+}
+
+func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.API_ExtractServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := extractServer.Context()
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return err
+	}
+	if err := a.checkIsAdmin(ctx, pachClient); err != nil {
+		return err
+	}
+
+	if err := extractServer.Send(&admin.Op{
+		Header: &admin.Header{
+			Version:      backupVersion,
+			PachdVersion: version.PrettyPrintVersion(version.Version),
+		},
+	}); err != nil {
+		return err
+	}
+	if request.Objects {
+		if err := a.extractObjects(ctx, pachClient, extractServer); err != nil {
+			return err
+		}
+	}
+
+	repoInfos, err := list(a.repos.ReadOnly(ctx), func() proto { return &pfs.RepoInfo{} })
+	if err != nil {
+		return err
+	}
+	for _, repoInfo := range repoInfos {
+		repoInfo := repoInfo.(*pfs.RepoInfo)
+		repoInfo.Scope = auth.Scope_NONE
+		if err := extractServer.Send(&admin.Op{Repo: repoInfo}); err != nil {
+			return err
+		}
+		quota := new(pfs.Quota)
+		if err := a.repoQuotas.ReadOnly(ctx).Get(repoInfo.Repo.Name, quota); err == nil {
+			if err := extractServer.Send(&admin.Op{Quota: &pfs.QuotaInfo{Repo: repoInfo.Repo, Quota: quota}}); err != nil {
+				return err
+			}
+		} else if !col.IsErrNotFound(err) {
+			return err
+		}
+		commitInfos, err := list(a.commits(repoInfo.Repo.Name).ReadOnly(ctx), func() proto { return &pfs.CommitInfo{} })
+		if err != nil {
+			return err
+		}
+		for _, commitInfo := range commitInfos {
+			if err := extractServer.Send(&admin.Op{Commit: commitInfo.(*pfs.CommitInfo)}); err != nil {
+				return err
+			}
+		}
+		iterator, err := a.branches(repoInfo.Repo.Name).ReadOnly(ctx).List()
+		if err != nil {
+			return err
+		}
+		for {
+			var branch string
+			head := new(pfs.Commit)
+			ok, err := iterator.Next(&branch, head)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			if err := extractServer.Send(&admin.Op{Branch: &pfs.BranchInfo{Name: branch, Head: head}}); err != nil {
+				return err
+			}
+		}
+		resp, err := pachClient.AuthAPIClient.GetACL(auth.In2Out(ctx), &auth.GetACLRequest{Repo: repoInfo.Repo.Name})
+		if err != nil && !auth.IsNotActivatedError(err) {
+			return err
+		}
+		if err == nil && resp.ACL != nil && len(resp.ACL.Entries) > 0 {
+			if err := extractServer.Send(&admin.Op{Acl: &admin.ACL{Repo: repoInfo.Repo, Acl: resp.ACL}}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := a.extractUserQuotas(ctx, extractServer); err != nil {
+		return err
+	}
+
+	// Jobs are restored before pipelines, so that restored pipelines don't
+	// rerun the jobs
+	jobInfos, err := list(a.jobs.ReadOnly(ctx), func() proto { return &pps.JobInfo{} })
+	if err != nil {
+		return err
+	}
+	for _, jobInfo := range jobInfos {
+		if err := extractServer.Send(&admin.Op{Job: jobInfo.(*pps.JobInfo)}); err != nil {
+			return err
+		}
+	}
+	pipelineInfos, err := list(a.pipelines.ReadOnly(ctx), func() proto { return &pps.PipelineInfo{} })
+	if err != nil {
+		return err
+	}
+	for _, pipelineInfo := range pipelineInfos {
+		pipelineInfo := pipelineInfo.(*pps.PipelineInfo)
+		// Capabilities are only valid in the cluster that issued them
+		pipelineInfo.Capability = ""
+		if err := extractServer.Send(&admin.Op{Pipeline: pipelineInfo}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractUserQuotas sends the quota and the usage of every user that has
+// either to 'extractServer'
+func (a *apiServer) extractUserQuotas(ctx context.Context, extractServer admin.API_ExtractServer) error {
+	quotaInfos := make(map[string]*pfs.QuotaInfo)
+	var users []string
+	getQuotaInfo := func(user string) *pfs.QuotaInfo {
+		if _, ok := quotaInfos[user]; !ok {
+			quotaInfos[user] = &pfs.QuotaInfo{User: user}
+			users = append(users, user)
+		}
+		return quotaInfos[user]
+	}
+	quotas, err := a.userQuotas.ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	for {
+		var user string
+		quota := new(pfs.Quota)
+		ok, err := quotas.Next(&user, quota)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		getQuotaInfo(user).Quota = quota
+	}
+	usages, err := a.userUsage.ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	for {
+		var user string
+		usage := new(pfs.Usage)
+		ok, err := usages.Next(&user, usage)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		getQuotaInfo(user).Usage = usage
+	}
+	for _, user := range users {
+		if err := extractServer.Send(&admin.Op{Quota: quotaInfos[user]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractObjects sends the content of every object, followed by every tag,
+// to 'extractServer'
+func (a *apiServer) extractObjects(ctx context.Context, pachClient *client.APIClient, extractServer admin.API_ExtractServer) error {
+	listObjectsClient, err := pachClient.ObjectAPIClient.ListObjects(auth.In2Out(ctx), &pfs.ListObjectsRequest{})
+	if err != nil {
+		return err
+	}
+	var objects []*pfs.Object
+	for {
+		object, err := listObjectsClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		objects = append(objects, object)
+	}
+	for _, object := range objects {
+		getObjectClient, err := pachClient.ObjectAPIClient.GetObject(auth.In2Out(ctx), object)
+		if err != nil {
+			return err
+		}
+		for {
+			value, err := getObjectClient.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if err := extractServer.Send(&admin.Op{Object: &admin.Object{Object: object, Value: value.Value}}); err != nil {
+				return err
+			}
+		}
+	}
+
+	listTagsClient, err := pachClient.ObjectAPIClient.ListTags(auth.In2Out(ctx), &pfs.ListTagsRequest{IncludeObject: true})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := listTagsClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if resp.Object == nil {
+			continue
+		}
+		if err := extractServer.Send(&admin.Op{Tag: &pfs.TagObjectRequest{
+			Object: resp.Object,
+			Tags:   []*pfs.Tag{{Name: resp.Tag}},
+		}}); err != nil {
+			return err
+		}
+	}
+}
+
+func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error) {
+	func() { a.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := restoreServer.Context()
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return err
+	}
+	if err := a.checkIsAdmin(ctx, pachClient); err != nil {
+		return err
+	}
+	for _, c := range []col.Collection{a.repos, a.pipelines, a.jobs} {
+		count, err := c.ReadOnly(ctx).Count()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("backups can only be restored into an empty cluster")
+		}
+	}
+
+	r := &restorer{
+		apiServer:  a,
+		ctx:        ctx,
+		pachClient: pachClient,
+	}
+	for i := 0; ; i++ {
+		request, err := restoreServer.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if request.Op == nil {
+			return fmt.Errorf("restore request %d has no op", i)
+		}
+		if i == 0 {
+			header := request.Op.Header
+			if header == nil {
+				return fmt.Errorf("backup does not start with a header")
+			}
+			if header.Version > backupVersion {
+				return fmt.Errorf("backup has version %d, but only versions up to %d are supported", header.Version, backupVersion)
+			}
+			continue
+		}
+		if err := r.apply(request.Op); err != nil {
+			return err
+		}
+	}
+	if err := r.finishObject(); err != nil {
+		return err
+	}
+	return restoreServer.SendAndClose(&types.Empty{})
+}
+
+// restorer applies the ops of a backup to a cluster
+type restorer struct {
+	*apiServer
+	ctx        context.Context
+	pachClient *client.APIClient
+
+	// object is the object whose content is being restored by putObjectClient
+	object          *pfs.Object
+	putObjectClient pfs.ObjectAPI_PutObjectClient
+}
+
+func (r *restorer) apply(op *admin.Op) error {
+	if err := checkOp(op); err != nil {
+		return err
+	}
+	if op.Object != nil {
+		return r.putObject(op.Object)
+	}
+	if err := r.finishObject(); err != nil {
+		return err
+	}
+	switch {
+	case op.Tag != nil:
+		_, err := r.pachClient.ObjectAPIClient.TagObject(auth.In2Out(r.ctx), op.Tag)
+		return err
+	case op.Repo != nil:
+		_, err := col.NewSTM(r.ctx, r.etcdClient, func(stm col.STM) error {
+			repoRefCounts := r.repoRefCounts.ReadWriteInt(stm)
+			if err := r.repos.ReadWrite(stm).Create(op.Repo.Repo.Name, op.Repo); err != nil {
+				return err
+			}
+			// Repos may be restored before or after the repos in their
+			// provenance, so ref counts are created by whichever comes first
+			if _, err := repoRefCounts.Get(op.Repo.Repo.Name); col.IsErrNotFound(err) {
+				if err := repoRefCounts.Create(op.Repo.Repo.Name, 0); err != nil {
+					return err
+				}
+			} else if err != nil {
+				return err
+			}
+			for _, prov := range op.Repo.Provenance {
+				if _, err := repoRefCounts.Get(prov.Name); col.IsErrNotFound(err) {
+					if err := repoRefCounts.Create(prov.Name, 1); err != nil {
+						return err
+					}
+				} else if err != nil {
+					return err
+				} else if err := repoRefCounts.Increment(prov.Name); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return err
+	case op.Commit != nil:
+		_, err := col.NewSTM(r.ctx, r.etcdClient, func(stm col.STM) error {
+			commit := op.Commit.Commit
+			if err := r.commits(commit.Repo.Name).ReadWrite(stm).Create(commit.ID, op.Commit); err != nil {
+				return err
+			}
+			if op.Commit.Finished == nil {
+				return r.openCommits.ReadWrite(stm).Put(commit.ID, commit)
+			}
+			return nil
+		})
+		return err
+	case op.Branch != nil:
+		_, err := col.NewSTM(r.ctx, r.etcdClient, func(stm col.STM) error {
+			return r.branches(op.Branch.Head.Repo.Name).ReadWrite(stm).Put(op.Branch.Name, op.Branch.Head)
+		})
+		return err
+	case op.Acl != nil:
+		if _, err := r.pachClient.AuthAPIClient.SetACL(auth.In2Out(r.ctx), &auth.SetACLRequest{
+			Repo:   op.Acl.Repo.Name,
+			NewACL: op.Acl.Acl,
+		}); err != nil && !auth.IsNotActivatedError(err) {
+			return err
+		}
+		return nil
+	case op.Job != nil:
+		_, err := col.NewSTM(r.ctx, r.etcdClient, func(stm col.STM) error {
+			return r.jobs.ReadWrite(stm).Create(op.Job.Job.ID, op.Job)
+		})
+		return err
+	case op.Pipeline != nil:
+		resp, err := r.pachClient.AuthAPIClient.GetCapability(auth.In2Out(r.ctx), &auth.GetCapabilityRequest{
+			Pipeline: op.Pipeline.Pipeline.Name,
+		})
+		if err != nil && !auth.IsNotActivatedError(err) {
+			return fmt.Errorf("error getting capability for pipeline %s: %v", op.Pipeline.Pipeline.Name, err)
+		}
+		if err == nil {
+			op.Pipeline.Capability = resp.Capability
+		}
+		_, err = col.NewSTM(r.ctx, r.etcdClient, func(stm col.STM) error {
+			return r.pipelines.ReadWrite(stm).Create(op.Pipeline.Pipeline.Name, op.Pipeline)
+		})
+		return err
+	case op.Quota != nil:
+		_, err := col.NewSTM(r.ctx, r.etcdClient, func(stm col.STM) error {
+			if op.Quota.Repo != nil {
+				if op.Quota.Quota == nil {
+					return nil
+				}
+				return r.repoQuotas.ReadWrite(stm).Put(op.Quota.Repo.Name, op.Quota.Quota)
+			}
+			if op.Quota.Quota != nil {
+				if err := r.userQuotas.ReadWrite(stm).Put(op.Quota.User, op.Quota.Quota); err != nil {
+					return err
+				}
+			}
+			if op.Quota.Usage != nil {
+				return r.userUsage.ReadWrite(stm).Put(op.Quota.User, op.Quota.Usage)
+			}
+			return nil
+		})
+		return err
+	case op.Header != nil:
+		return fmt.Errorf("unexpected header in the middle of the backup")
+	}
+	return fmt.Errorf("unrecognized op %v", op)
+}
+
+// checkOp returns an error if 'op' is missing a field that restoring it
+// relies on
+func checkOp(op *admin.Op) error {
+	switch {
+	case op.Object != nil && op.Object.Object == nil,
+		op.Repo != nil && op.Repo.Repo == nil,
+		op.Commit != nil && (op.Commit.Commit == nil || op.Commit.Commit.Repo == nil),
+		op.Branch != nil && (op.Branch.Head == nil || op.Branch.Head.Repo == nil),
+		op.Acl != nil && op.Acl.Repo == nil,
+		op.Job != nil && op.Job.Job == nil,
+		op.Pipeline != nil && op.Pipeline.Pipeline == nil,
+		op.Quota != nil && op.Quota.Repo == nil && op.Quota.User == "":
+		return fmt.Errorf("malformed op %v; the backup may be corrupt", op)
+	}
+	return nil
+}
+
+// putObject writes a chunk of an object's content. Chunks of the same object
+// are written to the same PutObject stream.
+func (r *restorer) putObject(object *admin.Object) error {
+	if r.object == nil || r.object.Hash != object.Object.Hash {
+		if err := r.finishObject(); err != nil {
+			return err
+		}
+		putObjectClient, err := r.pachClient.ObjectAPIClient.PutObject(auth.In2Out(r.ctx))
+		if err != nil {
+			return err
+		}
+		r.object, r.putObjectClient = object.Object, putObjectClient
+	}
+	return r.putObjectClient.Send(&pfs.PutObjectRequest{Value: object.Value})
+}
+
+// finishObject closes the PutObject stream of the object being restored, if
+// any, and checks that its content has the expected hash
+func (r *restorer) finishObject() error {
+	if r.object == nil {
+		return nil
+	}
+	expected := r.object
+	r.object = nil
+	object, err := r.putObjectClient.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	if object.Hash != expected.Hash {
+		return fmt.Errorf("restored object has hash %s, but %s was expected; the backup may be corrupt", object.Hash, expected.Hash)
+	}
+	return nil
+}
+
+// proto is the interface satisfied by the messages stored in collections
+type proto interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// list returns every item in a collection, oldest first, using newItem to
+// allocate each one
+func list(c col.ReadonlyCollection, newItem func() proto) ([]proto, error) {
+	iterator, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+	var result []proto
+	for {
+		var key string
+		item := newItem()
+		ok, err := iterator.Next(&key, item)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		result = append(result, item)
+	}
+	// List returns the most recently modified items first
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
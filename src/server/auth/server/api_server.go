@@ -499,6 +499,7 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *authclient.WhoAmIRequest) (
 	}
 	return &authclient.WhoAmIResponse{
 		Username: user.Username,
+		Pipeline: user.Pipeline,
 	}, nil
 }
 
@@ -789,6 +790,7 @@ func (a *apiServer) GetCapability(ctx context.Context, req *authclient.GetCapabi
 	// currently, GetCapability is only called by CreatePipeline
 	// TODO(msteffen): Only expose this inside the cluster
 	user.Type = authclient.User_PIPELINE
+	user.Pipeline = req.Pipeline
 
 	capability := uuid.NewWithoutDashes()
 	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
@@ -625,6 +625,49 @@ func TestCreateAndUpdatePipeline(t *testing.T) {
 	})
 }
 
+// TestCommitAuthor tests that commits record the user or pipeline that made
+// them
+func TestCommitAuthor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	alice := uniqueString("alice")
+	aliceClient := getPachClient(t, alice)
+
+	dataRepo := uniqueString("TestCommitAuthor")
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	commit, err := aliceClient.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = aliceClient.PutFile(dataRepo, commit.ID, "/file", strings.NewReader("test data"))
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.FinishCommit(dataRepo, commit.ID))
+	commitInfo, err := aliceClient.InspectCommit(dataRepo, commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, alice, commitInfo.Author)
+
+	// Commits made by alice's pipeline are attributed to the pipeline
+	pipeline := uniqueString("pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: ubuntu:14.04
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewAtomInput(dataRepo, "/*"),
+		"", // default output branch: master
+		false,
+	))
+	iter, err := aliceClient.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{{Name: pipeline}})
+	require.NoError(t, err)
+	var outputCommitInfo *pfs.CommitInfo
+	require.NoErrorWithinT(t, 60*time.Second, func() error {
+		outputCommitInfo, err = iter.Next()
+		return err
+	})
+	require.Equal(t, "pipeline:"+pipeline, outputCommitInfo.Author)
+}
+
 func TestPipelineMultipleInputs(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -18,12 +18,14 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/batch"
 
+	"github.com/docker/go-units"
 	"github.com/facebookgo/pidfile"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
+	admincmds "github.com/pachyderm/pachyderm/src/server/admin/cmds"
 	authcmds "github.com/pachyderm/pachyderm/src/server/auth/cmds"
 	enterprisecmds "github.com/pachyderm/pachyderm/src/server/enterprise/cmds"
 	pfscmds "github.com/pachyderm/pachyderm/src/server/pfs/cmds"
@@ -31,6 +33,7 @@ import (
 	deploycmds "github.com/pachyderm/pachyderm/src/server/pkg/deploy/cmds"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	ppscmds "github.com/pachyderm/pachyderm/src/server/pps/cmds"
+	ppspretty "github.com/pachyderm/pachyderm/src/server/pps/pretty"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -88,6 +91,10 @@ Environment variables:
 	for _, cmd := range enterpriseCmds {
 		rootCmd.AddCommand(cmd)
 	}
+	adminCmds := admincmds.Cmds()
+	for _, cmd := range adminCmds {
+		rootCmd.AddCommand(cmd)
+	}
 
 	versionCmd := &cobra.Command{
 		Use:   "version",
@@ -226,6 +233,8 @@ kubectl %v port-forward "$pod" %d:8081
 	portForward.Flags().IntVarP(&uiWebsocketPort, "proxy-port", "x", 30081, "The local port to bind to.")
 	portForward.Flags().StringVarP(&kubeCtlFlags, "kubectlflags", "k", "", "Any kubectl flags to proxy, e.g. --kubectlflags='--kubeconfig /some/path/kubeconfig'")
 
+	var gracePeriod time.Duration
+	var dryRun bool
 	garbageCollect := &cobra.Command{
 		Use:   "garbage-collect",
 		Short: "Garbage collect unused data.",
@@ -235,7 +244,9 @@ When a file/commit/repo is deleted, the data is not immediately removed from the
 
 To actually remove the data, you will need to manually invoke garbage collection.  The easiest way to do it is through "pachctl garbage-collecth".
 
-Currently "pachctl garbage-collect" can only be started when there are no active jobs running.  You also need to ensure that there's no ongoing "put-file".  Garbage collection puts the cluster into a readonly mode where no new jobs can be created and no data can be added.
+By default, "pachctl garbage-collect" can only be started when there are no active jobs running.  You also need to ensure that there's no ongoing "put-file".  Garbage collection puts the cluster into a readonly mode where no new jobs can be created and no data can be added.
+
+With --grace-period, garbage collection can run while jobs are running and data is being added: data is only removed once it has been unused for at least the grace period, so a run only removes data that earlier runs found to be unused.  Commits must be finished within the grace period.
 `,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
 			client, err := client.NewOnUserMachine(!noMetrics, "user")
@@ -243,9 +254,76 @@ Currently "pachctl garbage-collect" can only be started when there are no active
 				return err
 			}
 
-			return client.GarbageCollect()
+			response, err := client.GarbageCollectWithGracePeriod(gracePeriod, dryRun)
+			if err != nil {
+				return err
+			}
+			verb := "Removed"
+			if dryRun {
+				verb = "Would remove"
+			}
+			fmt.Printf("%s %d objects (%s) and %d tags.\n", verb, response.ObjectsDeleted, units.BytesSize(float64(response.BytesReclaimed)), response.TagsDeleted)
+			if response.ObjectsPending > 0 || response.TagsPending > 0 {
+				fmt.Printf("%d objects and %d tags are unused but within the grace period.\n", response.ObjectsPending, response.TagsPending)
+			}
+			return nil
+		}),
+	}
+	garbageCollect.Flags().DurationVar(&gracePeriod, "grace-period", 0, "Only remove data that has been unused for at least this long (e.g. 1h), so that garbage collection can run while data is being added.")
+	garbageCollect.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Report what would be removed, without removing anything.")
+
+	var showCommits bool
+	storageReport := &cobra.Command{
+		Use:   "storage-report",
+		Short: "Report which repos, commits and pipelines use storage.",
+		Long: `Report which repos, commits and pipelines use storage.
+
+Storage is attributed to each repo and (with --commits) commit that references it, and to each pipeline whose datum tags (which let the pipeline skip datums that it has already processed) reference it.  RECLAIMABLE and EXCLUSIVE count the storage that nothing else references, which deleting the repo, commit or pipeline (followed by "pachctl garbage-collect") would reclaim.  SHARED counts the rest of a commit's storage.
+`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			client, err := client.NewOnUserMachine(!noMetrics, "user")
+			if err != nil {
+				return err
+			}
+			report, err := client.StorageReport()
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			ppspretty.PrintRepoStorageHeader(writer)
+			for _, repoStorage := range report.Repos {
+				ppspretty.PrintRepoStorage(writer, repoStorage)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			if showCommits {
+				fmt.Println()
+				writer = tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+				ppspretty.PrintCommitStorageHeader(writer)
+				for _, repoStorage := range report.Repos {
+					for _, commitStorage := range repoStorage.Commits {
+						ppspretty.PrintCommitStorage(writer, commitStorage)
+					}
+				}
+				if err := writer.Flush(); err != nil {
+					return err
+				}
+			}
+			fmt.Println()
+			writer = tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			ppspretty.PrintPipelineStorageHeader(writer)
+			for _, pipelineStorage := range report.Pipelines {
+				ppspretty.PrintPipelineStorage(writer, pipelineStorage)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			fmt.Printf("\nTotal: %s\n", units.BytesSize(float64(report.SizeBytes)))
+			return nil
 		}),
 	}
+	storageReport.Flags().BoolVar(&showCommits, "commits", false, "Report the storage used by each commit too.")
 
 	var from, to, namespace string
 	migrate := &cobra.Command{
@@ -359,6 +437,7 @@ $ pachctl migrate --from 1.4.8 --to 1.5.0
 	rootCmd.AddCommand(deleteAll)
 	rootCmd.AddCommand(portForward)
 	rootCmd.AddCommand(garbageCollect)
+	rootCmd.AddCommand(storageReport)
 	rootCmd.AddCommand(migrate)
 	return rootCmd, nil
 }
@@ -6,11 +6,14 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	units "github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/src/client"
+	adminclient "github.com/pachyderm/pachyderm/src/client/admin"
 	authclient "github.com/pachyderm/pachyderm/src/client/auth"
 	eprsclient "github.com/pachyderm/pachyderm/src/client/enterprise"
 	healthclient "github.com/pachyderm/pachyderm/src/client/health"
@@ -21,13 +24,16 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
+	adminserver "github.com/pachyderm/pachyderm/src/server/admin/server"
 	authserver "github.com/pachyderm/pachyderm/src/server/auth/server"
+	authtesting "github.com/pachyderm/pachyderm/src/server/auth/testing"
 	eprsserver "github.com/pachyderm/pachyderm/src/server/enterprise/server"
 	"github.com/pachyderm/pachyderm/src/server/health"
 	pfs_server "github.com/pachyderm/pachyderm/src/server/pfs/server"
 	cache_pb "github.com/pachyderm/pachyderm/src/server/pkg/cache/groupcachepb"
 	cache_server "github.com/pachyderm/pachyderm/src/server/pkg/cache/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/migration"
 	"github.com/pachyderm/pachyderm/src/server/pkg/netutil"
@@ -47,7 +53,7 @@ var readinessCheck bool
 var migrate string
 
 func init() {
-	flag.StringVar(&mode, "mode", "full", "Pachd currently supports two modes: full and sidecar.  The former includes everything you need in a full pachd node.  The later runs only PFS, the Auth service, and a stripped-down version of PPS.")
+	flag.StringVar(&mode, "mode", "full", "Pachd currently supports three modes: full, sidecar and local.  The first includes everything you need in a full pachd node.  The second runs only PFS, the Auth service, and a stripped-down version of PPS.  The last runs only PFS, on local storage, without etcd or kubernetes.")
 	flag.BoolVar(&readinessCheck, "readiness-check", false, "Set to true when checking if local pod is ready")
 	flag.StringVar(&migrate, "migrate", "", "Use the format FROM_VERSION-TO_VERSION; e.g. 1.4.8-1.5.0")
 	flag.Parse()
@@ -59,6 +65,7 @@ type appEnv struct {
 	StorageRoot           string `env:"PACH_ROOT,default=/pach"`
 	StorageBackend        string `env:"STORAGE_BACKEND,default="`
 	StorageHostPath       string `env:"STORAGE_HOST_PATH,default="`
+	StorageMirrorURL      string `env:"STORAGE_MIRROR_URL,default="`
 	PPSEtcdPrefix         string `env:"PPS_ETCD_PREFIX,default=pachyderm_pps"`
 	PFSEtcdPrefix         string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
 	AuthEtcdPrefix        string `env:"PACHYDERM_AUTH_ETCD_PREFIX,default=pachyderm_auth"`
@@ -69,19 +76,44 @@ type appEnv struct {
 	Metrics               bool   `env:"METRICS,default=true"`
 	Init                  bool   `env:"INIT,default=false"`
 	BlockCacheBytes       string `env:"BLOCK_CACHE_BYTES,default=1G"`
+	BlockDiskCacheDir     string `env:"BLOCK_DISK_CACHE_DIR,default="`
+	BlockDiskCacheBytes   string `env:"BLOCK_DISK_CACHE_BYTES,default=10G"`
 	PFSCacheSize          string `env:"PFS_CACHE_SIZE,default=0"`
+	PFSDiskCacheDir       string `env:"PFS_DISK_CACHE_DIR,default="`
+	PFSDiskCacheBytes     string `env:"PFS_DISK_CACHE_BYTES,default=10G"`
+	HotTierDir            string `env:"STORAGE_HOT_TIER_DIR,default="`
+	HotTierBytes          string `env:"STORAGE_HOT_TIER_BYTES,default=10G"`
+	CompactionInterval    string `env:"COMPACTION_INTERVAL,default=1h"`
+	CompactionThreshold   int64  `env:"COMPACTION_THRESHOLD,default=10000"`
+	CompactionRate        string `env:"COMPACTION_REQUESTS_PER_SECOND,default=100"`
+	PrefetchParallelism   int    `env:"GET_OBJECTS_PARALLELISM,default=16"`
+	PrefetchWindowBytes   string `env:"GET_OBJECTS_WINDOW_BYTES,default=256M"`
 	WorkerImage           string `env:"WORKER_IMAGE,default="`
 	WorkerSidecarImage    string `env:"WORKER_SIDECAR_IMAGE,default="`
 	WorkerImagePullPolicy string `env:"WORKER_IMAGE_PULL_POLICY,default="`
 	LogLevel              string `env:"LOG_LEVEL,default=info"`
 }
 
+// localAppEnv is the environment of a pachd in local mode, which keeps its
+// metadata in an embedded store (see kv.NewEmbedded) instead of etcd.
+type localAppEnv struct {
+	Port            uint16 `env:"PORT,default=650"`
+	StorageRoot     string `env:"PACH_ROOT,default=/pach"`
+	KVPath          string `env:"KV_PATH,default="`
+	PFSEtcdPrefix   string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
+	BlockCacheBytes string `env:"BLOCK_CACHE_BYTES,default=1G"`
+	PFSCacheSize    string `env:"PFS_CACHE_SIZE,default=0"`
+	LogLevel        string `env:"LOG_LEVEL,default=info"`
+}
+
 func main() {
 	switch mode {
 	case "full":
 		cmdutil.Main(doFullMode, &appEnv{})
 	case "sidecar":
 		cmdutil.Main(doSidecarMode, &appEnv{})
+	case "local":
+		cmdutil.Main(doLocalMode, &localAppEnv{})
 	default:
 		fmt.Printf("unrecognized mode: %s\n", mode)
 	}
@@ -128,7 +160,11 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, int64(pfsCacheSize))
+	pfsDiskCacheBytes, err := units.RAMInBytes(appEnv.PFSDiskCacheBytes)
+	if err != nil {
+		return err
+	}
+	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, int64(pfsCacheSize), appEnv.PFSDiskCacheDir, pfsDiskCacheBytes)
 	if err != nil {
 		return err
 	}
@@ -145,7 +181,33 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress)
+	blockDiskCacheBytes, err := units.RAMInBytes(appEnv.BlockDiskCacheBytes)
+	if err != nil {
+		return err
+	}
+	hotTierBytes, err := units.RAMInBytes(appEnv.HotTierBytes)
+	if err != nil {
+		return err
+	}
+	prefetchWindowBytes, err := units.RAMInBytes(appEnv.PrefetchWindowBytes)
+	if err != nil {
+		return err
+	}
+	prefetch := pfs_server.PrefetchOptions{
+		Parallelism: appEnv.PrefetchParallelism,
+		WindowBytes: prefetchWindowBytes,
+	}
+	blockAPIServer, err := pfs_server.NewBlockAPIServer(etcdAddress, pfs_server.BlockAPIServerOptions{
+		Backend:        appEnv.StorageBackend,
+		Dir:            appEnv.StorageRoot,
+		MirrorURL:      appEnv.StorageMirrorURL,
+		CacheBytes:     blockCacheBytes,
+		DiskCacheDir:   appEnv.BlockDiskCacheDir,
+		DiskCacheBytes: blockDiskCacheBytes,
+		HotTierDir:     appEnv.HotTierDir,
+		HotTierBytes:   hotTierBytes,
+		Prefetch:       prefetch,
+	})
 	if err != nil {
 		return err
 	}
@@ -177,6 +239,74 @@ func doSidecarMode(appEnvObj interface{}) error {
 	)
 }
 
+// doLocalMode runs PFS as a single process, with its metadata in an embedded
+// store (logged to KVPath, or to PACH_ROOT/kv.log) and its objects under
+// PACH_ROOT. Auth is always inactive.
+func doLocalMode(appEnvObj interface{}) error {
+	appEnv := appEnvObj.(*localAppEnv)
+	switch appEnv.LogLevel {
+	case "debug":
+		log.SetLevel(log.DebugLevel)
+	case "info":
+		log.SetLevel(log.InfoLevel)
+	case "error":
+		log.SetLevel(log.ErrorLevel)
+	default:
+		log.Errorf("Unrecognized log level %s, falling back to default of \"info\"", appEnv.LogLevel)
+		log.SetLevel(log.InfoLevel)
+	}
+	if err := os.MkdirAll(appEnv.StorageRoot, 0755); err != nil {
+		return err
+	}
+	kvPath := appEnv.KVPath
+	if kvPath == "" {
+		kvPath = filepath.Join(appEnv.StorageRoot, "kv.log")
+	}
+	store, err := kv.NewEmbedded(kvPath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	// PFS reaches the object and auth APIs through its own address
+	address := fmt.Sprintf("localhost:%d", appEnv.Port)
+	pfsCacheSize, err := strconv.Atoi(appEnv.PFSCacheSize)
+	if err != nil {
+		return err
+	}
+	pfsAPIServer, err := pfs_server.NewAPIServerWithStore(address, store, appEnv.PFSEtcdPrefix, int64(pfsCacheSize), "", 0)
+	if err != nil {
+		return err
+	}
+	blockCacheBytes, err := units.RAMInBytes(appEnv.BlockCacheBytes)
+	if err != nil {
+		return err
+	}
+	blockAPIServer, err := pfs_server.NewBlockAPIServerWithStore(store, pfs_server.BlockAPIServerOptions{
+		Backend:    pfs_server.LocalBackendEnvVar,
+		Dir:        appEnv.StorageRoot,
+		CacheBytes: blockCacheBytes,
+	})
+	if err != nil {
+		return err
+	}
+	healthServer := health.NewHealthServer()
+	return grpcutil.Serve(
+		func(s *grpc.Server) {
+			healthclient.RegisterHealthServer(s, healthServer)
+			pfsclient.RegisterAPIServer(s, pfsAPIServer)
+			pfsclient.RegisterObjectAPIServer(s, blockAPIServer)
+			authclient.RegisterAPIServer(s, &authtesting.InactiveAPIServer{})
+		},
+		grpcutil.ServeOptions{
+			Version:    version.Version,
+			MaxMsgSize: grpcutil.MaxMsgSize,
+		},
+		grpcutil.ServeEnv{
+			GRPCPort: appEnv.Port,
+		},
+	)
+}
+
 func doFullMode(appEnvObj interface{}) error {
 	appEnv := appEnvObj.(*appEnv)
 	if migrate != "" {
@@ -269,10 +399,20 @@ func doFullMode(appEnvObj interface{}) error {
 		address,
 	)
 	cacheServer := cache_server.NewCacheServer(router, appEnv.NumShards)
-	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, int64(pfsCacheSize))
+	pfsDiskCacheBytes, err := units.RAMInBytes(appEnv.PFSDiskCacheBytes)
+	if err != nil {
+		return err
+	}
+	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, int64(pfsCacheSize), appEnv.PFSDiskCacheDir, pfsDiskCacheBytes)
 	if err != nil {
 		return err
 	}
+	// Workers get a hot tier if pachd has one. It's always write-through,
+	// since the workers' hot tiers don't outlive them.
+	var workerHotTierBytes string
+	if appEnv.HotTierDir != "" {
+		workerHotTierBytes = appEnv.HotTierBytes
+	}
 	ppsAPIServer, err := pps_server.NewAPIServer(
 		etcdAddress,
 		appEnv.PPSEtcdPrefix,
@@ -284,7 +424,9 @@ func doFullMode(appEnvObj interface{}) error {
 		appEnv.WorkerImagePullPolicy,
 		appEnv.StorageRoot,
 		appEnv.StorageBackend,
+		appEnv.StorageMirrorURL,
 		appEnv.StorageHostPath,
+		workerHotTierBytes,
 		reporter,
 	)
 	if err != nil {
@@ -304,7 +446,48 @@ func doFullMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress)
+	blockDiskCacheBytes, err := units.RAMInBytes(appEnv.BlockDiskCacheBytes)
+	if err != nil {
+		return err
+	}
+	hotTierBytes, err := units.RAMInBytes(appEnv.HotTierBytes)
+	if err != nil {
+		return err
+	}
+	prefetchWindowBytes, err := units.RAMInBytes(appEnv.PrefetchWindowBytes)
+	if err != nil {
+		return err
+	}
+	prefetch := pfs_server.PrefetchOptions{
+		Parallelism: appEnv.PrefetchParallelism,
+		WindowBytes: prefetchWindowBytes,
+	}
+	// Only pachd compacts objects in the background; worker sidecars don't
+	compactionInterval, err := time.ParseDuration(appEnv.CompactionInterval)
+	if err != nil {
+		return err
+	}
+	compactionRate, err := strconv.ParseFloat(appEnv.CompactionRate, 64)
+	if err != nil {
+		return err
+	}
+	compaction := pfs_server.CompactionOptions{
+		Interval:          compactionInterval,
+		Threshold:         appEnv.CompactionThreshold,
+		RequestsPerSecond: compactionRate,
+	}
+	blockAPIServer, err := pfs_server.NewBlockAPIServer(etcdAddress, pfs_server.BlockAPIServerOptions{
+		Backend:        appEnv.StorageBackend,
+		Dir:            appEnv.StorageRoot,
+		MirrorURL:      appEnv.StorageMirrorURL,
+		CacheBytes:     blockCacheBytes,
+		DiskCacheDir:   appEnv.BlockDiskCacheDir,
+		DiskCacheBytes: blockDiskCacheBytes,
+		HotTierDir:     appEnv.HotTierDir,
+		HotTierBytes:   hotTierBytes,
+		Compaction:     compaction,
+		Prefetch:       prefetch,
+	})
 	if err != nil {
 		return err
 	}
@@ -317,6 +500,10 @@ func doFullMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
+	adminAPIServer, err := adminserver.NewAPIServer(address, etcdAddress, appEnv.PFSEtcdPrefix, appEnv.PPSEtcdPrefix)
+	if err != nil {
+		return err
+	}
 
 	healthServer := health.NewHealthServer()
 
@@ -338,6 +525,7 @@ func doFullMode(appEnvObj interface{}) error {
 				cache_pb.RegisterGroupCacheServer(s, cacheServer)
 				authclient.RegisterAPIServer(s, authAPIServer)
 				eprsclient.RegisterAPIServer(s, enterpriseAPIServer)
+				adminclient.RegisterAPIServer(s, adminAPIServer)
 			},
 			grpcutil.ServeOptions{
 				Version:    version.Version,
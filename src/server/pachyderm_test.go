@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
@@ -2356,6 +2357,95 @@ func TestPipelineThatSymlinks(t *testing.T) {
 	require.Equal(t, inputFooFileInfo.Objects, outputFooFileInfo.Objects)
 }
 
+func TestExtractRestore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := uniqueString("TestExtractRestore_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.SetRepoQuota(dataRepo, 1<<30, 0))
+	pipeline := uniqueString("TestExtractRestore")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	for i := 0; i < 3; i++ {
+		_, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(dataRepo, "master", fmt.Sprintf("file%d", i), strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(dataRepo, "master"))
+	}
+	commitIter, err := c.FlushCommit([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collectCommitInfos(t, commitIter)))
+	commitInfos, err := c.ListCommit(pipeline, "", "", 0)
+	require.NoError(t, err)
+	jobInfos, err := c.ListJob(pipeline, nil)
+	require.NoError(t, err)
+
+	var backup bytes.Buffer
+	require.NoError(t, c.ExtractWriter(true, &backup))
+	// Restoring into a cluster that isn't empty fails
+	require.YesError(t, c.RestoreReader(bytes.NewReader(backup.Bytes())))
+	require.NoError(t, c.DeleteAll())
+	// A backup with an empty op is rejected
+	require.YesError(t, c.Restore([]*admin.Op{nil}))
+	require.NoError(t, c.RestoreReader(&backup))
+
+	quotaInfos, err := c.ListQuota()
+	require.NoError(t, err)
+	var restoredQuota bool
+	for _, quotaInfo := range quotaInfos {
+		if quotaInfo.Repo != nil && quotaInfo.Repo.Name == dataRepo {
+			require.Equal(t, &pfs.Quota{SizeBytes: 1 << 30}, quotaInfo.Quota)
+			restoredQuota = true
+		}
+	}
+	require.True(t, restoredQuota)
+
+	restoredCommitInfos, err := c.ListCommit(pipeline, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, len(commitInfos), len(restoredCommitInfos))
+	for i := range commitInfos {
+		require.Equal(t, commitInfos[i].Commit.ID, restoredCommitInfos[i].Commit.ID)
+	}
+	restoredJobInfos, err := c.ListJob(pipeline, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(jobInfos), len(restoredJobInfos))
+	for i := 0; i < 3; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(pipeline, "master", fmt.Sprintf("file%d", i), 0, 0, &buf))
+		require.Equal(t, "foo\n", buf.String())
+	}
+
+	// The restored pipeline processes new commits
+	_, err = c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, "master", "file3", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, "master"))
+	commitIter, err = c.FlushCommit([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collectCommitInfos(t, commitIter)))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(pipeline, "master", "file3", 0, 0, &buf))
+	require.Equal(t, "foo\n", buf.String())
+}
+
 // TestChainedPipelines tracks https://github.com/pachyderm/pachyderm/issues/797
 func TestChainedPipelines(t *testing.T) {
 	if testing.Short() {
@@ -4020,6 +4110,90 @@ func TestGarbageCollection(t *testing.T) {
 	require.Equal(t, "barbar\n", buf.String())
 }
 
+func TestStorageReport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	dataRepo := uniqueString("TestStorageReport")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	foo := uniqueString("foo")
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit1.ID, "foo", strings.NewReader(foo))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit2.ID, "bar", strings.NewReader(uniqueString("bar")))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.ID))
+
+	report, err := c.StorageReport()
+	require.NoError(t, err)
+	var repoStorage *pps.RepoStorage
+	for _, r := range report.Repos {
+		if r.Repo.Name == dataRepo {
+			repoStorage = r
+		}
+	}
+	require.NotNil(t, repoStorage)
+	require.True(t, repoStorage.SizeBytes > 0)
+	require.Equal(t, repoStorage.SizeBytes, repoStorage.ExclusiveBytes)
+	require.True(t, report.SizeBytes >= repoStorage.SizeBytes)
+	require.Equal(t, 2, len(repoStorage.Commits))
+	// Both commits contain "foo", and nothing else is shared
+	for _, commitStorage := range repoStorage.Commits {
+		require.Equal(t, int64(len(foo)), commitStorage.SharedBytes)
+		require.True(t, commitStorage.ExclusiveBytes > 0)
+		require.Equal(t, commitStorage.SizeBytes, commitStorage.ExclusiveBytes+commitStorage.SharedBytes)
+	}
+}
+
+func TestGarbageCollectionGracePeriod(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	if os.Getenv(InCloudEnv) == "" {
+		t.Skip("Skipping this test as it can only be run in the cloud.")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	require.NoError(t, c.GarbageCollect())
+
+	dataRepo := uniqueString("TestGarbageCollectionGracePeriod")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	_, err := c.PutFile(dataRepo, "master", "foo", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteRepo(dataRepo, false))
+	objectsBefore := getAllObjects(t, c)
+
+	// A dry run reports the unused objects without deleting them
+	response, err := c.GarbageCollectWithGracePeriod(0, true)
+	require.NoError(t, err)
+	require.True(t, response.ObjectsDeleted > 0)
+	require.True(t, response.BytesReclaimed > 0)
+	require.Equal(t, len(objectsBefore), len(getAllObjects(t, c)))
+
+	// Objects that were only just found to be unused aren't deleted
+	response, err = c.GarbageCollectWithGracePeriod(2*time.Second, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), response.ObjectsDeleted)
+	require.True(t, response.ObjectsPending > 0)
+	require.Equal(t, len(objectsBefore), len(getAllObjects(t, c)))
+
+	// ...until they've been unused for the grace period
+	time.Sleep(2 * time.Second)
+	response, err = c.GarbageCollectWithGracePeriod(2*time.Second, false)
+	require.NoError(t, err)
+	require.True(t, response.ObjectsDeleted > 0)
+	require.Equal(t, int64(0), response.ObjectsPending)
+	require.Equal(t, len(objectsBefore)-int(response.ObjectsDeleted), len(getAllObjects(t, c)))
+}
+
 func TestPipelineWithStats(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -13,15 +13,19 @@ import (
 	"strings"
 	"syscall"
 	"text/tabwriter"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pfs/fuse"
 	"github.com/pachyderm/pachyderm/src/server/pfs/pretty"
+	"github.com/pachyderm/pachyderm/src/server/pfs/replicate"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/sync"
 
@@ -126,6 +130,8 @@ func Cmds(noMetrics *bool) []*cobra.Command {
 	rawFlag(inspectRepo)
 
 	var listRepoProvenance cmdutil.RepeatedStringArg
+	var pageSize int64
+	var pageToken string
 	listRepo := &cobra.Command{
 		Use:   "list-repo",
 		Short: "Return all repos.",
@@ -135,10 +141,21 @@ func Cmds(noMetrics *bool) []*cobra.Command {
 			if err != nil {
 				return err
 			}
-			repoInfos, err := c.ListRepo(listRepoProvenance)
+			var repoInfos []*pfsclient.RepoInfo
+			var nextPageToken string
+			if pageSize > 0 || pageToken != "" {
+				repoInfos, nextPageToken, err = c.ListRepoPage(listRepoProvenance, pageSize, pageToken)
+			} else {
+				repoInfos, err = c.ListRepo(listRepoProvenance)
+			}
 			if err != nil {
 				return err
 			}
+			defer func() {
+				if nextPageToken != "" {
+					fmt.Fprintf(os.Stderr, "There are more repos; list them with --page-token=%s\n", nextPageToken)
+				}
+			}()
 			if raw {
 				for _, repoInfo := range repoInfos {
 					if err := marshaller.Marshal(os.Stdout, repoInfo); err != nil {
@@ -156,6 +173,8 @@ func Cmds(noMetrics *bool) []*cobra.Command {
 		}),
 	}
 	listRepo.Flags().VarP(&listRepoProvenance, "provenance", "p", "list only repos with the specified repos provenance")
+	listRepo.Flags().Int64Var(&pageSize, "page-size", 0, "list at most this many repos, newest first; if set to zero, list all repos")
+	listRepo.Flags().StringVar(&pageToken, "page-token", "", "list the repos after the previous page, as printed by the previous list-repo")
 	rawFlag(listRepo)
 
 	var force bool
@@ -193,6 +212,121 @@ func Cmds(noMetrics *bool) []*cobra.Command {
 	deleteRepo.Flags().BoolVarP(&force, "force", "f", false, "remove the repo regardless of errors; use with care")
 	deleteRepo.Flags().BoolVar(&all, "all", false, "remove all repos")
 
+	forkRepo := &cobra.Command{
+		Use:   "fork-repo src-repo dst-repo",
+		Short: "Create a new repo as a copy of an existing repo.",
+		Long: `Create a new repo as a copy of an existing repo.
+
+The new repo starts with the same commits and branches as the source repo.
+No data is copied; both repos reference the same objects. Commits made to
+either repo after the fork are not visible in the other.
+
+Examples:
+
+` + codestart + `# fork repo "foo" into a new repo "foo-sandbox"
+$ pachctl fork-repo foo foo-sandbox
+` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			_, err = c.PfsAPIClient.ForkRepo(
+				c.Ctx(),
+				&pfsclient.ForkRepoRequest{
+					Src:         client.NewRepo(args[0]),
+					Dst:         client.NewRepo(args[1]),
+					Description: description,
+				},
+			)
+			return err
+		}),
+	}
+	forkRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the new repo; defaults to the description of the source repo.")
+
+	var quotaRepo string
+	var quotaUser string
+	var quotaSize string
+	var quotaFiles uint64
+	setQuota := &cobra.Command{
+		Use:   "set-quota",
+		Short: "Limit the storage used by a repo or user.",
+		Long: `Limit the storage used by a repo or user.
+
+Writes that would take a repo, or the author of the commit being written, over
+quota are rejected. A user's usage counts the files in every commit that they
+have authored. A limit of 0 means that there's no limit; setting both limits to
+0 removes the quota. If auth is activated, only cluster admins may set quotas.
+
+Examples:
+
+` + codestart + `# limit repo "foo" to 10 gigabytes and a million files
+$ pachctl set-quota --repo foo --size 10GB --files 1000000
+
+# limit the commits authored by user "alice" to 500 megabytes
+$ pachctl set-quota --user alice --size 500MB
+
+# remove the quota of repo "foo"
+$ pachctl set-quota --repo foo
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if (quotaRepo == "") == (quotaUser == "") {
+				return fmt.Errorf("exactly one of --repo and --user must be provided")
+			}
+			var sizeBytes int64
+			if quotaSize != "" {
+				var err error
+				sizeBytes, err = units.RAMInBytes(quotaSize)
+				if err != nil {
+					return fmt.Errorf("could not parse --size: %v", err)
+				}
+			}
+			c, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			if quotaRepo != "" {
+				return c.SetRepoQuota(quotaRepo, uint64(sizeBytes), quotaFiles)
+			}
+			return c.SetUserQuota(quotaUser, uint64(sizeBytes), quotaFiles)
+		}),
+	}
+	setQuota.Flags().StringVarP(&quotaRepo, "repo", "r", "", "The repo whose quota is set.")
+	setQuota.Flags().StringVarP(&quotaUser, "user", "u", "", "The user whose quota is set.")
+	setQuota.Flags().StringVarP(&quotaSize, "size", "s", "", "The maximum size of the data, e.g. 500MB or 10GB.")
+	setQuota.Flags().Uint64VarP(&quotaFiles, "files", "f", 0, "The maximum number of files.")
+
+	listQuota := &cobra.Command{
+		Use:   "list-quota",
+		Short: "Return the storage used by every repo and user, and their quotas.",
+		Long:  "Return the storage used by every repo and user, and their quotas. If auth is activated, only cluster admins may list quotas.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			quotaInfos, err := c.ListQuota()
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, quotaInfo := range quotaInfos {
+					if err := marshaller.Marshal(os.Stdout, quotaInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintQuotaHeader(writer)
+			for _, quotaInfo := range quotaInfos {
+				pretty.PrintQuotaInfo(writer, quotaInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	rawFlag(listQuota)
+
 	commit := &cobra.Command{
 		Use:   "commit",
 		Short: "Docs for commits.",
@@ -233,9 +367,12 @@ $ pachctl start-commit test patch -p master
 
 # Start a commit with XXX as the parent in repo "test", not on any branch
 $ pachctl start-commit test -p XXX
+
+# Start a commit in repo "test" on branch "master" with a description
+$ pachctl start-commit test master -m "add cat labels"
 ` + codeend,
 		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
-			client, err := client.NewOnUserMachine(metrics, "user")
+			c, err := client.NewOnUserMachine(metrics, "user")
 			if err != nil {
 				return err
 			}
@@ -243,7 +380,14 @@ $ pachctl start-commit test -p XXX
 			if len(args) == 2 {
 				branch = args[1]
 			}
-			commit, err := client.StartCommitParent(args[0], branch, parent)
+			commit, err := c.PfsAPIClient.StartCommit(
+				c.Ctx(),
+				&pfsclient.StartCommitRequest{
+					Parent:      client.NewCommit(args[0], parent),
+					Branch:      branch,
+					Description: description,
+				},
+			)
 			if err != nil {
 				return err
 			}
@@ -252,19 +396,28 @@ $ pachctl start-commit test -p XXX
 		}),
 	}
 	startCommit.Flags().StringVarP(&parent, "parent", "p", "", "The parent of the new commit, unneeded if branch is specified and you want to use the previous head of the branch as the parent.")
+	startCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents")
 
 	finishCommit := &cobra.Command{
 		Use:   "finish-commit repo-name commit-id",
 		Short: "Finish a started commit.",
 		Long:  "Finish a started commit. Commit-id must be a writeable commit.",
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
-			client, err := client.NewOnUserMachine(metrics, "user")
+			c, err := client.NewOnUserMachine(metrics, "user")
 			if err != nil {
 				return err
 			}
-			return client.FinishCommit(args[0], args[1])
+			_, err = c.PfsAPIClient.FinishCommit(
+				c.Ctx(),
+				&pfsclient.FinishCommitRequest{
+					Commit:      client.NewCommit(args[0], args[1]),
+					Description: description,
+				},
+			)
+			return err
 		}),
 	}
+	finishCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents (overwrites any existing commit description)")
 
 	inspectCommit := &cobra.Command{
 		Use:   "inspect-commit repo-name commit-id",
@@ -313,6 +466,9 @@ $ pachctl list-commit foo XXX
 
 # return commits in repo "foo" since commit XXX
 $ pachctl list-commit foo master --from XXX
+
+# return the 20 commits in repo "foo" on branch "master" before commit XXX
+$ pachctl list-commit foo master -n 20 --page-token XXX
 ` + codeend,
 		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) (retErr error) {
 			c, err := client.NewOnUserMachine(metrics, "user")
@@ -325,10 +481,21 @@ $ pachctl list-commit foo master --from XXX
 				to = args[1]
 			}
 
-			commitInfos, err := c.ListCommit(args[0], to, from, uint64(number))
+			var commitInfos []*pfsclient.CommitInfo
+			var nextPageToken string
+			if number > 0 || pageToken != "" {
+				commitInfos, nextPageToken, err = c.ListCommitPage(args[0], to, from, uint64(number), pageToken)
+			} else {
+				commitInfos, err = c.ListCommit(args[0], to, from, uint64(number))
+			}
 			if err != nil {
 				return err
 			}
+			defer func() {
+				if nextPageToken != "" {
+					fmt.Fprintf(os.Stderr, "There are more commits; list them with --page-token=%s\n", nextPageToken)
+				}
+			}()
 
 			if raw {
 				for _, commitInfo := range commitInfos {
@@ -348,6 +515,7 @@ $ pachctl list-commit foo master --from XXX
 	}
 	listCommit.Flags().StringVarP(&from, "from", "f", "", "list all commits since this commit")
 	listCommit.Flags().IntVarP(&number, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
+	listCommit.Flags().StringVar(&pageToken, "page-token", "", "list the commits after the previous page, as printed by the previous list-commit")
 	rawFlag(listCommit)
 
 	printCommitIter := func(commitIter client.CommitInfoIterator) error {
@@ -837,6 +1005,50 @@ $ pachctl list-file foo master^2
 	}
 	rawFlag(listFile)
 
+	var historyLength int64
+	logFile := &cobra.Command{
+		Use:   "log-file repo-name commit-id path/to/file",
+		Short: "Return the commits in which a file was changed.",
+		Long: `Return the commits in which a file was changed or deleted, starting from
+the given commit and going back through its ancestors.
+
+Examples:
+
+` + codestart + `# Return the commits on branch "master" in repo "foo" that changed file "XXX"
+$ pachctl log-file foo master XXX
+
+# Return the last 3 commits on branch "master" in repo "foo" that changed
+# file "XXX"
+$ pachctl log-file foo master XXX -n 3
+` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			fileInfos, err := client.ListFileHistory(args[0], args[1], args[2], historyLength)
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, fileInfo := range fileInfos {
+					if err := marshaller.Marshal(os.Stdout, fileInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintFileHistoryHeader(writer)
+			for _, fileInfo := range fileInfos {
+				pretty.PrintFileHistory(writer, fileInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	logFile.Flags().Int64VarP(&historyLength, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
+	rawFlag(logFile)
+
 	globFile := &cobra.Command{
 		Use:   "glob-file repo-name commit-id pattern",
 		Short: "Return files that match a glob pattern in a commit.",
@@ -880,7 +1092,45 @@ $ pachctl glob-file foo master "data/*"
 	}
 	rawFlag(globFile)
 
+	var maxMatches int64
+	grepFile := &cobra.Command{
+		Use:   "grep-file repo-name commit-id pattern regex",
+		Short: "Return lines that match a regular expression in files in a commit.",
+		Long: `Return lines that match a regular expression in the files that match a glob
+pattern in a commit. Directories that match the pattern are searched
+recursively. The search runs inside pachd, so file contents aren't downloaded.
+Matching lines are printed as "path:line-number:line". Regular expressions
+use the syntax documented [here](https://golang.org/pkg/regexp/syntax/).
+
+Examples:
+
+` + codestart + `# Return lines containing "error" in files under directory "logs" in
+# repo "foo" on branch "master".
+$ pachctl grep-file foo master "logs" "error"
+
+# Return the first 10 lines that start with a number in files that end in
+# ".csv".
+$ pachctl grep-file foo master "*.csv" "^[0-9]" --max-matches 10
+` + codeend,
+		Run: cmdutil.RunFixedArgs(4, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.GrepFile(args[0], args[1], args[2], args[3], maxMatches, func(match *pfsclient.GrepFileResponse) error {
+				if raw {
+					return marshaller.Marshal(os.Stdout, match)
+				}
+				_, err := fmt.Printf("%s:%d:%s\n", match.File.Path, match.LineNumber, match.Line)
+				return err
+			})
+		}),
+	}
+	grepFile.Flags().Int64VarP(&maxMatches, "max-matches", "m", 0, "The maximum number of matching lines to return; 0 returns all of them.")
+	rawFlag(grepFile)
+
 	var shallow bool
+	var content bool
 	diffFile := &cobra.Command{
 		Use:   "diff-file new-repo-name new-commit-id new-path [old-repo-name old-commit-id old-path]",
 		Short: "Return a diff of two file trees.",
@@ -893,6 +1143,9 @@ $ pachctl diff-file foo master path
 
 # Return the diff between foo master path1 and bar master path2.
 $ pachctl diff-file foo master path1 bar master path2
+
+# Return the changes to the contents of the files under foo master path.
+$ pachctl diff-file foo master path --content
 ` + codeend,
 		Run: cmdutil.RunBoundedArgs(3, 6, func(args []string) error {
 			client, err := client.NewOnUserMachine(metrics, "user")
@@ -901,7 +1154,12 @@ $ pachctl diff-file foo master path1 bar master path2
 			}
 			var newFiles []*pfsclient.FileInfo
 			var oldFiles []*pfsclient.FileInfo
+			var contentDiffs []*pfsclient.FileContentDiff
 			switch {
+			case len(args) == 3 && content:
+				newFiles, oldFiles, contentDiffs, err = client.DiffFileContent(args[0], args[1], args[2], "", "", "", shallow, 0)
+			case len(args) == 6 && content:
+				newFiles, oldFiles, contentDiffs, err = client.DiffFileContent(args[0], args[1], args[2], args[3], args[4], args[5], shallow, 0)
 			case len(args) == 3:
 				newFiles, oldFiles, err = client.DiffFile(args[0], args[1], args[2], "", "", "", shallow)
 			case len(args) == 6:
@@ -912,6 +1170,12 @@ $ pachctl diff-file foo master path1 bar master path2
 			if err != nil {
 				return err
 			}
+			if content {
+				for _, contentDiff := range contentDiffs {
+					pretty.PrintFileContentDiff(os.Stdout, contentDiff)
+				}
+				return nil
+			}
 			if len(newFiles) > 0 {
 				fmt.Println("New Files:")
 				writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
@@ -938,6 +1202,7 @@ $ pachctl diff-file foo master path1 bar master path2
 		}),
 	}
 	diffFile.Flags().BoolVarP(&shallow, "shallow", "s", false, "Specifies whether or not to diff subdirectories")
+	diffFile.Flags().BoolVar(&content, "content", false, "Show the changes to the contents of text files, as a line diff or, for JSON files, a diff of their keys")
 
 	deleteFile := &cobra.Command{
 		Use:   "delete-file repo-name commit-id path/to/file",
@@ -978,6 +1243,205 @@ $ pachctl diff-file foo master path1 bar master path2
 		}),
 	}
 
+	compact := &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the objects in the object store.",
+		Long: `Compact the objects in the object store.
+
+Compaction rewrites the objects that have been written since it last ran into
+larger blocks and merges them into the object indexes. pachd compacts objects
+in the background, so this is rarely necessary. compact blocks until the
+compaction is done; use inspect-compaction to follow its progress.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.Compact()
+		}),
+	}
+
+	inspectCompaction := &cobra.Command{
+		Use:   "inspect-compaction",
+		Short: "Return the progress of the running (or last) compaction.",
+		Long:  "Return the progress of the running compaction of objects, or the results of the last one if none is running.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			compactionInfo, err := client.InspectCompaction()
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, compactionInfo)
+			}
+			return pretty.PrintDetailedCompactionInfo(compactionInfo)
+		}),
+	}
+	rawFlag(inspectCompaction)
+
+	var fix bool
+	fsck := &cobra.Command{
+		Use:   "fsck",
+		Short: "Check the consistency of PFS.",
+		Long: `Check the consistency of PFS.
+
+fsck checks every commit's tree and the objects that it references, along with
+commit provenance and branches, and prints each problem that it finds. With
+--fix, problems that can be fixed without losing data are fixed. If auth is
+activated, only cluster admins may run fsck.
+
+Examples:
+
+` + codestart + `# check PFS for problems
+$ pachctl fsck
+
+# check PFS for problems, and fix those that can be fixed safely
+$ pachctl fsck --fix
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			responses, err := c.Fsck(fix)
+			if err != nil {
+				return err
+			}
+			var unfixed int
+			for _, response := range responses {
+				if response.Fixed {
+					fmt.Printf("fixed: %s\n", response.Error)
+				} else {
+					fmt.Printf("error: %s\n", response.Error)
+					unfixed++
+				}
+			}
+			if unfixed > 0 {
+				return fmt.Errorf("found %d problem(s) that were not fixed", unfixed)
+			}
+			return nil
+		}),
+	}
+	fsck.Flags().BoolVar(&fix, "fix", false, "Fix the problems that can be fixed safely.")
+
+	var target string
+	replicateCmd := &cobra.Command{
+		Use:   "replicate repo/branch [repo/branch ...]",
+		Short: "Continuously copy branches to another cluster. This command blocks.",
+		Long: `Continuously copy branches to another cluster. This command blocks.
+
+Each commit on the given branches is created in the target cluster with the
+same ID and provenance as soon as it's finished, and only the objects that the
+target is missing are copied. If the branch is omitted, "master" is
+replicated. Replication resumes from the branch's head in the target, so the
+command can be restarted at any time. The target's copies of the repos should
+only be written to by replication.
+
+Examples:
+
+` + codestart + `# replicate the master branches of repos "foo" and "bar" to another cluster
+$ pachctl replicate foo bar --target 10.0.0.2:650
+
+# replicate branch "staging" of repo "foo"
+$ pachctl replicate foo/staging --target 10.0.0.2:650
+` + codeend,
+		Run: cmdutil.Run(func(args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("at least one repo must be given")
+			}
+			if target == "" {
+				return fmt.Errorf("--target must be set")
+			}
+			branches, err := cmdutil.ParseCommits(args)
+			if err != nil {
+				return err
+			}
+			source, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			targetClient, err := client.NewFromAddress(target)
+			if err != nil {
+				return fmt.Errorf("could not connect to %s: %v", target, err)
+			}
+			var eg errgroup.Group
+			for _, branch := range branches {
+				repo, branchName := branch.Repo.Name, branch.ID
+				if branchName == "" {
+					branchName = "master"
+				}
+				eg.Go(func() error {
+					return backoff.RetryNotify(func() error {
+						return replicate.Replicate(source, targetClient, repo, branchName)
+					}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+						fmt.Fprintf(os.Stderr, "error replicating %s/%s: %v; retrying in %v\n", repo, branchName, err, d)
+						return nil
+					})
+				})
+			}
+			return eg.Wait()
+		}),
+	}
+	replicateCmd.Flags().StringVarP(&target, "target", "t", "", "The address of the pachd to replicate to.")
+
+	var syncDelete bool
+	var dryRun bool
+	syncCmd := &cobra.Command{
+		Use:   "sync source destination",
+		Short: "Make a directory in pfs match a local directory, or vice versa.",
+		Long: `Make a directory in pfs match a local directory, or vice versa.
+
+One of source and destination is a local directory and the other is a
+directory in a branch, written as repo@branch:/path (the path defaults to the
+root of the branch). Local paths containing "@" must be written with a "/" in
+them, e.g. "./data@2017". Files are compared by hash, and only the files whose
+content, permissions or symlink target differ are copied. Changes to pfs are
+made in a single commit.
+
+Examples:
+
+` + codestart + `# upload the changes in local directory "data" to directory "/data" in branch
+# "master" of repo "foo"
+$ pachctl sync data foo@master:/data
+
+# download the changes in the root of branch "master" of repo "foo", and delete
+# local files that aren't in the branch
+$ pachctl sync foo@master ./foo --delete
+
+# show which files would be uploaded without uploading them
+$ pachctl sync data foo@master:/data --dry-run
+` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			srcRepo, srcBranch, srcPath, srcRemote := parseSyncRemote(args[0])
+			dstRepo, dstBranch, dstPath, dstRemote := parseSyncRemote(args[1])
+			if srcRemote == dstRemote {
+				return fmt.Errorf("exactly one of source and destination must be of the form repo@branch:/path")
+			}
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			var changes []*sync.Change
+			if dstRemote {
+				changes, err = sync.Upload(client, args[0], dstRepo, dstBranch, dstPath, syncDelete, dryRun)
+			} else {
+				changes, err = sync.Download(client, args[1], srcRepo, srcBranch, srcPath, syncDelete, dryRun)
+			}
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				fmt.Printf("%s %s\n", change.Type, change.Path)
+			}
+			return nil
+		}),
+	}
+	syncCmd.Flags().BoolVar(&syncDelete, "delete", false, "Delete files in the destination that aren't in the source.")
+	syncCmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Print the changes that would be made without making them.")
+
 	var debug bool
 	var allCommits bool
 	mount := &cobra.Command{
@@ -1065,6 +1529,9 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, inspectRepo)
 	result = append(result, listRepo)
 	result = append(result, deleteRepo)
+	result = append(result, forkRepo)
+	result = append(result, setQuota)
+	result = append(result, listQuota)
 	result = append(result, commit)
 	result = append(result, startCommit)
 	result = append(result, finishCommit)
@@ -1082,10 +1549,17 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, inspectFile)
 	result = append(result, listFile)
 	result = append(result, globFile)
+	result = append(result, grepFile)
+	result = append(result, logFile)
 	result = append(result, diffFile)
 	result = append(result, deleteFile)
 	result = append(result, getObject)
 	result = append(result, getTag)
+	result = append(result, compact)
+	result = append(result, inspectCompaction)
+	result = append(result, fsck)
+	result = append(result, replicateCmd)
+	result = append(result, syncCmd)
 	result = append(result, mount)
 	result = append(result, unmount)
 	return result
@@ -1109,6 +1583,24 @@ func parseCommitMounts(args []string) []*fuse.CommitMount {
 	return result
 }
 
+// parseSyncRemote parses an argument to sync of the form repo@branch:/path,
+// where the path is optional. ok is false if 'arg' isn't of that form, in
+// which case it's a local path.
+func parseSyncRemote(arg string) (repo string, branch string, filePath string, ok bool) {
+	i := strings.Index(arg, "@")
+	if i <= 0 || strings.ContainsAny(arg[:i], "/:") {
+		return "", "", "", false
+	}
+	branch = arg[i+1:]
+	if j := strings.Index(branch, ":"); j >= 0 {
+		branch, filePath = branch[:j], branch[j+1:]
+	}
+	if branch == "" {
+		return "", "", "", false
+	}
+	return arg[:i], branch, filePath, true
+}
+
 func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 	recursive bool, overwrite bool, limiter limit.ConcurrencyLimiter, split string,
 	targetFileDatums uint, targetFileBytes uint) (retErr error) {
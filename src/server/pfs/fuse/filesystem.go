@@ -251,14 +251,50 @@ func (f *file) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
 	if err != nil {
 		return err
 	}
+	a.Mode = 0666
 	if fileInfo != nil {
 		a.Size = fileInfo.SizeBytes
+		if fileInfo.Mode != 0 {
+			a.Mode = os.FileMode(fileInfo.Mode)
+		}
+		if fileInfo.Mtime != nil {
+			a.Mtime, _ = types.TimestampFromProto(fileInfo.Mtime)
+		}
 	}
-	a.Mode = 0666
 	a.Inode = f.fs.inode(f.File)
 	return nil
 }
 
+// symlink is a symbolic link in a commit
+type symlink struct {
+	directory
+	target string
+	mtime  *types.Timestamp
+}
+
+func (s *symlink) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
+	defer func() {
+		if retErr == nil {
+			log.Debug(&FileAttr{&s.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)})
+		} else {
+			log.Error(&FileAttr{&s.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)})
+		}
+	}()
+	a.Valid = time.Nanosecond
+	// Symlinks' permissions are ignored, so they're always 0777
+	a.Mode = os.ModeSymlink | 0777
+	a.Size = uint64(len(s.target))
+	if s.mtime != nil {
+		a.Mtime, _ = types.TimestampFromProto(s.mtime)
+	}
+	a.Inode = s.fs.inode(s.File)
+	return nil
+}
+
+func (s *symlink) Readlink(ctx context.Context, request *fuse.ReadlinkRequest) (string, error) {
+	return s.target, nil
+}
+
 func (f *file) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *fuse.SetattrResponse) (retErr error) {
 	defer func() {
 		if retErr == nil {
@@ -614,6 +650,12 @@ func (d *directory) lookUpFile(ctx context.Context, name string) (fs.Node, error
 		}, nil
 	case pfsclient.FileType_DIR:
 		return directory, nil
+	case pfsclient.FileType_SYMLINK:
+		return &symlink{
+			directory: *directory,
+			target:    fileInfo.SymlinkTarget,
+			mtime:     fileInfo.Mtime,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unrecognized file type")
 	}
@@ -681,6 +723,8 @@ func (d *directory) readFiles(ctx context.Context) ([]fuse.Dirent, error) {
 			result = append(result, fuse.Dirent{Name: shortPath, Type: fuse.DT_File})
 		case pfsclient.FileType_DIR:
 			result = append(result, fuse.Dirent{Name: shortPath, Type: fuse.DT_Dir})
+		case pfsclient.FileType_SYMLINK:
+			result = append(result, fuse.Dirent{Name: shortPath, Type: fuse.DT_Link})
 		default:
 			continue
 		}
@@ -41,6 +41,16 @@ type ErrParentCommitNotFound struct {
 	Commit *pfs.Commit
 }
 
+// ErrQuotaExceeded represents an error where a write would take a repo or a
+// user over its quota. Exactly one of Repo and User is set.
+type ErrQuotaExceeded struct {
+	Repo  *pfs.Repo
+	User  string
+	Quota *pfs.Quota
+	// Usage is what the usage would be after the write
+	Usage *pfs.Usage
+}
+
 func (e ErrFileNotFound) Error() string {
 	return fmt.Sprintf("file %v not found in repo %v at commit %v", e.File.Path, e.File.Commit.Repo.Name, e.File.Commit.ID)
 }
@@ -69,6 +79,17 @@ func (e ErrParentCommitNotFound) Error() string {
 	return fmt.Sprintf("parent commit %v not found in repo %v", e.Commit.ID, e.Commit.Repo.Name)
 }
 
+func (e ErrQuotaExceeded) Error() string {
+	owner := fmt.Sprintf("user %v", e.User)
+	if e.Repo != nil {
+		owner = fmt.Sprintf("repo %v", e.Repo.Name)
+	}
+	if e.Quota.SizeBytes != 0 && e.Usage.SizeBytes > e.Quota.SizeBytes {
+		return fmt.Sprintf("quota exceeded for %s: write would use %d bytes, but the quota is %d bytes", owner, e.Usage.SizeBytes, e.Quota.SizeBytes)
+	}
+	return fmt.Sprintf("quota exceeded for %s: write would use %d files, but the quota is %d files", owner, e.Usage.FileCount, e.Quota.FileCount)
+}
+
 // ByteRangeSize returns byteRange.Upper - byteRange.Lower.
 func ByteRangeSize(byteRange *pfs.ByteRange) uint64 {
 	return byteRange.Upper - byteRange.Lower
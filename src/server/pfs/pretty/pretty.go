@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -59,7 +60,7 @@ func PrintBranch(w io.Writer, branch *pfs.BranchInfo) {
 
 // PrintCommitInfoHeader prints a commit info header.
 func PrintCommitInfoHeader(w io.Writer) {
-	fmt.Fprint(w, "REPO\tID\tPARENT\tSTARTED\tDURATION\tSIZE\t\n")
+	fmt.Fprint(w, "REPO\tID\tPARENT\tSTARTED\tDURATION\tSIZE\tAUTHOR\tDESCRIPTION\t\n")
 }
 
 // PrintCommitInfo pretty-prints commit info.
@@ -78,12 +79,19 @@ func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo) {
 	)
 	if commitInfo.Finished != nil {
 		fmt.Fprintf(w, fmt.Sprintf("%s\t", pretty.TimeDifference(commitInfo.Started, commitInfo.Finished)))
-		fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(commitInfo.SizeBytes)))
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(commitInfo.SizeBytes)))
 	} else {
 		fmt.Fprintf(w, "-\t")
 		// Open commits don't have meaningful size information
-		fmt.Fprintf(w, "-\t\n")
+		fmt.Fprintf(w, "-\t")
+	}
+	if commitInfo.Author != "" {
+		fmt.Fprintf(w, "%s\t", commitInfo.Author)
+	} else {
+		fmt.Fprint(w, "-\t")
 	}
+	// Only the first line of the description fits in a table
+	fmt.Fprintf(w, "%s\t\n", strings.SplitN(commitInfo.Description, "\n", 2)[0])
 }
 
 // PrintDetailedCommitInfo pretty-prints detailed commit info.
@@ -93,7 +101,9 @@ func PrintDetailedCommitInfo(commitInfo *pfs.CommitInfo) error {
 Parent: {{.ParentCommit.ID}} {{end}}
 Started: {{prettyAgo .Started}}{{if .Finished}}
 Finished: {{prettyAgo .Finished}} {{end}}
-Size: {{prettySize .SizeBytes}}{{if .Provenance}}
+Size: {{prettySize .SizeBytes}}{{if .Author}}
+Author: {{.Author}}{{end}}{{if .Description}}
+Description: {{.Description}}{{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}/{{.ID}} {{end}} {{end}}
 `)
 	if err != nil {
@@ -124,6 +134,52 @@ func PrintFileInfo(w io.Writer, fileInfo *pfs.FileInfo) {
 	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(fileInfo.SizeBytes)))
 }
 
+// PrintFileHistoryHeader prints a file history header.
+func PrintFileHistoryHeader(w io.Writer) {
+	fmt.Fprint(w, "COMMIT\tSIZE\tHASH\t\n")
+}
+
+// PrintFileHistory pretty-prints file info as an entry in a file's history.
+func PrintFileHistory(w io.Writer, fileInfo *pfs.FileInfo) {
+	fmt.Fprintf(w, "%s\t", fileInfo.File.Commit.ID)
+	if fileInfo.Deleted {
+		fmt.Fprint(w, "-\tdeleted\t\n")
+		return
+	}
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(fileInfo.SizeBytes)))
+	fmt.Fprintf(w, "%x\t\n", fileInfo.Hash)
+}
+
+// PrintQuotaHeader prints a quota info header.
+func PrintQuotaHeader(w io.Writer) {
+	fmt.Fprint(w, "TYPE\tNAME\tSIZE\tSIZE QUOTA\tFILES\tFILE QUOTA\t\n")
+}
+
+// PrintQuotaInfo pretty-prints the usage and quota of a repo or user.
+func PrintQuotaInfo(w io.Writer, quotaInfo *pfs.QuotaInfo) {
+	if quotaInfo.Repo != nil {
+		fmt.Fprintf(w, "repo\t%s\t", quotaInfo.Repo.Name)
+	} else {
+		fmt.Fprintf(w, "user\t%s\t", quotaInfo.User)
+	}
+	quota := quotaInfo.Quota
+	if quota == nil {
+		quota = &pfs.Quota{}
+	}
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(quotaInfo.Usage.SizeBytes)))
+	if quota.SizeBytes != 0 {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(quota.SizeBytes)))
+	} else {
+		fmt.Fprint(w, "-\t")
+	}
+	fmt.Fprintf(w, "%d\t", quotaInfo.Usage.FileCount)
+	if quota.FileCount != 0 {
+		fmt.Fprintf(w, "%d\t\n", quota.FileCount)
+	} else {
+		fmt.Fprint(w, "-\t\n")
+	}
+}
+
 // PrintDetailedFileInfo pretty-prints detailed file info.
 func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 	template, err := template.New("FileInfo").Funcs(funcMap).Parse(
@@ -141,6 +197,57 @@ Children: {{range .Children}} {{.}} {{end}}
 	return nil
 }
 
+// PrintDetailedCompactionInfo pretty-prints detailed compaction info.
+func PrintDetailedCompactionInfo(compactionInfo *pfs.CompactionInfo) error {
+	template, err := template.New("CompactionInfo").Funcs(funcMap).Parse(
+		`State: {{if .Running}}running{{else if .Error}}failed{{else if .Finished}}finished{{else}}never run{{end}}{{if .Started}}
+Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}
+Finished: {{prettyAgo .Finished}}{{end}}
+Objects compacted: {{.ObjectsCompacted}}
+Tags compacted: {{.TagsCompacted}}
+Indexes merged: {{.IndexesMerged}}
+Bytes rewritten: {{prettySize .BytesRewritten}}{{if .Error}}
+Error: {{.Error}}{{end}}
+`)
+	if err != nil {
+		return err
+	}
+	if err := template.Execute(os.Stdout, compactionInfo); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PrintFileContentDiff pretty-prints the changes to a file's contents.
+func PrintFileContentDiff(w io.Writer, contentDiff *pfs.FileContentDiff) {
+	if contentDiff.UnifiedDiff != "" {
+		fmt.Fprint(w, contentDiff.UnifiedDiff)
+		return
+	}
+	oldName, newName := contentDiff.OldPath, contentDiff.NewPath
+	if oldName == "" {
+		oldName = "/dev/null"
+	}
+	if newName == "" {
+		newName = "/dev/null"
+	}
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", oldName, newName)
+	for _, keyDiff := range contentDiff.JsonDiffs {
+		key := keyDiff.Key
+		if key == "" {
+			key = "<root>"
+		}
+		switch {
+		case keyDiff.OldValue == "":
+			fmt.Fprintf(w, "+%s: %s\n", key, keyDiff.NewValue)
+		case keyDiff.NewValue == "":
+			fmt.Fprintf(w, "-%s: %s\n", key, keyDiff.OldValue)
+		default:
+			fmt.Fprintf(w, "~%s: %s -> %s\n", key, keyDiff.OldValue, keyDiff.NewValue)
+		}
+	}
+}
+
 type uint64Slice []uint64
 
 func (s uint64Slice) Len() int           { return len(s) }
@@ -0,0 +1,328 @@
+package replicate
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// copyConcurrency is the number of objects that are copied at once
+const copyConcurrency = 50
+
+// Replicate copies the commits on 'branch' of 'repo' in the source cluster to
+// the same repo and branch in the target cluster, as they are finished.
+// Commits keep their IDs, authors and provenance (which is replicated first,
+// if the target doesn't have it), and only the objects that the target is
+// missing are copied. Replicating commits whose authors aren't the caller
+// requires the caller to be an admin of the target, if it uses auth.
+//
+// Replicate starts from the head of the branch in the target, so it can be
+// restarted after either cluster restarts without copying anything twice. It
+// returns when the subscription to the source ends, e.g. because the source's
+// context was cancelled or the source restarted.
+func Replicate(source *client.APIClient, target *client.APIClient, repo string, branch string) error {
+	r := &replicator{
+		source:        source,
+		target:        target,
+		createdRepos:  make(map[string]bool),
+		copiedObjects: make(map[string]bool),
+	}
+	if err := r.replicateRepo(repo); err != nil {
+		return err
+	}
+	var from string
+	headInfo, err := target.InspectCommit(repo, branch)
+	if err != nil && !isNotFoundErr(err) {
+		return err
+	}
+	if err == nil {
+		from = headInfo.Commit.ID
+	}
+	commitIter, err := source.SubscribeCommit(repo, branch, from)
+	if err != nil {
+		return err
+	}
+	defer commitIter.Close()
+	for {
+		commitInfo, err := commitIter.Next()
+		if err != nil {
+			return err
+		}
+		if err := r.replicateCommit(commitInfo, branch); err != nil {
+			return fmt.Errorf("error replicating commit %s: %v", commitInfo.Commit.FullID(), err)
+		}
+	}
+}
+
+type replicator struct {
+	source *client.APIClient
+	target *client.APIClient
+	// createdRepos and copiedObjects record the repos and objects that are
+	// known to exist in the target, to avoid checking them again.
+	// copiedObjects is guarded by mu, since objects are copied concurrently.
+	createdRepos  map[string]bool
+	mu            sync.Mutex
+	copiedObjects map[string]bool
+}
+
+// replicateRepo creates 'repo' in the target, along with its provenance, if
+// it doesn't exist yet
+func (r *replicator) replicateRepo(repo string) error {
+	if r.createdRepos[repo] {
+		return nil
+	}
+	_, err := r.target.InspectRepo(repo)
+	if err != nil && !isNotFoundErr(err) {
+		return err
+	}
+	if err != nil {
+		repoInfo, err := r.source.InspectRepo(repo)
+		if err != nil {
+			return err
+		}
+		for _, prov := range repoInfo.Provenance {
+			if err := r.replicateRepo(prov.Name); err != nil {
+				return err
+			}
+		}
+		if _, err := r.target.PfsAPIClient.CreateRepo(
+			r.target.Ctx(),
+			&pfs.CreateRepoRequest{
+				Repo:        repoInfo.Repo,
+				Provenance:  repoInfo.Provenance,
+				Description: repoInfo.Description,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	r.createdRepos[repo] = true
+	return nil
+}
+
+// replicateCommit creates the commit described by 'commitInfo' in the
+// target, after replicating its parent and provenance if needed. If 'branch'
+// is set, the commit becomes the head of the branch in the target.
+func (r *replicator) replicateCommit(commitInfo *pfs.CommitInfo, branch string) error {
+	commit := commitInfo.Commit
+	if _, err := r.target.InspectCommit(commit.Repo.Name, commit.ID); err == nil {
+		// The commit has already been replicated (e.g. as the provenance of
+		// another commit), but may not be on the branch yet
+		if branch == "" {
+			return nil
+		}
+		headInfo, err := r.target.InspectCommit(commit.Repo.Name, branch)
+		if err == nil && headInfo.Commit.ID == commit.ID {
+			return nil
+		}
+		return r.target.SetBranch(commit.Repo.Name, commit.ID, branch)
+	} else if !isNotFoundErr(err) {
+		return err
+	}
+
+	parent := &pfs.Commit{Repo: commit.Repo}
+	if commitInfo.ParentCommit != nil {
+		if err := r.replicateCommitByID(commitInfo.ParentCommit); err != nil {
+			return err
+		}
+		parent.ID = commitInfo.ParentCommit.ID
+	}
+	for _, prov := range commitInfo.Provenance {
+		if err := r.replicateRepo(prov.Repo.Name); err != nil {
+			return err
+		}
+		if err := r.replicateCommitByID(prov); err != nil {
+			return err
+		}
+	}
+	tree, err := r.replicateTree(commitInfo.Tree)
+	if err != nil {
+		return err
+	}
+	_, err = r.target.PfsAPIClient.BuildCommit(
+		r.target.Ctx(),
+		&pfs.BuildCommitRequest{
+			ID:          commit.ID,
+			Parent:      parent,
+			Branch:      branch,
+			Provenance:  commitInfo.Provenance,
+			Tree:        tree,
+			Description: commitInfo.Description,
+			Author:      commitInfo.Author,
+		},
+	)
+	return err
+}
+
+// replicateCommitByID replicates 'commit' if the target doesn't have it
+func (r *replicator) replicateCommitByID(commit *pfs.Commit) error {
+	if _, err := r.target.InspectCommit(commit.Repo.Name, commit.ID); err == nil {
+		return nil
+	} else if !isNotFoundErr(err) {
+		return err
+	}
+	commitInfo, err := r.source.InspectCommit(commit.Repo.Name, commit.ID)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished == nil {
+		return fmt.Errorf("commit %s has not been finished", commit.FullID())
+	}
+	return r.replicateCommit(commitInfo, "")
+}
+
+// replicateTree copies the hashtree 'tree', its shards if it's sharded, and
+// the objects of the files in it, to the target. The tree is copied last, so
+// if it exists in the target so do its shards and files.
+func (r *replicator) replicateTree(tree *pfs.Object) (*pfs.Object, error) {
+	if tree == nil {
+		// Commits with no files may not have a tree, but BuildCommit needs one
+		emptyTree, err := hashtree.NewHashTree().Finish()
+		if err != nil {
+			return nil, err
+		}
+		data, err := hashtree.Serialize(emptyTree)
+		if err != nil {
+			return nil, err
+		}
+		object, _, err := r.target.PutObject(bytes.NewReader(data))
+		return object, err
+	}
+	exists, err := r.objectExists(tree)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return tree, nil
+	}
+	var buf bytes.Buffer
+	if err := r.source.GetObject(tree.Hash, &buf); err != nil {
+		return nil, err
+	}
+	getShard := func(object *pfs.Object) ([]byte, error) {
+		var buf bytes.Buffer
+		if err := r.source.GetObject(object.Hash, &buf); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	h, err := hashtree.DeserializeSharded(buf.Bytes(), getShard)
+	if err != nil {
+		return nil, err
+	}
+	// The files' objects are copied before the shards, so that a shard in
+	// the target only refers to objects that are there
+	var objects []*pfs.Object
+	seen := make(map[string]bool)
+	if err := h.Walk(func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode == nil {
+			return nil
+		}
+		for _, object := range node.FileNode.Objects {
+			if !seen[object.Hash] {
+				seen[object.Hash] = true
+				objects = append(objects, object)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := r.copyObjects(objects); err != nil {
+		return nil, err
+	}
+	shards, err := hashtree.Shards(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.copyObjects(shards); err != nil {
+		return nil, err
+	}
+	return tree, r.copyObject(tree)
+}
+
+// copyObjects copies 'objects' from the source to the target, up to
+// copyConcurrency at a time
+func (r *replicator) copyObjects(objects []*pfs.Object) error {
+	limiter := limit.New(copyConcurrency)
+	var eg errgroup.Group
+	for _, object := range objects {
+		object := object
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			return r.copyObject(object)
+		})
+	}
+	return eg.Wait()
+}
+
+// copyObject copies 'object' from the source to the target, unless the
+// target already has it
+func (r *replicator) copyObject(object *pfs.Object) error {
+	exists, err := r.objectExists(object)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(r.source.GetObject(object.Hash, pw))
+	}()
+	// Closing the reader unblocks GetObject if PutObject fails
+	defer pr.Close()
+	copied, _, err := r.target.PutObject(pr)
+	if err != nil {
+		return err
+	}
+	if copied.Hash != object.Hash {
+		return fmt.Errorf("copied object %s has hash %s in the target", object.Hash, copied.Hash)
+	}
+	r.setCopied(object)
+	return nil
+}
+
+// objectExists returns whether the target has 'object'. CheckObject also
+// finds objects that the target has compacted into an index.
+func (r *replicator) objectExists(object *pfs.Object) (bool, error) {
+	r.mu.Lock()
+	copied := r.copiedObjects[object.Hash]
+	r.mu.Unlock()
+	if copied {
+		return true, nil
+	}
+	resp, err := r.target.ObjectAPIClient.CheckObject(
+		r.target.Ctx(),
+		&pfs.CheckObjectRequest{
+			Object: object,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	if resp.Exists {
+		r.setCopied(object)
+	}
+	return resp.Exists, nil
+}
+
+func (r *replicator) setCopied(object *pfs.Object) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.copiedObjects[object.Hash] = true
+}
+
+func isNotFoundErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
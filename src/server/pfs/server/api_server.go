@@ -15,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 
@@ -44,8 +45,8 @@ func newLocalAPIServer(address string, etcdPrefix string) (*apiServer, error) {
 	}, nil
 }
 
-func newAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (*apiServer, error) {
-	d, err := newDriver(address, etcdAddresses, etcdPrefix, cacheSize)
+func newAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64, diskCacheDir string, diskCacheBytes int64) (*apiServer, error) {
+	d, err := newDriver(address, etcdAddresses, etcdPrefix, cacheSize, diskCacheDir, diskCacheBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +56,22 @@ func newAPIServer(address string, etcdAddresses []string, etcdPrefix string, cac
 	}, nil
 }
 
+func newStoreAPIServer(address string, store kv.Store, etcdPrefix string, cacheSize int64, diskCacheDir string, diskCacheBytes int64) (*apiServer, error) {
+	d, err := newStoreDriver(address, store, etcdPrefix, cacheSize, diskCacheDir, diskCacheBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &apiServer{
+		Logger: log.NewLogger("pfs.API"),
+		driver: d,
+	}, nil
+}
+
+// Close implements the APIServer interface.
+func (a *apiServer) Close() error {
+	return a.driver.close()
+}
+
 func (a *apiServer) CreateRepo(ctx context.Context, request *pfs.CreateRepoRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -76,7 +93,7 @@ func (a *apiServer) ListRepo(ctx context.Context, request *pfs.ListRepoRequest)
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	repoInfos, err := a.driver.listRepo(ctx, request.Provenance, request.IncludeAuth)
+	repoInfos, err := a.driver.listRepo(ctx, request.Provenance, request.IncludeAuth, request.PageSize, request.PageToken)
 	return repoInfos, err
 }
 
@@ -97,11 +114,42 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) ForkRepo(ctx context.Context, request *pfs.ForkRepoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.forkRepo(ctx, request.Src, request.Dst, request.Description); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) SetQuota(ctx context.Context, request *pfs.SetQuotaRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.setQuota(ctx, request.Repo, request.User, request.Quota); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) ListQuota(ctx context.Context, request *pfs.ListQuotaRequest) (response *pfs.QuotaInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	quotaInfos, err := a.driver.listQuota(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.QuotaInfos{QuotaInfo: quotaInfos}, nil
+}
+
 func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitRequest) (response *pfs.Commit, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commit, err := a.driver.startCommit(ctx, request.Parent, request.Branch, request.Provenance)
+	commit, err := a.driver.startCommit(ctx, request.Parent, request.Branch, request.Provenance, request.Description)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +160,7 @@ func (a *apiServer) BuildCommit(ctx context.Context, request *pfs.BuildCommitReq
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commit, err := a.driver.buildCommit(ctx, request.Parent, request.Branch, request.Provenance, request.Tree)
+	commit, err := a.driver.buildCommit(ctx, request.ID, request.Parent, request.Branch, request.Provenance, request.Tree, request.Description, request.Author)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +171,7 @@ func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitR
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	if err := a.driver.finishCommit(ctx, request.Commit); err != nil {
+	if err := a.driver.finishCommit(ctx, request.Commit, request.Description); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -140,12 +188,13 @@ func (a *apiServer) ListCommit(ctx context.Context, request *pfs.ListCommitReque
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commitInfos, err := a.driver.listCommit(ctx, request.Repo, request.To, request.From, request.Number)
+	commitInfos, nextPageToken, err := a.driver.listCommit(ctx, request.Repo, request.To, request.From, request.Number, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	return &pfs.CommitInfos{
-		CommitInfo: commitInfos,
+		CommitInfo:    commitInfos,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -281,6 +330,12 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 	// ./foo which won't display correctly when the filesystem is mounted
 	request.File.Path = path.Clean(request.File.Path)
 	var r io.Reader
+	if request.SymlinkTarget != "" {
+		if request.Url != "" || len(request.Value) > 0 {
+			return fmt.Errorf("cannot put data into a symlink")
+		}
+		return a.driver.putFile(ctx, request.File, request.Delimiter, 0, 0, request.Overwrite, request.Mode, request.Mtime, request.SymlinkTarget, nil)
+	}
 	if request.Url != "" {
 		url, err := url.Parse(request.Url)
 		if err != nil {
@@ -323,7 +378,7 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 		}
 		r = &reader
 	}
-	if err := a.driver.putFile(ctx, request.File, request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.Overwrite, r); err != nil {
+	if err := a.driver.putFile(ctx, request.File, request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.Overwrite, request.Mode, request.Mtime, "", r); err != nil {
 		return err
 	}
 	return nil
@@ -334,12 +389,26 @@ func (a *apiServer) putFilePfs(ctx context.Context, request *pfs.PutFileRequest,
 	if err != nil {
 		return err
 	}
-	put := func(outPath string, inRepo string, inCommit string, inFile string) (retErr error) {
-		r, err := pClient.GetFileReader(inRepo, inCommit, inFile, 0, 0)
+	// put copies the file described by 'fileInfo' to 'outPath', keeping its
+	// attributes unless the request overrides them
+	put := func(outPath string, fileInfo *pfs.FileInfo) (retErr error) {
+		mode, mtime := fileInfo.Mode, fileInfo.Mtime
+		if request.Mode != 0 {
+			mode = request.Mode
+		}
+		if request.Mtime != nil {
+			mtime = request.Mtime
+		}
+		outFile := client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, outPath)
+		if fileInfo.FileType == pfs.FileType_SYMLINK {
+			return a.driver.putFile(ctx, outFile, pfs.Delimiter_NONE, 0, 0, request.Overwrite, mode, mtime, fileInfo.SymlinkTarget, nil)
+		}
+		inFile := fileInfo.File
+		r, err := pClient.GetFileReader(inFile.Commit.Repo.Name, inFile.Commit.ID, inFile.Path, 0, 0)
 		if err != nil {
 			return err
 		}
-		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, outPath), request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.Overwrite, r)
+		return a.driver.putFile(ctx, outFile, request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.Overwrite, mode, mtime, "", r)
 	}
 	splitPath := strings.Split(strings.TrimPrefix(url.Path, "/"), "/")
 	if len(splitPath) < 2 {
@@ -354,11 +423,11 @@ func (a *apiServer) putFilePfs(ctx context.Context, request *pfs.PutFileRequest,
 	if request.Recursive {
 		var eg errgroup.Group
 		if err := pClient.Walk(splitPath[0], commit, file, func(fileInfo *pfs.FileInfo) error {
-			if fileInfo.FileType != pfs.FileType_FILE {
+			if fileInfo.FileType == pfs.FileType_DIR {
 				return nil
 			}
 			eg.Go(func() error {
-				return put(filepath.Join(request.File.Path, strings.TrimPrefix(fileInfo.File.Path, file)), repo, commit, fileInfo.File.Path)
+				return put(filepath.Join(request.File.Path, strings.TrimPrefix(fileInfo.File.Path, file)), fileInfo)
 			})
 			return nil
 		}); err != nil {
@@ -366,7 +435,11 @@ func (a *apiServer) putFilePfs(ctx context.Context, request *pfs.PutFileRequest,
 		}
 		return eg.Wait()
 	}
-	return put(request.File.Path, repo, commit, file)
+	fileInfo, err := pClient.InspectFile(repo, commit, file)
+	if err != nil {
+		return err
+	}
+	return put(request.File.Path, fileInfo)
 }
 
 func (a *apiServer) putFileObj(ctx context.Context, objClient obj.Client, request *pfs.PutFileRequest, object string) (retErr error) {
@@ -393,7 +466,7 @@ func (a *apiServer) putFileObj(ctx context.Context, objClient obj.Client, reques
 			}
 		}()
 		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, filePath),
-			request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.Overwrite, r)
+			request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.Overwrite, request.Mode, request.Mtime, "", r)
 	}
 	if request.Recursive {
 		eg, egContext := errgroup.WithContext(ctx)
@@ -483,6 +556,35 @@ func (a *apiServer) GlobFile(ctx context.Context, request *pfs.GlobFileRequest)
 	}, nil
 }
 
+func (a *apiServer) GrepFile(request *pfs.GrepFileRequest, grepFileServer pfs.API_GrepFileServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.grepFile(grepFileServer.Context(), request.Commit, request.Pattern, request.Regex, request.MaxMatches, func(response *pfs.GrepFileResponse) error {
+		return grepFileServer.Send(response)
+	})
+}
+
+func (a *apiServer) ListFileHistory(ctx context.Context, request *pfs.ListFileHistoryRequest) (response *pfs.FileInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) {
+		if response != nil && len(response.FileInfo) > client.MaxListItemsLog {
+			logrus.Infof("Response contains %d objects; logging the first %d", len(response.FileInfo), client.MaxListItemsLog)
+			a.Log(request, &pfs.FileInfos{FileInfo: response.FileInfo[:client.MaxListItemsLog]}, retErr, time.Since(start))
+		} else {
+			a.Log(request, response, retErr, time.Since(start))
+		}
+	}(time.Now())
+
+	fileInfos, err := a.driver.listFileHistory(ctx, request.File, request.N)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.FileInfos{
+		FileInfo: fileInfos,
+	}, nil
+}
+
 func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest) (response *pfs.DiffFileResponse, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) {
@@ -492,6 +594,12 @@ func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest)
 				NewFiles: truncateFiles(response.NewFiles),
 				OldFiles: truncateFiles(response.OldFiles),
 			}, retErr, time.Since(start))
+		} else if response != nil && len(response.ContentDiffs) > 0 {
+			// Content diffs may be large, so they aren't logged
+			a.Log(request, &pfs.DiffFileResponse{
+				NewFiles: response.NewFiles,
+				OldFiles: response.OldFiles,
+			}, retErr, time.Since(start))
 		} else {
 			a.Log(request, response, retErr, time.Since(start))
 		}
@@ -500,9 +608,22 @@ func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest)
 	if err != nil {
 		return nil, err
 	}
+	var contentDiffs []*pfs.FileContentDiff
+	if request.Content {
+		// If OldFile is unset, the same path in the parent commit was used
+		oldRoot := request.NewFile.Path
+		if request.OldFile != nil {
+			oldRoot = request.OldFile.Path
+		}
+		contentDiffs, err = a.driver.diffFileContent(ctx, request.NewFile.Path, oldRoot, newFileInfos, oldFileInfos, request.ContentSizeLimit)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &pfs.DiffFileResponse{
-		NewFiles: newFileInfos,
-		OldFiles: oldFileInfos,
+		NewFiles:     newFileInfos,
+		OldFiles:     oldFileInfos,
+		ContentDiffs: contentDiffs,
 	}, nil
 }
 
@@ -527,6 +648,15 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.fsck(fsckServer.Context(), request.Fix, func(response *pfs.FsckResponse) error {
+		return fsckServer.Send(response)
+	})
+}
+
 type putFileReader struct {
 	server pfs.API_PutFileServer
 	buffer bytes.Buffer
@@ -0,0 +1,608 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/types"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
+)
+
+const (
+	// compactionBatchSize is the number of objects (or tags) that are
+	// compacted at a time. Progress is checkpointed after each batch.
+	compactionBatchSize = 1000
+	// compactionConcurrency is the number of objects that are compacted in
+	// parallel.
+	compactionConcurrency = 100
+	// compactionCheckInterval is how often the background scheduler checks
+	// whether compaction is due.
+	compactionCheckInterval = time.Minute
+	// indexLockKey is the key of the lock that's held while indexes are
+	// rewritten, by compaction and by the deletion of compacted objects and
+	// tags.
+	indexLockKey = "pachyderm_pfs_index_lock"
+	// blockRefsPrefix is the prefix of the keys that count the objects in
+	// each block that compaction wrote, so that the block is deleted once
+	// they've all been deleted. The keys also hold the blocks' sizes, as
+	// "<objects>/<bytes>".
+	blockRefsPrefix = "pachyderm_pfs_block_refs/"
+)
+
+// errCompactionBatchFull is used to stop walks once a batch is full.
+var errCompactionBatchFull = errors.New("compaction batch is full")
+
+// CompactionOptions configures the compaction of objects that a
+// BlockAPIServer does in the background.
+type CompactionOptions struct {
+	// Interval is the time between compactions. If it's 0, compactions aren't
+	// scheduled.
+	Interval time.Duration
+	// Threshold is the number of uncompacted objects and tags that triggers a
+	// compaction. If it's 0, compactions aren't triggered.
+	Threshold int64
+	// RequestsPerSecond limits the rate of object store requests that
+	// compactions make. If it's 0, the rate isn't limited.
+	RequestsPerSecond float64
+}
+
+// enabled returns true if compactions are run in the background.
+func (o CompactionOptions) enabled() bool {
+	return o.Interval > 0 || o.Threshold > 0
+}
+
+// throttle limits the rate of requests. A nil throttle doesn't limit it.
+type throttle struct {
+	ticker *time.Ticker
+}
+
+func newThrottle(requestsPerSecond float64) *throttle {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &throttle{
+		ticker: time.NewTicker(time.Duration(float64(time.Second) / requestsPerSecond)),
+	}
+}
+
+// wait blocks until another request can be made.
+func (t *throttle) wait() {
+	if t != nil {
+		<-t.ticker.C
+	}
+}
+
+func (t *throttle) stop() {
+	if t != nil {
+		t.ticker.Stop()
+	}
+}
+
+// scheduleCompaction runs compactions in the background whenever they're
+// due, until the server is closed.
+func (s *objBlockAPIServer) scheduleCompaction() {
+	ticker := time.NewTicker(compactionCheckInterval)
+	defer ticker.Stop()
+	for {
+		due, err := s.compactionDue()
+		if err != nil {
+			logrus.Errorf("error checking whether compaction is due: %v", err)
+		} else if due {
+			if err := s.compact(context.Background()); err != nil {
+				logrus.Errorf("error compacting objects: %v", err)
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// compactionDue returns true if a compaction should be run now, either
+// because the last one was interrupted, the interval has passed since it
+// finished, or there are enough uncompacted objects.
+func (s *objBlockAPIServer) compactionDue() (bool, error) {
+	info, err := s.readCompactionInfo()
+	if err != nil {
+		return false, err
+	}
+	if info.Running {
+		return true, nil
+	}
+	if s.compactionOptions.Interval > 0 {
+		var finished time.Time
+		if info.Finished != nil {
+			finished, err = types.TimestampFromProto(info.Finished)
+			if err != nil {
+				return false, err
+			}
+		}
+		if time.Since(finished) >= s.compactionOptions.Interval {
+			return true, nil
+		}
+	}
+	if s.compactionOptions.Threshold > 0 {
+		objects, tags, err := s.uncompacted(int(s.compactionOptions.Threshold))
+		if err != nil {
+			return false, err
+		}
+		return int64(len(objects)+len(tags)) >= s.compactionOptions.Threshold, nil
+	}
+	return false, nil
+}
+
+// uncompacted returns the paths of up to 'n' objects and tags that haven't
+// been incorporated into an index yet.
+func (s *objBlockAPIServer) uncompacted(n int) ([]string, []string, error) {
+	var objects, tags []string
+	if err := s.objClient.Walk(s.localServer.objectDir(), func(name string) error {
+		if len(objects) >= n {
+			return errCompactionBatchFull
+		}
+		objects = append(objects, name)
+		return nil
+	}); err != nil && err != errCompactionBatchFull {
+		return nil, nil, err
+	}
+	if err := s.objClient.Walk(s.localServer.tagDir(), func(name string) error {
+		if len(objects)+len(tags) >= n {
+			return errCompactionBatchFull
+		}
+		tags = append(tags, name)
+		return nil
+	}); err != nil && err != errCompactionBatchFull {
+		return nil, nil, err
+	}
+	return objects, tags, nil
+}
+
+// compact incorporates the objects and tags that have been written since the
+// last compaction into the indexes. It works in batches, and checkpoints its
+// progress after each one so that a compaction that's interrupted resumes
+// where it left off.
+func (s *objBlockAPIServer) compact(ctx context.Context) (retErr error) {
+	s.compactionMu.Lock()
+	defer s.compactionMu.Unlock()
+	store, err := s.getStore()
+	if err != nil {
+		return err
+	}
+	// The lock is shared with garbage collection, which mustn't delete
+	// objects while they're being moved into the indexes
+	lock := dlock.NewStoreDLock(store, client.CompactionLockKey)
+	ctx, err = lock.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	info, err := s.readCompactionInfo()
+	if err != nil {
+		return err
+	}
+	if !info.Running {
+		started, err := types.TimestampProto(time.Now())
+		if err != nil {
+			return err
+		}
+		info = &pfsclient.CompactionInfo{
+			Running: true,
+			Started: started,
+		}
+		if err := s.writeCompactionInfo(info); err != nil {
+			return err
+		}
+	}
+	defer func() {
+		info.Running = false
+		if retErr != nil {
+			info.Error = retErr.Error()
+		}
+		var err error
+		info.Finished, err = types.TimestampProto(time.Now())
+		if err == nil {
+			err = s.writeCompactionInfo(info)
+		}
+		if err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	t := newThrottle(s.compactionOptions.RequestsPerSecond)
+	defer t.stop()
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		objects, tags, err := s.uncompacted(compactionBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(objects)+len(tags) == 0 {
+			return nil
+		}
+		if err := s.withIndexLock(ctx, func(ctx context.Context) error {
+			return s.compactBatch(ctx, store, objects, tags, t, info)
+		}); err != nil {
+			return err
+		}
+		if err := s.writeCompactionInfo(info); err != nil {
+			return err
+		}
+		if len(objects)+len(tags) < compactionBatchSize {
+			return nil
+		}
+	}
+}
+
+// compactBatch rewrites the objects in 'objects' into a single block, merges
+// them and the tags in 'tags' into the indexes, and then deletes them and the
+// blocks that held the objects. Each request to the object store waits for
+// 't'. Progress is recorded in 'info'. The caller must hold the index lock.
+func (s *objBlockAPIServer) compactBatch(ctx context.Context, store kv.Store, objects []string, tags []string, t *throttle, info *pfsclient.CompactionInfo) (retErr error) {
+	var mu sync.Mutex
+	objectIndex := &pfsclient.ObjectIndex{
+		Objects: make(map[string]*pfsclient.BlockRef),
+		Tags:    make(map[string]*pfsclient.Object),
+	}
+	// files are the object and tag files that have been merged into the
+	// indexes, and blocks are the blocks that the objects were in
+	var files, blocks []string
+	var bytesRewritten uint64
+	limiter := limit.New(compactionConcurrency)
+	block := &pfsclient.Block{Hash: uuid.NewWithoutDashes()}
+	if len(objects) > 0 {
+		w, err := s.newBlockWriter(block)
+		if err != nil {
+			return err
+		}
+		var eg errgroup.Group
+		for _, name := range objects {
+			name := name
+			limiter.Acquire()
+			eg.Go(func() (retErr error) {
+				defer limiter.Release()
+				blockRef := &pfsclient.BlockRef{}
+				t.wait()
+				if err := s.readProto(name, blockRef); err != nil {
+					// The object was deleted after the batch was listed
+					if s.isNotFoundErr(err) {
+						return nil
+					}
+					return err
+				}
+				blockPath := s.localServer.blockPath(blockRef.Block)
+				t.wait()
+				r, err := s.objClient.Reader(blockPath, blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := r.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				object, err := ioutil.ReadAll(r)
+				if err != nil {
+					return err
+				}
+				blockRef, err = w.Write(object)
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				objectIndex.Objects[filepath.Base(name)] = blockRef
+				files = append(files, name)
+				blocks = append(blocks, blockPath)
+				bytesRewritten += uint64(len(object))
+				return nil
+			})
+		}
+		err = eg.Wait()
+		// The block must be complete before the indexes refer to it
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		// The block is counted before the indexes refer to it, so that if
+		// the batch is interrupted, the block is never deleted too early
+		// (but may be leaked instead)
+		if len(objectIndex.Objects) == 0 {
+			blocks = append(blocks, s.localServer.blockPath(block))
+		} else if err := s.setBlockRefs(ctx, store, block.Hash, int64(len(objectIndex.Objects)), w.written); err != nil {
+			return err
+		}
+	}
+	var eg errgroup.Group
+	for _, name := range tags {
+		name := name
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			tagObjectIndex := &pfsclient.ObjectIndex{}
+			t.wait()
+			if err := s.readProto(name, tagObjectIndex); err != nil {
+				// The tag was deleted after the batch was listed
+				if s.isNotFoundErr(err) {
+					return nil
+				}
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for tag, object := range tagObjectIndex.Tags {
+				objectIndex.Tags[tag] = object
+			}
+			files = append(files, name)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	prefixes := make(map[string]bool)
+	for hash := range objectIndex.Objects {
+		prefixes[indexPrefix(hash)] = true
+	}
+	for tag := range objectIndex.Tags {
+		prefixes[indexPrefix(tag)] = true
+	}
+	// replaced counts the objects whose older copies, in blocks written by
+	// earlier compactions, are replaced in the indexes
+	replaced := make(map[string]int64)
+	eg = errgroup.Group{}
+	for prefix := range prefixes {
+		prefix := prefix
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			prefixObjectIndex := &pfsclient.ObjectIndex{
+				Objects: make(map[string]*pfsclient.BlockRef),
+				Tags:    make(map[string]*pfsclient.Object),
+			}
+			t.wait()
+			if err := s.readProto(s.localServer.indexPath(prefix), prefixObjectIndex); err != nil && !s.isNotFoundErr(err) {
+				return err
+			}
+			for hash, blockRef := range objectIndex.Objects {
+				if indexPrefix(hash) != prefix {
+					continue
+				}
+				if old, ok := prefixObjectIndex.Objects[hash]; ok && old.Block != nil && old.Block.Hash != blockRef.Block.Hash {
+					mu.Lock()
+					replaced[old.Block.Hash]++
+					mu.Unlock()
+				}
+				prefixObjectIndex.Objects[hash] = blockRef
+			}
+			for tag, object := range objectIndex.Tags {
+				if indexPrefix(tag) == prefix {
+					prefixObjectIndex.Tags[tag] = object
+				}
+			}
+			t.wait()
+			if err := s.writeProto(s.localServer.indexPath(prefix), prefixObjectIndex); err != nil {
+				return err
+			}
+			s.setObjectIndex(prefix, prefixObjectIndex, s.getGeneration())
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	if _, err := s.releaseBlockRefs(ctx, replaced); err != nil {
+		return err
+	}
+	// The objects and tags are only deleted once they're in the indexes, so
+	// if this batch is interrupted, the next compaction redoes it. The old
+	// blocks are deleted last, so that an object file never refers to a block
+	// that's gone (an interrupted batch leaves the blocks behind instead).
+	if err := s.deleteCompacted(files, limiter, t); err != nil {
+		return err
+	}
+	// Cached object infos may refer to the old blocks, so they're
+	// invalidated before the blocks are deleted
+	if err := s.incrementGeneration(ctx, store); err != nil {
+		return err
+	}
+	if err := s.deleteCompacted(blocks, limiter, t); err != nil {
+		return err
+	}
+	info.ObjectsCompacted += uint64(len(objects))
+	info.TagsCompacted += uint64(len(tags))
+	info.IndexesMerged += uint64(len(prefixes))
+	info.BytesRewritten += bytesRewritten
+	return nil
+}
+
+// deleteCompacted deletes 'paths', which have been compacted. Each request to
+// the object store waits for 't'.
+func (s *objBlockAPIServer) deleteCompacted(paths []string, limiter limit.ConcurrencyLimiter, t *throttle) error {
+	var eg errgroup.Group
+	for _, path := range paths {
+		path := path
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			t.wait()
+			if err := s.objClient.Delete(path); err != nil && !s.isNotFoundErr(err) {
+				return err
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// incrementGeneration increments the GC generation number in etcd, which
+// invalidates the caches of every block server, and updates this server's
+// generation without waiting for its watch.
+func (s *objBlockAPIServer) incrementGeneration(ctx context.Context, store kv.Store) error {
+	var newGen int
+	if _, err := col.NewStoreSTM(ctx, store, func(stm col.STM) error {
+		// The first generation is assumed to be 0, so a missing key is 0
+		newGen = 1
+		if oldGen := stm.Get(client.GCGenerationKey); oldGen != "" {
+			gen, err := strconv.Atoi(oldGen)
+			if err != nil {
+				return err
+			}
+			newGen = gen + 1
+		}
+		stm.Put(client.GCGenerationKey, strconv.Itoa(newGen), kv.NoLease)
+		return nil
+	}); err != nil {
+		return err
+	}
+	s.setGeneration(newGen)
+	return nil
+}
+
+// withIndexLock calls 'f' while holding the index lock, which keeps indexes
+// from being rewritten concurrently. 'f' is passed a context that's cancelled
+// if the lock is lost.
+func (s *objBlockAPIServer) withIndexLock(ctx context.Context, f func(ctx context.Context) error) (retErr error) {
+	store, err := s.getStore()
+	if err != nil {
+		return err
+	}
+	lock := dlock.NewStoreDLock(store, indexLockKey)
+	ctx, err = lock.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	return f(ctx)
+}
+
+// setBlockRefs records that 'n' of the objects in the indexes are in 'block',
+// which is 'size' bytes.
+func (s *objBlockAPIServer) setBlockRefs(ctx context.Context, store kv.Store, block string, n int64, size uint64) error {
+	_, err := store.Txn(ctx, &kv.Txn{
+		Then: []kv.Op{kv.Put(path.Join(blockRefsPrefix, block), fmt.Sprintf("%d/%d", n, size), kv.NoLease)},
+	})
+	return err
+}
+
+// releaseBlockRefs records that the numbers of objects in 'removed' have been
+// removed from the indexes in each block, and deletes the blocks that no
+// longer have any objects in them. It returns the number of bytes that were
+// deleted. Blocks that aren't counted are never deleted.
+func (s *objBlockAPIServer) releaseBlockRefs(ctx context.Context, removed map[string]int64) (uint64, error) {
+	if len(removed) == 0 {
+		return 0, nil
+	}
+	store, err := s.getStore()
+	if err != nil {
+		return 0, err
+	}
+	var freed uint64
+	limiter := limit.New(compactionConcurrency)
+	var eg errgroup.Group
+	for block, n := range removed {
+		block, n := block, n
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			key := path.Join(blockRefsPrefix, block)
+			var unreferenced bool
+			var size uint64
+			if _, err := col.NewStoreSTM(ctx, store, func(stm col.STM) error {
+				unreferenced = false
+				refs := stm.Get(key)
+				if refs == "" {
+					return nil
+				}
+				var count int64
+				if _, err := fmt.Sscanf(refs, "%d/%d", &count, &size); err != nil {
+					return fmt.Errorf("invalid reference count %q for block %s: %v", refs, block, err)
+				}
+				if count -= n; count > 0 {
+					stm.Put(key, fmt.Sprintf("%d/%d", count, size), kv.NoLease)
+					return nil
+				}
+				stm.Del(key)
+				unreferenced = true
+				return nil
+			}); err != nil {
+				return err
+			}
+			if !unreferenced {
+				return nil
+			}
+			if err := s.objClient.Delete(s.localServer.blockPath(&pfsclient.Block{Hash: block})); err != nil && !s.isNotFoundErr(err) {
+				return err
+			}
+			atomic.AddUint64(&freed, size)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return 0, err
+	}
+	return freed, nil
+}
+
+// readCompactionInfo reads the checkpoint of the current (or last)
+// compaction. If there's never been a compaction, it returns an empty
+// CompactionInfo.
+func (s *objBlockAPIServer) readCompactionInfo() (*pfsclient.CompactionInfo, error) {
+	info := &pfsclient.CompactionInfo{}
+	if err := s.readProto(s.localServer.compactionPath(), info); err != nil && !s.isNotFoundErr(err) {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (s *objBlockAPIServer) writeCompactionInfo(info *pfsclient.CompactionInfo) error {
+	return s.writeProto(s.localServer.compactionPath(), info)
+}
+
+// getStore returns the server's store, connecting to etcd the first time it's
+// called if the server wasn't given a store.
+func (s *objBlockAPIServer) getStore() (kv.Store, error) {
+	s.storeLock.Lock()
+	defer s.storeLock.Unlock()
+	if s.store == nil {
+		etcdClient, err := etcd.New(etcd.Config{
+			Endpoints:   []string{s.etcdAddress},
+			DialOptions: client.EtcdDialOptions(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating etcd client: %v", err)
+		}
+		s.store = kv.NewEtcdStore(etcdClient)
+	}
+	return s.store, nil
+}
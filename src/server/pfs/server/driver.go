@@ -5,32 +5,41 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diskcache"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/types"
 	"github.com/hashicorp/golang-lru"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
 
@@ -97,9 +106,15 @@ type driver struct {
 	// store API and auth API
 	pachClient *client.APIClient
 
-	// etcdClient and prefix write repo and other metadata to etcd
+	// store and prefix write repo and other metadata to etcd (or to another
+	// kv.Store)
+	store  kv.Store
+	prefix string
+	// etcdClient is the client behind 'store' if the driver connected to etcd
+	// itself, in which case close() closes it
 	etcdClient *etcd.Client
-	prefix     string
+	// done is closed by close(), to stop the driver's goroutines
+	done chan struct{}
 
 	// collections
 	repos         col.Collection
@@ -107,9 +122,18 @@ type driver struct {
 	commits       collectionFactory
 	branches      collectionFactory
 	openCommits   col.Collection
+	repoQuotas    col.Collection
+	userQuotas    col.Collection
+	userUsage     col.Collection
 
 	// a cache for hashtrees
 	treeCache *lru.Cache
+	// a cache on local disk for serialized hashtrees and their shards, which
+	// survives restarts. It's nil if no cache directory was given.
+	diskCache *diskcache.Cache
+	// a cache for the nodes compared by listFileHistory, keyed by commit ID
+	// and path
+	nodeCache *lru.Cache
 }
 
 const (
@@ -118,10 +142,16 @@ const (
 
 const (
 	defaultTreeCacheSize = 128
+	defaultNodeCacheSize = 4096
+
+	// defaultDiffContentSizeLimit is the size of the largest file whose
+	// content is diffed by diffFileContent, unless the caller sets a limit
+	defaultDiffContentSizeLimit = 1024 * 1024
 )
 
-// newDriver is used to create a new Driver instance
-func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCacheSize int64) (*driver, error) {
+// newDriver is used to create a new Driver instance. If 'diskCacheDir' is set,
+// serialized hashtrees are also cached there, up to 'diskCacheBytes' bytes.
+func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCacheSize int64, diskCacheDir string, diskCacheBytes int64) (*driver, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   etcdAddresses,
 		DialOptions: client.EtcdDialOptions(),
@@ -129,6 +159,19 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCa
 	if err != nil {
 		return nil, fmt.Errorf("could not connect to etcd: %s", err.Error())
 	}
+	d, err := newStoreDriver(address, kv.NewEtcdStore(etcdClient), etcdPrefix, treeCacheSize, diskCacheDir, diskCacheBytes)
+	if err != nil {
+		etcdClient.Close()
+		return nil, err
+	}
+	d.etcdClient = etcdClient
+	return d, nil
+}
+
+// newStoreDriver is like newDriver, except that the driver keeps its metadata
+// in 'store'.
+func newStoreDriver(address string, store kv.Store, etcdPrefix string, treeCacheSize int64, diskCacheDir string, diskCacheBytes int64) (*driver, error) {
+	var err error
 	if treeCacheSize <= 0 {
 		treeCacheSize = defaultTreeCacheSize
 	}
@@ -136,30 +179,78 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCa
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize treeCache: %s", err.Error())
 	}
+	nodeCache, err := lru.New(defaultNodeCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize nodeCache: %s", err.Error())
+	}
+	done := make(chan struct{})
+	var diskCache *diskcache.Cache
+	if diskCacheDir != "" {
+		diskCache, err = diskcache.NewCache(diskCacheDir, diskCacheBytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not initialize diskCache: %s", err.Error())
+		}
+		// Periodically print cache stats for debugging purposes
+		go func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+				case <-done:
+					return
+				}
+				logrus.Infof("diskCache stats: %+v", diskCache.Stats())
+			}
+		}()
+	}
 
 	d := &driver{
 		address:       address,
-		etcdClient:    etcdClient,
+		store:         store,
 		prefix:        etcdPrefix,
-		repos:         pfsdb.Repos(etcdClient, etcdPrefix),
-		repoRefCounts: pfsdb.RepoRefCounts(etcdClient, etcdPrefix),
+		repos:         pfsdb.Repos(store, etcdPrefix),
+		repoRefCounts: pfsdb.RepoRefCounts(store, etcdPrefix),
 		commits: func(repo string) col.Collection {
-			return pfsdb.Commits(etcdClient, etcdPrefix, repo)
+			return pfsdb.Commits(store, etcdPrefix, repo)
 		},
 		branches: func(repo string) col.Collection {
-			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
+			return pfsdb.Branches(store, etcdPrefix, repo)
 		},
-		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
+		openCommits: pfsdb.OpenCommits(store, etcdPrefix),
+		repoQuotas:  pfsdb.RepoQuotas(store, etcdPrefix),
+		userQuotas:  pfsdb.UserQuotas(store, etcdPrefix),
+		userUsage:   pfsdb.UserUsage(store, etcdPrefix),
 		treeCache:   treeCache,
+		nodeCache:   nodeCache,
+		diskCache:   diskCache,
+		done:        done,
 	}
 	go func() { d.initializePachConn() }() // Begin dialing connection on startup
 	return d, nil
 }
 
+// close stops the driver's goroutines and closes its connection to pachd, and
+// its etcd client if it created one. The driver can't be used afterwards.
+func (d *driver) close() error {
+	close(d.done)
+	// Wait for initializePachConn to finish dialing
+	var err error
+	if d.initializePachConn() == nil {
+		err = d.pachConn.Close()
+	}
+	if d.etcdClient != nil {
+		if etcdErr := d.etcdClient.Close(); err == nil {
+			err = etcdErr
+		}
+	}
+	return err
+}
+
 // newLocalDriver creates a driver using an local etcd instance.  This
 // function is intended for testing purposes
 func newLocalDriver(blockAddress string, etcdPrefix string) (*driver, error) {
-	return newDriver(blockAddress, []string{"localhost:32379"}, etcdPrefix, defaultTreeCacheSize)
+	return newDriver(blockAddress, []string{"localhost:32379"}, etcdPrefix, defaultTreeCacheSize, "", 0)
 }
 
 // initializePachConn initializes the connects that the pfs driver has with the
@@ -196,6 +287,29 @@ func (d *driver) checkIsAuthorized(ctx context.Context, r *pfs.Repo, s auth.Scop
 	return nil
 }
 
+// checkIsAdmin returns an error if the auth system is activated and the
+// current user (in 'ctx') is not a cluster admin
+func (d *driver) checkIsAdmin(ctx context.Context) error {
+	d.initializePachConn()
+	whoAmI, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsNotActivatedError(err) {
+			return nil
+		}
+		return fmt.Errorf("error authenticating: %s", err.Error())
+	}
+	resp, err := d.pachClient.AuthAPIClient.GetAdmins(auth.In2Out(ctx), &auth.GetAdminsRequest{})
+	if err != nil {
+		return fmt.Errorf("error looking up cluster admins: %s", err.Error())
+	}
+	for _, admin := range resp.Admins {
+		if admin == whoAmI.Username {
+			return nil
+		}
+	}
+	return &auth.NotAuthorizedError{}
+}
+
 func now() *types.Timestamp {
 	t, err := types.TimestampProto(time.Now())
 	if err != nil {
@@ -204,14 +318,6 @@ func now() *types.Timestamp {
 	return t
 }
 
-func present(key string) etcd.Cmp {
-	return etcd.Compare(etcd.CreateRevision(key), ">", 0)
-}
-
-func absent(key string) etcd.Cmp {
-	return etcd.Compare(etcd.CreateRevision(key), "=", 0)
-}
-
 func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*pfs.Repo, description string, update bool) error {
 	if err := ValidateRepoName(repo.Name); err != nil {
 		return err
@@ -248,7 +354,7 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*p
 		}
 	}
 
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
 
@@ -298,7 +404,7 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*p
 			// We also add the new provenance repos to the provenance
 			// of all downstream repos, and remove the old provenance
 			// repos from their provenance.
-			downstreamRepos, err := d.listRepo(ctx, []*pfs.Repo{repo}, false)
+			downstreamRepos, err := d.listRepo(ctx, []*pfs.Repo{repo}, false, 0, "")
 			if err != nil {
 				return err
 			}
@@ -390,7 +496,10 @@ func (d *driver) inspectRepo(ctx context.Context, repo *pfs.Repo, includeAuth bo
 	return result, nil
 }
 
-func (d *driver) listRepo(ctx context.Context, provenance []*pfs.Repo, includeAuth bool) (*pfs.ListRepoResponse, error) {
+// listRepo lists the repos that have all of 'provenance' as provenance. If
+// 'pageSize' isn't 0, at most 'pageSize' repos are listed, newest first,
+// starting after the repo named 'pageToken'.
+func (d *driver) listRepo(ctx context.Context, provenance []*pfs.Repo, includeAuth bool, pageSize int64, pageToken string) (*pfs.ListRepoResponse, error) {
 	repos := d.repos.ReadOnly(ctx)
 	// Ensure that all provenance repos exist
 	for _, prov := range provenance {
@@ -400,11 +509,34 @@ func (d *driver) listRepo(ctx context.Context, provenance []*pfs.Repo, includeAu
 		}
 	}
 
-	iterator, err := repos.List()
+	var iterator col.Iterator
+	// pages is set if the repos are listed in pages, and gives the token of
+	// each repo that's listed
+	var pages col.ListIterator
+	var err error
+	if pageSize > 0 || pageToken != "" {
+		// Pages are sorted by creation time, which, unlike modification
+		// time, doesn't change between pages
+		opts := col.ListOptions{
+			Target:    col.SortByCreateRevision,
+			PageToken: pageToken,
+		}
+		if pageSize > 0 && len(provenance) == 0 {
+			// Read one more repo than the page holds, to find out if
+			// there's a next page
+			opts.Limit = pageSize + 1
+		}
+		pages, err = repos.ListWithOptions(opts)
+		iterator = pages
+	} else {
+		iterator, err = repos.List()
+	}
 	if err != nil {
 		return nil, err
 	}
 	result := new(pfs.ListRepoResponse)
+	// lastToken continues the listing after the last repo in the result
+	var lastToken string
 nextRepo:
 	for {
 		repoName, repoInfo := "", new(pfs.RepoInfo)
@@ -428,6 +560,10 @@ nextRepo:
 				continue nextRepo
 			}
 		}
+		if pageSize > 0 && int64(len(result.RepoInfo)) == pageSize {
+			result.NextPageToken = lastToken
+			break
+		}
 		if includeAuth {
 			// Include auth information in the response
 			resp, err := d.pachClient.AuthAPIClient.GetScope(auth.In2Out(ctx),
@@ -445,6 +581,9 @@ nextRepo:
 			}
 		}
 		result.RepoInfo = append(result.RepoInfo, repoInfo)
+		if pages != nil {
+			lastToken = pages.PageToken()
+		}
 	}
 	return result, nil
 }
@@ -453,7 +592,7 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_OWNER); err != nil {
 		return err
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
 		commits := d.commits(repo.Name).ReadWrite(stm)
@@ -484,12 +623,22 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 			}
 		}
 
+		// The users whose commits added to the repo's usage no longer use
+		// that storage
+		for user, usage := range repoInfo.UserUsage {
+			if err := d.subtractUserUsage(stm, user, usage); err != nil {
+				return err
+			}
+		}
 		if err := repos.Delete(repo.Name); err != nil {
 			return err
 		}
 		if err := repoRefCounts.Delete(repo.Name); err != nil {
 			return err
 		}
+		if err := d.repoQuotas.ReadWrite(stm).Delete(repo.Name); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
 		commits.DeleteAll()
 		branches.DeleteAll()
 		return nil
@@ -506,38 +655,508 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 	return nil
 }
 
-func (d *driver) startCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit) (*pfs.Commit, error) {
-	return d.makeCommit(ctx, parent, branch, provenance, nil)
+// forkRepo creates 'dst' as a copy of 'src'. The commits and branches of
+// 'dst' point at the same tree objects as those of 'src', so no file data is
+// copied. Forked commits get new IDs, so that a commit ID never refers to
+// commits in two repos. Open commits in 'src' are not forked; forked commits
+// and branches whose parent or head is an open commit point at its closest
+// finished ancestor instead. If the fork fails after 'dst' has been created,
+// 'dst' is deleted.
+func (d *driver) forkRepo(ctx context.Context, src *pfs.Repo, dst *pfs.Repo, description string) (retErr error) {
+	if err := d.checkIsAuthorized(ctx, src, auth.Scope_READER); err != nil {
+		return err
+	}
+	srcInfo, err := d.inspectRepo(ctx, src, false)
+	if err != nil {
+		return err
+	}
+	if description == "" {
+		description = srcInfo.Description
+	}
+	if err := d.createRepo(ctx, dst, srcInfo.Provenance, description, false); err != nil {
+		return err
+	}
+	defer func() {
+		if retErr == nil {
+			return
+		}
+		if err := d.deleteRepo(ctx, dst, true); err != nil {
+			logrus.Errorf("could not delete partially forked repo %s: %v", dst.Name, err)
+		}
+	}()
+
+	// Each commit is copied in its own transaction, as a repo may have more
+	// commits than etcd allows operations in a single transaction.
+	// List returns the most recently modified commits first, so they're
+	// copied in reverse, so that 'dst' lists them in the same order as 'src'
+	var commitIDs []string
+	commitInfos := make(map[string]*pfs.CommitInfo)
+	iterator, err := d.commits(src.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	for {
+		var commitID string
+		commitInfo := new(pfs.CommitInfo)
+		ok, err := iterator.Next(&commitID, commitInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		commitIDs = append(commitIDs, commitInfo.Commit.ID)
+		commitInfos[commitInfo.Commit.ID] = commitInfo
+	}
+	// forkedIDs maps the IDs of the finished commits in 'src' to the IDs of
+	// their copies in 'dst'
+	forkedIDs := make(map[string]string)
+	for id, commitInfo := range commitInfos {
+		if commitInfo.Finished != nil {
+			forkedIDs[id] = uuid.NewWithoutDashes()
+		}
+	}
+	// forkedCommit returns the copy in 'dst' of the closest finished ancestor
+	// of 'commit' (including 'commit' itself), or nil if there is none
+	forkedCommit := func(commit *pfs.Commit) *pfs.Commit {
+		for commit != nil {
+			if id, ok := forkedIDs[commit.ID]; ok {
+				return &pfs.Commit{Repo: dst, ID: id}
+			}
+			commitInfo, ok := commitInfos[commit.ID]
+			if !ok {
+				return nil
+			}
+			commit = commitInfo.ParentCommit
+		}
+		return nil
+	}
+	for i := len(commitIDs) - 1; i >= 0; i-- {
+		commitInfo := commitInfos[commitIDs[i]]
+		if commitInfo.Finished == nil {
+			continue
+		}
+		forkInfo := *commitInfo
+		forkInfo.Commit = forkedCommit(commitInfo.Commit)
+		forkInfo.ParentCommit = forkedCommit(commitInfo.ParentCommit)
+		// The fork's storage isn't charged to the commits' authors
+		forkInfo.Usage = nil
+		if _, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
+			return d.commits(dst.Name).ReadWrite(stm).Create(forkInfo.Commit.ID, &forkInfo)
+		}); err != nil {
+			return err
+		}
+	}
+
+	branchInfos, err := d.listBranch(ctx, src)
+	if err != nil {
+		return err
+	}
+	_, err = col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
+		repos := d.repos.ReadWrite(stm)
+		branches := d.branches(dst.Name).ReadWrite(stm)
+		for _, branchInfo := range branchInfos {
+			// Point the branch at the fork of its most recent finished commit
+			head := forkedCommit(branchInfo.Head)
+			if head == nil {
+				continue
+			}
+			if err := branches.Put(branchInfo.Name, head); err != nil {
+				return err
+			}
+		}
+		repoInfo := new(pfs.RepoInfo)
+		if err := repos.Get(dst.Name, repoInfo); err != nil {
+			return err
+		}
+		repoInfo.SizeBytes = srcInfo.SizeBytes
+		repoInfo.FileCount = srcInfo.FileCount
+		return repos.Put(dst.Name, repoInfo)
+	})
+	return err
+}
+
+func (d *driver) setQuota(ctx context.Context, repo *pfs.Repo, user string, quota *pfs.Quota) error {
+	if (repo == nil) == (user == "") {
+		return fmt.Errorf("exactly one of repo and user must be set")
+	}
+	if err := d.checkIsAdmin(ctx); err != nil {
+		return err
+	}
+	_, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
+		quotas := d.userQuotas.ReadWrite(stm)
+		key := user
+		if repo != nil {
+			// Check if repo exists
+			if err := d.repos.ReadWrite(stm).Get(repo.Name, &pfs.RepoInfo{}); err != nil {
+				return err
+			}
+			quotas = d.repoQuotas.ReadWrite(stm)
+			key = repo.Name
+		}
+		if quota == nil || (quota.SizeBytes == 0 && quota.FileCount == 0) {
+			if err := quotas.Delete(key); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			return nil
+		}
+		return quotas.Put(key, quota)
+	})
+	return err
+}
+
+func (d *driver) listQuota(ctx context.Context) ([]*pfs.QuotaInfo, error) {
+	if err := d.checkIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var result []*pfs.QuotaInfo
+	repoInfos, err := d.listRepo(ctx, nil, false, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	repoQuotas := d.repoQuotas.ReadOnly(ctx)
+	for _, repoInfo := range repoInfos.RepoInfo {
+		quotaInfo := &pfs.QuotaInfo{
+			Repo: repoInfo.Repo,
+			Usage: &pfs.Usage{
+				SizeBytes: repoInfo.SizeBytes,
+				FileCount: repoInfo.FileCount,
+			},
+		}
+		quota := new(pfs.Quota)
+		if err := repoQuotas.Get(repoInfo.Repo.Name, quota); err == nil {
+			quotaInfo.Quota = quota
+		} else if !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		result = append(result, quotaInfo)
+	}
+
+	// Users appear in the report if they have used any storage or have a quota
+	userInfos := make(map[string]*pfs.QuotaInfo)
+	iterator, err := d.userUsage.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var user string
+		usage := new(pfs.Usage)
+		ok, err := iterator.Next(&user, usage)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		userInfos[user] = &pfs.QuotaInfo{User: user, Usage: usage}
+	}
+	iterator, err = d.userQuotas.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var user string
+		quota := new(pfs.Quota)
+		ok, err := iterator.Next(&user, quota)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if _, ok := userInfos[user]; !ok {
+			userInfos[user] = &pfs.QuotaInfo{User: user, Usage: &pfs.Usage{}}
+		}
+		userInfos[user].Quota = quota
+	}
+	var users []string
+	for user := range userInfos {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+	for _, user := range users {
+		result = append(result, userInfos[user])
+	}
+	return result, nil
+}
+
+// addUsage adds 'usage' to the storage used by the repo in 'repoInfo' and by
+// 'user' (if set), and writes the updated usage in 'stm'. If the new usage
+// would exceed either quota, an ErrQuotaExceeded is returned and nothing is
+// written.
+func (d *driver) addUsage(stm col.STM, repoInfo *pfs.RepoInfo, user string, usage *pfs.Usage) error {
+	userUsage, err := d.checkQuotas(stm, repoInfo, user, usage)
+	if err != nil {
+		return err
+	}
+	repoInfo.SizeBytes += usage.SizeBytes
+	repoInfo.FileCount += usage.FileCount
+	if user != "" {
+		if repoInfo.UserUsage == nil {
+			repoInfo.UserUsage = make(map[string]*pfs.Usage)
+		}
+		repoUserUsage := repoInfo.UserUsage[user]
+		if repoUserUsage == nil {
+			repoUserUsage = &pfs.Usage{}
+			repoInfo.UserUsage[user] = repoUserUsage
+		}
+		repoUserUsage.SizeBytes += usage.SizeBytes
+		repoUserUsage.FileCount += usage.FileCount
+	}
+	if err := d.repos.ReadWrite(stm).Put(repoInfo.Repo.Name, repoInfo); err != nil {
+		return err
+	}
+	if user == "" {
+		return nil
+	}
+	return d.userUsage.ReadWrite(stm).Put(user, userUsage)
+}
+
+// subtractUsage subtracts 'usage' from the storage used by the repo in
+// 'repoInfo' and by 'user' (if set), and writes the updated usage in 'stm'.
+func (d *driver) subtractUsage(stm col.STM, repoInfo *pfs.RepoInfo, user string, usage *pfs.Usage) error {
+	subtract(&repoInfo.SizeBytes, usage.SizeBytes)
+	subtract(&repoInfo.FileCount, usage.FileCount)
+	if repoUserUsage, ok := repoInfo.UserUsage[user]; ok {
+		subtract(&repoUserUsage.SizeBytes, usage.SizeBytes)
+		subtract(&repoUserUsage.FileCount, usage.FileCount)
+		if repoUserUsage.SizeBytes == 0 && repoUserUsage.FileCount == 0 {
+			delete(repoInfo.UserUsage, user)
+		}
+	}
+	if err := d.repos.ReadWrite(stm).Put(repoInfo.Repo.Name, repoInfo); err != nil {
+		return err
+	}
+	if user == "" {
+		return nil
+	}
+	return d.subtractUserUsage(stm, user, usage)
+}
+
+// subtractUserUsage subtracts 'usage' from the storage used by 'user', and
+// writes the updated usage in 'stm'. Users that no longer use any storage are
+// removed.
+func (d *driver) subtractUserUsage(stm col.STM, user string, usage *pfs.Usage) error {
+	userUsages := d.userUsage.ReadWrite(stm)
+	userUsage := new(pfs.Usage)
+	if err := userUsages.Get(user, userUsage); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	subtract(&userUsage.SizeBytes, usage.SizeBytes)
+	subtract(&userUsage.FileCount, usage.FileCount)
+	if userUsage.SizeBytes == 0 && userUsage.FileCount == 0 {
+		return userUsages.Delete(user)
+	}
+	return userUsages.Put(user, userUsage)
+}
+
+// subtract subtracts 'n' from '*x', stopping at 0, since usage that was
+// recorded before it was tracked per repo and per commit may be lower than
+// what's subtracted.
+func subtract(x *uint64, n uint64) {
+	if n > *x {
+		*x = 0
+		return
+	}
+	*x -= n
+}
+
+// checkQuotas returns an ErrQuotaExceeded if adding 'usage' to the storage
+// used by the repo in 'repoInfo' or by 'user' (if set) would exceed their
+// quotas. Otherwise, it returns what the storage used by 'user' would be.
+func (d *driver) checkQuotas(stm col.STM, repoInfo *pfs.RepoInfo, user string, usage *pfs.Usage) (*pfs.Usage, error) {
+	repoQuota := new(pfs.Quota)
+	if err := d.repoQuotas.ReadWrite(stm).Get(repoInfo.Repo.Name, repoQuota); err == nil {
+		repoUsage := &pfs.Usage{
+			SizeBytes: repoInfo.SizeBytes + usage.SizeBytes,
+			FileCount: repoInfo.FileCount + usage.FileCount,
+		}
+		if exceedsQuota(repoQuota, repoUsage) {
+			return nil, pfsserver.ErrQuotaExceeded{Repo: repoInfo.Repo, Quota: repoQuota, Usage: repoUsage}
+		}
+	} else if !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	if user == "" {
+		return nil, nil
+	}
+	userUsage := new(pfs.Usage)
+	if err := d.userUsage.ReadWrite(stm).Get(user, userUsage); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	userUsage.SizeBytes += usage.SizeBytes
+	userUsage.FileCount += usage.FileCount
+	userQuota := new(pfs.Quota)
+	if err := d.userQuotas.ReadWrite(stm).Get(user, userQuota); err == nil {
+		if exceedsQuota(userQuota, userUsage) {
+			return nil, pfsserver.ErrQuotaExceeded{User: user, Quota: userQuota, Usage: userUsage}
+		}
+	} else if !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	return userUsage, nil
+}
+
+// remainingQuota returns how many more bytes can be added to the repo in
+// 'repoInfo' and by 'user' (if set) before either goes over its quota, or
+// math.MaxUint64 if neither has a size quota.
+func (d *driver) remainingQuota(stm col.STM, repoInfo *pfs.RepoInfo, user string) (uint64, error) {
+	remaining := uint64(math.MaxUint64)
+	limit := func(quota, used uint64) {
+		if quota == 0 {
+			return
+		}
+		var left uint64
+		if used < quota {
+			left = quota - used
+		}
+		if left < remaining {
+			remaining = left
+		}
+	}
+	repoQuota := new(pfs.Quota)
+	if err := d.repoQuotas.ReadWrite(stm).Get(repoInfo.Repo.Name, repoQuota); err == nil {
+		limit(repoQuota.SizeBytes, repoInfo.SizeBytes)
+	} else if !col.IsErrNotFound(err) {
+		return 0, err
+	}
+	if user == "" {
+		return remaining, nil
+	}
+	userQuota := new(pfs.Quota)
+	if err := d.userQuotas.ReadWrite(stm).Get(user, userQuota); err != nil {
+		if col.IsErrNotFound(err) {
+			return remaining, nil
+		}
+		return 0, err
+	}
+	userUsage := new(pfs.Usage)
+	if err := d.userUsage.ReadWrite(stm).Get(user, userUsage); err != nil && !col.IsErrNotFound(err) {
+		return 0, err
+	}
+	limit(userQuota.SizeBytes, userUsage.SizeBytes)
+	return remaining, nil
+}
+
+// quotaReader reads from 'r' until more than 'remaining' bytes have been
+// read, after which it fails, so that uploads that go over quota are stopped.
+type quotaReader struct {
+	r         io.Reader
+	remaining uint64
+	read      uint64
+	exceeded  bool
+}
+
+func (q *quotaReader) Read(p []byte) (int, error) {
+	if q.exceeded {
+		return 0, errQuotaReached
+	}
+	// Read at most one byte past what's left, which is enough to tell that
+	// the upload goes over quota
+	if left := q.remaining - q.read; left < math.MaxUint64 && uint64(len(p)) > left+1 {
+		p = p[:left+1]
+	}
+	n, err := q.r.Read(p)
+	q.read += uint64(n)
+	if q.read > q.remaining {
+		q.exceeded = true
+		return n, errQuotaReached
+	}
+	return n, err
+}
+
+// errQuotaReached is returned by quotaReader once an upload goes over quota
+var errQuotaReached = errors.New("upload exceeds the storage quota")
+
+func exceedsQuota(quota *pfs.Quota, usage *pfs.Usage) bool {
+	return (quota.SizeBytes != 0 && usage.SizeBytes > quota.SizeBytes) ||
+		(quota.FileCount != 0 && usage.FileCount > quota.FileCount)
+}
+
+func (d *driver) startCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, description string) (*pfs.Commit, error) {
+	return d.makeCommit(ctx, "", parent, branch, provenance, nil, description, "")
+}
+
+func (d *driver) buildCommit(ctx context.Context, commitID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit, tree *pfs.Object, description string, author string) (*pfs.Commit, error) {
+	return d.makeCommit(ctx, commitID, parent, branch, provenance, tree, description, author)
 }
 
-func (d *driver) buildCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, tree *pfs.Object) (*pfs.Commit, error) {
-	return d.makeCommit(ctx, parent, branch, provenance, tree)
+// commitAuthor returns the author of the commits started by the caller in
+// 'ctx', which is the name of the calling user or pipeline. If the auth system
+// isn't activated, the author is empty.
+func (d *driver) commitAuthor(ctx context.Context) (string, error) {
+	d.initializePachConn()
+	whoAmI, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsNotActivatedError(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error looking up the author of the commit: %s", err.Error())
+	}
+	if whoAmI.Pipeline != "" {
+		return pfs.PipelineAuthor(whoAmI.Pipeline), nil
+	}
+	return whoAmI.Username, nil
 }
 
-func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, treeRef *pfs.Object) (*pfs.Commit, error) {
+// makeCommit creates a new commit. If commitID is empty, a new ID is generated
+// for it. If author is empty, the commit is attributed to the caller.
+func (d *driver) makeCommit(ctx context.Context, commitID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit, treeRef *pfs.Object, description string, author string) (*pfs.Commit, error) {
 	if err := d.checkIsAuthorized(ctx, parent.Repo, auth.Scope_WRITER); err != nil {
 		return nil, err
 	}
 	if parent == nil {
 		return nil, fmt.Errorf("parent cannot be nil")
 	}
+	caller, err := d.commitAuthor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if author == "" {
+		author = caller
+	} else if author != caller && caller != "" {
+		// Commits count against their authors' quotas, so only admins can
+		// attribute them to someone else
+		if err := d.checkIsAdmin(ctx); err != nil {
+			return nil, fmt.Errorf("only admins can attribute commits to %s: %v", author, err)
+		}
+	}
+	explicitID := commitID != ""
+	if explicitID {
+		if err := validateCommitID(commitID); err != nil {
+			return nil, err
+		}
+	} else {
+		commitID = uuid.NewWithoutDashes()
+	}
 	commit := &pfs.Commit{
 		Repo: parent.Repo,
-		ID:   uuid.NewWithoutDashes(),
+		ID:   commitID,
 	}
-	var commitSize uint64
+	var commitSize, commitFileCount uint64
 	if treeRef != nil {
-		var buf bytes.Buffer
-		if err := d.pachClient.GetObject(treeRef.Hash, &buf); err != nil {
+		data, err := d.getTreeObject(treeRef)
+		if err != nil {
 			return nil, err
 		}
-		tree, err := hashtree.Deserialize(buf.Bytes())
+		tree, err := hashtree.DeserializeSharded(data, d.getTreeObject)
 		if err != nil {
 			return nil, err
 		}
 		commitSize = uint64(tree.FSSize())
+		if err := tree.Walk(func(path string, node *hashtree.NodeProto) error {
+			if node.FileNode != nil {
+				commitFileCount++
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
 	}
-	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	if _, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		commits := d.commits(parent.Repo.Name).ReadWrite(stm)
 		branches := d.branches(parent.Repo.Name).ReadWrite(stm)
@@ -547,10 +1166,26 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 		if err := repos.Get(parent.Repo.Name, repoInfo); err != nil {
 			return err
 		}
+		if explicitID {
+			// A commit ID that's taken by a commit or a branch would make
+			// the new commit ambiguous
+			if err := commits.Get(commitID, &pfs.CommitInfo{}); err == nil {
+				return fmt.Errorf("commit %s already exists in repo %s", commitID, parent.Repo.Name)
+			} else if !col.IsErrNotFound(err) {
+				return err
+			}
+			if err := branches.Get(commitID, &pfs.Commit{}); err == nil {
+				return fmt.Errorf("commit ID %s is the name of a branch in repo %s", commitID, parent.Repo.Name)
+			} else if !col.IsErrNotFound(err) {
+				return err
+			}
+		}
 
 		commitInfo := &pfs.CommitInfo{
-			Commit:  commit,
-			Started: now(),
+			Commit:      commit,
+			Started:     now(),
+			Description: description,
+			Author:      author,
 		}
 
 		// Use a map to de-dup provenance
@@ -609,8 +1244,13 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 			commitInfo.Tree = treeRef
 			commitInfo.SizeBytes = commitSize
 			commitInfo.Finished = now()
-			repoInfo.SizeBytes += commitSize
-			repos.Put(parent.Repo.Name, repoInfo)
+			commitInfo.Usage = &pfs.Usage{
+				SizeBytes: commitSize,
+				FileCount: commitFileCount,
+			}
+			if err := d.addUsage(stm, repoInfo, author, commitInfo.Usage); err != nil {
+				return err
+			}
 		} else {
 			d.openCommits.ReadWrite(stm).Put(commit.ID, commit)
 		}
@@ -622,7 +1262,17 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 	return commit, nil
 }
 
-func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
+// validateCommitID returns an error if 'id' isn't in the format of the commit
+// IDs that pachd generates (a UUIDv4 without dashes), which putFile relies on
+// to tell commit IDs from branch names.
+func validateCommitID(id string) error {
+	if len(id) != uuid.UUIDWithoutDashesLength || id[12] != '4' || strings.Trim(id, "0123456789abcdef") != "" {
+		return fmt.Errorf("invalid commit ID %q: commit IDs must be UUIDv4s without dashes", id)
+	}
+	return nil
+}
+
+func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, description string) error {
 	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
@@ -640,7 +1290,7 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	}
 
 	// Read everything under the scratch space for this commit
-	resp, err := d.etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+	resp, err := d.store.Get(ctx, prefix, kv.GetOptions{Prefix: true, Sort: kv.SortByModRevisionAscend})
 	if err != nil {
 		return err
 	}
@@ -649,7 +1299,12 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	if err != nil {
 		return err
 	}
-	tree := parentTree.Open()
+	// Only the parts of a sharded parent tree that this commit changes are
+	// loaded and rewritten
+	tree, err := hashtree.OpenOverlay(parentTree)
+	if err != nil {
+		return err
+	}
 
 	if err := d.applyWrites(resp, tree); err != nil {
 		return err
@@ -659,15 +1314,44 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	if err != nil {
 		return err
 	}
-	// Serialize the tree
-	data, err := hashtree.Serialize(finishedTree)
+	// The repo's usage grows by the sizes of the files that have been added
+	// or changed in this commit, and by the number of new files
+	usage := &pfs.Usage{}
+	if err := finishedTree.Diff(parentTree, "", "", -1, func(path string, node *hashtree.NodeProto, new bool) error {
+		if node.FileNode != nil && new {
+			usage.SizeBytes += uint64(node.SubtreeSize)
+			if _, err := parentTree.Get(path); hashtree.Code(err) == hashtree.PathNotFound {
+				usage.FileCount++
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Check the quotas before the tree is written to the object store, so
+	// that commits that would go over quota don't leave trees behind. They're
+	// checked again when the usage is added, in case it has changed since.
+	if _, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
+		repoInfo := new(pfs.RepoInfo)
+		if err := d.repos.ReadWrite(stm).Get(commit.Repo.Name, repoInfo); err != nil {
+			return err
+		}
+		_, err := d.checkQuotas(stm, repoInfo, commitInfo.Author, usage)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Serialize the tree, storing it in shards if it's large
+	data, err := hashtree.SerializeSharded(finishedTree, hashtree.DefaultShardSize, d.putTreeObject)
 	if err != nil {
 		return err
 	}
 
 	if len(data) > 0 {
 		// Put the tree into the blob store
-		obj, _, err := d.pachClient.PutObject(bytes.NewReader(data))
+		obj, err := d.putTreeObject(data)
 		if err != nil {
 			return err
 		}
@@ -677,8 +1361,12 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 
 	commitInfo.SizeBytes = uint64(finishedTree.FSSize())
 	commitInfo.Finished = now()
+	if description != "" {
+		commitInfo.Description = description
+	}
 
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	commitInfo.Usage = usage
+	_, err = col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		repos := d.repos.ReadWrite(stm)
 
@@ -691,24 +1379,17 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 		if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
 			return err
 		}
-
-		// Increment the repo sizes by the sizes of the files that have
-		// been added in this commit.
-		finishedTree.Diff(parentTree, "", "", -1, func(path string, node *hashtree.NodeProto, new bool) error {
-			if node.FileNode != nil && new {
-				repoInfo.SizeBytes += uint64(node.SubtreeSize)
-			}
-			return nil
-		})
-		repos.Put(commit.Repo.Name, repoInfo)
-		return nil
+		// If this commit would take the repo or its author over quota, the
+		// transaction fails and the commit stays open, so that the caller can
+		// delete files and try again.
+		return d.addUsage(stm, repoInfo, commitInfo.Author, usage)
 	})
 	if err != nil {
 		return err
 	}
 
 	// Delete the scratch space for this commit
-	_, err = d.etcdClient.Delete(ctx, prefix, etcd.WithPrefix())
+	_, err = d.store.Txn(ctx, &kv.Txn{Then: []kv.Op{kv.DeletePrefix(prefix)}})
 	return err
 }
 
@@ -727,7 +1408,7 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Co
 	commitID, ancestryLength := parseCommitID(commit.ID)
 
 	// Check if the commitID is a branch name
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
 		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
 
 		head := new(pfs.Commit)
@@ -804,33 +1485,37 @@ func parseCommitID(commitID string) (string, int) {
 	return commitID[:sepIndex], len(commitID) - sepIndex
 }
 
-func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64) ([]*pfs.CommitInfo, error) {
+// listCommit lists at most 'number' commits (or all of them, if it's 0),
+// starting after the commit 'pageToken' if it's set. It also returns the
+// token of the next page, if there may be one.
+func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64, pageToken string) ([]*pfs.CommitInfo, string, error) {
 	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_READER); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if from != nil && from.Repo.Name != repo.Name || to != nil && to.Repo.Name != repo.Name {
-		return nil, fmt.Errorf("`from` and `to` commits need to be from repo %s", repo.Name)
+		return nil, "", fmt.Errorf("`from` and `to` commits need to be from repo %s", repo.Name)
 	}
 
 	// Make sure that the repo exists
 	_, err := d.inspectRepo(ctx, repo, !includeAuth)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Make sure that both from and to are valid commits
 	if from != nil {
 		_, err = d.inspectCommit(ctx, from)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 	if to != nil {
 		_, err = d.inspectCommit(ctx, to)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
+	pageSize := number
 
 	// if number is 0, we return all commits that match the criteria
 	if number == 0 {
@@ -839,41 +1524,83 @@ func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit,
 	var commitInfos []*pfs.CommitInfo
 	commits := d.commits(repo.Name).ReadOnly(ctx)
 
+	var nextPageToken string
 	if from != nil && to == nil {
-		return nil, fmt.Errorf("cannot use `from` commit without `to` commit")
+		return nil, "", fmt.Errorf("cannot use `from` commit without `to` commit")
 	} else if from == nil && to == nil {
 		// if neither from and to is given, we list all commits in
 		// the repo, sorted by revision timestamp
-		iterator, err := commits.List()
+		var iterator col.Iterator
+		// pages is set if the commits are listed in pages, and gives the
+		// token of each commit that's listed
+		var pages col.ListIterator
+		if pageSize > 0 || pageToken != "" {
+			// Pages are sorted by creation time, which, unlike
+			// modification time, doesn't change when a commit is finished
+			opts := col.ListOptions{
+				Target:    col.SortByCreateRevision,
+				PageToken: pageToken,
+			}
+			if pageSize > 0 {
+				// Read one more commit than the page holds, to find out if
+				// there's a next page
+				opts.Limit = int64(pageSize) + 1
+			}
+			pages, err = commits.ListWithOptions(opts)
+			iterator = pages
+		} else {
+			iterator, err = commits.List()
+		}
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		var commitID string
-		for number != 0 {
+		// lastToken continues the listing after the last commit in
+		// commitInfos
+		var lastToken string
+		for {
 			var commitInfo pfs.CommitInfo
 			ok, err := iterator.Next(&commitID, &commitInfo)
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
 			if !ok {
 				break
 			}
+			if number == 0 {
+				nextPageToken = lastToken
+				break
+			}
 			commitInfos = append(commitInfos, &commitInfo)
+			if pages != nil {
+				lastToken = pages.PageToken()
+			}
 			number--
 		}
 	} else {
 		cursor := to
+		if pageToken != "" {
+			// The page starts after the last commit of the previous page
+			var commitInfo pfs.CommitInfo
+			if err := commits.Get(pageToken, &commitInfo); err != nil {
+				return nil, "", err
+			}
+			cursor = commitInfo.ParentCommit
+		}
 		for number != 0 && cursor != nil && (from == nil || cursor.ID != from.ID) {
 			var commitInfo pfs.CommitInfo
 			if err := commits.Get(cursor.ID, &commitInfo); err != nil {
-				return nil, err
+				return nil, "", err
 			}
 			commitInfos = append(commitInfos, &commitInfo)
 			cursor = commitInfo.ParentCommit
 			number--
 		}
+		if pageSize > 0 && cursor != nil && (from == nil || cursor.ID != from.ID) {
+			nextPageToken = commitInfos[len(commitInfos)-1].Commit.ID
+		}
 	}
-	return commitInfos, nil
+	return commitInfos, nextPageToken, nil
 }
 
 type commitStream struct {
@@ -924,10 +1651,10 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 		seen := make(map[string]bool)
 		// include all commits that are currently on the given branch,
 		// but only the ones that have been finished
-		commitInfos, err := d.listCommit(ctx, repo, &pfs.Commit{
+		commitInfos, _, err := d.listCommit(ctx, repo, &pfs.Commit{
 			Repo: repo,
 			ID:   branch,
-		}, from, 0)
+		}, from, 0, "")
 		if err != nil {
 			// We skip NotFound error because it's ok if the branch
 			// doesn't exist yet, in which case ListCommit returns
@@ -1223,7 +1950,7 @@ func (d *driver) deleteCommit(ctx context.Context, commit *pfs.Commit) error {
 	if err != nil {
 		return err
 	}
-	_, err = d.etcdClient.Delete(ctx, prefix, etcd.WithPrefix())
+	_, err = d.store.Txn(ctx, &kv.Txn{Then: []kv.Op{kv.DeletePrefix(prefix)}})
 	if err != nil {
 		return err
 	}
@@ -1250,18 +1977,22 @@ func (d *driver) deleteCommit(ctx context.Context, commit *pfs.Commit) error {
 		}
 	}
 
-	// Delete the commit itself and subtract the size of the commit
-	// from repo size.
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		repos := d.repos.ReadWrite(stm)
-		repoInfo := new(pfs.RepoInfo)
-		if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
+	// Delete the commit itself and subtract the storage that the commit
+	// added from the usage of the repo and of the commit's author.
+	_, err = col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
+		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
+		if err := commits.Get(commit.ID, commitInfo); err != nil {
 			return err
 		}
-		repoInfo.SizeBytes -= commitInfo.SizeBytes
-		repos.Put(commit.Repo.Name, repoInfo)
-
-		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
+		if commitInfo.Usage != nil {
+			repoInfo := new(pfs.RepoInfo)
+			if err := d.repos.ReadWrite(stm).Get(commit.Repo.Name, repoInfo); err != nil {
+				return err
+			}
+			if err := d.subtractUsage(stm, repoInfo, commitInfo.Author, commitInfo.Usage); err != nil {
+				return err
+			}
+		}
 		return commits.Delete(commit.ID)
 	})
 
@@ -1304,7 +2035,7 @@ func (d *driver) setBranch(ctx context.Context, commit *pfs.Commit, name string)
 	if _, err := d.inspectCommit(ctx, commit); err != nil {
 		return err
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
 
@@ -1326,7 +2057,7 @@ func (d *driver) deleteBranch(ctx context.Context, repo *pfs.Repo, name string)
 	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
 		branches := d.branches(repo.Name).ReadWrite(stm)
 		return branches.Delete(name)
 	})
@@ -1371,8 +2102,13 @@ func checkPath(path string) error {
 	return nil
 }
 
+// putFile writes the data in 'reader' to 'file'. If 'mode' or 'mtime' is set,
+// it's applied to the file (or each file, if the data is split). If
+// 'symlinkTarget' is set, a symlink is created instead, and 'reader' is
+// ignored.
 func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Delimiter,
-	targetFileDatums int64, targetFileBytes int64, overwrite bool, reader io.Reader) error {
+	targetFileDatums int64, targetFileBytes int64, overwrite bool, mode uint32,
+	mtime *types.Timestamp, symlinkTarget string, reader io.Reader) error {
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
@@ -1381,12 +2117,65 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 	// and is open.
 	// Since we use UUIDv4 for commit IDs, the 13th character would be 4 if
 	// this is a commit ID.
+	commitInfo := new(pfs.CommitInfo)
 	if len(file.Commit.ID) != uuid.UUIDWithoutDashesLength || file.Commit.ID[12] != '4' {
-		commitInfo, err := d.inspectCommit(ctx, file.Commit)
+		var err error
+		commitInfo, err = d.inspectCommit(ctx, file.Commit)
 		if err != nil {
 			return err
 		}
 		file.Commit = commitInfo.Commit
+	} else if err := d.commits(file.Commit.Repo.Name).ReadOnly(ctx).Get(file.Commit.ID, commitInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrCommitNotFound{Commit: file.Commit}
+		}
+		return err
+	}
+
+	// checkQuotas returns an error if writing 'size' more bytes would take the
+	// repo or the commit's author over quota. File counts are only checked
+	// in finishCommit, as whether a write adds a file isn't known until then.
+	checkQuotas := func(size uint64) error {
+		_, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
+			repoInfo := new(pfs.RepoInfo)
+			if err := d.repos.ReadWrite(stm).Get(file.Commit.Repo.Name, repoInfo); err != nil {
+				return err
+			}
+			_, err := d.checkQuotas(stm, repoInfo, commitInfo.Author, &pfs.Usage{SizeBytes: size})
+			return err
+		})
+		return err
+	}
+	// Reject writes to a repo that is already over quota before uploading
+	// anything, and stop the upload once it uses up what's left of either
+	// quota
+	var remaining uint64
+	if _, err := col.NewStoreSTM(ctx, d.store, func(stm col.STM) error {
+		repoInfo := new(pfs.RepoInfo)
+		if err := d.repos.ReadWrite(stm).Get(file.Commit.Repo.Name, repoInfo); err != nil {
+			return err
+		}
+		if _, err := d.checkQuotas(stm, repoInfo, commitInfo.Author, &pfs.Usage{}); err != nil {
+			return err
+		}
+		var err error
+		remaining, err = d.remainingQuota(stm, repoInfo, commitInfo.Author)
+		return err
+	}); err != nil {
+		return err
+	}
+	quotaReader := &quotaReader{r: reader, remaining: remaining}
+	reader = quotaReader
+	// quotaErr returns the quota error in place of 'err' if the upload was
+	// stopped for going over quota
+	quotaErr := func(err error) error {
+		if !quotaReader.exceeded {
+			return err
+		}
+		if err := checkQuotas(quotaReader.read); err != nil {
+			return err
+		}
+		return err
 	}
 
 	if overwrite {
@@ -1395,7 +2184,10 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 		}
 	}
 
-	records := &PutFileRecords{}
+	records := &PutFileRecords{
+		Mode:  mode,
+		Mtime: mtime,
+	}
 	if err := checkPath(file.Path); err != nil {
 		return err
 	}
@@ -1406,17 +2198,25 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 
 	// Put the tree into the blob store
 	// Only write the records to etcd if the commit does exist and is open.
-	// To check that a key exists in etcd, we assert that its CreateRevision
-	// is greater than zero.
+	// To check that the commit's key still exists, we assert that it hasn't
+	// been modified since we read it.
 	putRecords := func() error {
 		marshalledRecords, err := records.Marshal()
 		if err != nil {
 			return err
 		}
-		kvc := etcd.NewKV(d.etcdClient)
-
-		txnResp, err := kvc.Txn(ctx).
-			If(etcd.Compare(etcd.CreateRevision(d.openCommits.Path(file.Commit.ID)), ">", 0)).Then(etcd.OpPut(path.Join(prefix, uuid.NewWithoutDashes()), string(marshalledRecords))).Commit()
+		openCommitKey := d.openCommits.Path(file.Commit.ID)
+		resp, err := d.store.Get(ctx, openCommitKey, kv.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			return fmt.Errorf("commit %v is not open", file.Commit.ID)
+		}
+		txnResp, err := d.store.Txn(ctx, &kv.Txn{
+			If:   []kv.Cmp{{Key: openCommitKey, ModRevision: resp.Kvs[0].ModRevision}},
+			Then: []kv.Op{kv.Put(path.Join(prefix, uuid.NewWithoutDashes()), string(marshalledRecords), kv.NoLease)},
+		})
 		if err != nil {
 			return err
 		}
@@ -1426,15 +2226,25 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 		return nil
 	}
 
+	if symlinkTarget != "" {
+		if delimiter != pfs.Delimiter_NONE {
+			return fmt.Errorf("cannot split a symlink")
+		}
+		records.SymlinkTarget = symlinkTarget
+		return putRecords()
+	}
 	if delimiter == pfs.Delimiter_NONE {
 		object, size, err := d.pachClient.PutObject(reader)
 		if err != nil {
-			return err
+			return quotaErr(err)
 		}
 		records.Records = append(records.Records, &PutFileRecord{
 			SizeBytes:  size,
 			ObjectHash: object.Hash,
 		})
+		if err := checkQuotas(uint64(size)); err != nil {
+			return err
+		}
 		return putRecords()
 	}
 	buffer := &bytes.Buffer{}
@@ -1465,7 +2275,7 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 			if err == io.EOF {
 				EOF = true
 			} else {
-				return err
+				return quotaErr(err)
 			}
 		}
 		buffer.Write(value)
@@ -1502,8 +2312,13 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 	}
 
 	records.Split = true
+	var size uint64
 	for i := 0; i < len(indexToRecord); i++ {
 		records.Records = append(records.Records, indexToRecord[i])
+		size += uint64(indexToRecord[i].SizeBytes)
+	}
+	if err := checkQuotas(size); err != nil {
+		return err
 	}
 
 	return putRecords()
@@ -1549,13 +2364,13 @@ func (d *driver) getTreeForCommit(ctx context.Context, commit *pfs.Commit) (hash
 		return t, nil
 	}
 
-	// read the tree from the block store
-	var buf bytes.Buffer
-	if err := d.pachClient.GetObject(treeRef.Hash, &buf); err != nil {
+	// read the tree from the disk cache or the block store
+	data, err := d.getTreeObject(treeRef)
+	if err != nil {
 		return nil, err
 	}
 
-	h, err := hashtree.Deserialize(buf.Bytes())
+	h, err := hashtree.DeserializeSharded(data, d.getTreeObject)
 	if err != nil {
 		return nil, err
 	}
@@ -1565,6 +2380,44 @@ func (d *driver) getTreeForCommit(ctx context.Context, commit *pfs.Commit) (hash
 	return h, nil
 }
 
+// getTreeObject reads a serialized hashtree, or a shard of one, from the disk
+// cache, falling back to the block store
+func (d *driver) getTreeObject(object *pfs.Object) ([]byte, error) {
+	if d.diskCache != nil {
+		if data, ok := d.diskCache.Get(object.Hash); ok {
+			return data, nil
+		}
+	}
+	var buf bytes.Buffer
+	if err := d.pachClient.GetObject(object.Hash, &buf); err != nil {
+		return nil, err
+	}
+	d.cacheTreeObject(object, buf.Bytes())
+	return buf.Bytes(), nil
+}
+
+// putTreeObject writes a serialized hashtree, or a shard of one, to the block
+// store and the disk cache
+func (d *driver) putTreeObject(data []byte) (*pfs.Object, error) {
+	object, _, err := d.pachClient.PutObject(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	d.cacheTreeObject(object, data)
+	return object, nil
+}
+
+// cacheTreeObject adds 'data', the contents of 'object', to the disk cache.
+// Failing to cache isn't fatal, so errors are only logged.
+func (d *driver) cacheTreeObject(object *pfs.Object, data []byte) {
+	if d.diskCache == nil {
+		return
+	}
+	if err := d.diskCache.Put(object.Hash, data); err != nil {
+		logrus.Errorf("could not cache hashtree object %s on disk: %v", object.Hash, err)
+	}
+}
+
 // getTreeForFile is like getTreeForCommit except that it can handle open commits.
 // It takes a file instead of a commit so that it can apply the changes for
 // that path to the tree before it returns it.
@@ -1592,7 +2445,7 @@ func (d *driver) getTreeForFile(ctx context.Context, file *pfs.File) (hashtree.H
 		return nil, err
 	}
 	// Read everything under the scratch space for this commit
-	resp, err := d.etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+	resp, err := d.store.Get(ctx, prefix, kv.GetOptions{Prefix: true, Sort: kv.SortByModRevisionAscend})
 	if err != nil {
 		return nil, err
 	}
@@ -1601,7 +2454,10 @@ func (d *driver) getTreeForFile(ctx context.Context, file *pfs.File) (hashtree.H
 	if err != nil {
 		return nil, err
 	}
-	openTree := parentTree.Open()
+	openTree, err := hashtree.OpenOverlay(parentTree)
+	if err != nil {
+		return nil, err
+	}
 	if err := d.applyWrites(resp, openTree); err != nil {
 		return nil, err
 	}
@@ -1626,9 +2482,12 @@ func (d *driver) getFile(ctx context.Context, file *pfs.File, offset int64, size
 		return nil, pfsserver.ErrFileNotFound{file}
 	}
 
-	if node.FileNode == nil {
+	if node.DirNode != nil {
 		return nil, fmt.Errorf("%s is a directory", file.Path)
 	}
+	if node.SymlinkNode != nil {
+		return nil, fmt.Errorf("%s is a symlink to %s", file.Path, node.SymlinkNode.Target)
+	}
 
 	getObjectsClient, err := d.pachClient.ObjectAPIClient.GetObjects(
 		ctx,
@@ -1653,6 +2512,8 @@ func nodeToFileInfo(commit *pfs.Commit, path string, node *hashtree.NodeProto, f
 		},
 		SizeBytes: uint64(node.SubtreeSize),
 		Hash:      node.Hash,
+		Mode:      node.Mode,
+		Mtime:     node.Mtime,
 	}
 	if node.FileNode != nil {
 		fileInfo.FileType = pfs.FileType_FILE
@@ -1664,6 +2525,9 @@ func nodeToFileInfo(commit *pfs.Commit, path string, node *hashtree.NodeProto, f
 		if full {
 			fileInfo.Children = node.DirNode.Children
 		}
+	} else if node.SymlinkNode != nil {
+		fileInfo.FileType = pfs.FileType_SYMLINK
+		fileInfo.SymlinkTarget = node.SymlinkNode.Target
 	}
 	return fileInfo
 }
@@ -1727,6 +2591,246 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, pattern strin
 	return fileInfos, nil
 }
 
+// grepConcurrency is the number of files that grepFile searches at once
+const grepConcurrency = 50
+
+// grepBufferSize is the number of matches in a file that grepFile holds
+// before the search of the file waits for them to be sent
+const grepBufferSize = 100
+
+// grepResult streams the matching lines found in one file by grepFile.
+// 'matches' is closed when the search of the file is done, after which 'err'
+// holds its error, if any.
+type grepResult struct {
+	matches chan *pfs.GrepFileResponse
+	err     error
+}
+
+// grepFile calls 'cb' with each line that matches 'regex' in the files in
+// 'commit' that match 'pattern', in path order. Directories that match
+// 'pattern' are searched recursively. Files are read in parallel inside pachd,
+// and the matches in each file are passed to 'cb' as they're found, but only
+// a bounded number of matches are held for files after the one being sent. If
+// 'maxMatches' is > 0, grepFile stops after that many matches.
+func (d *driver) grepFile(ctx context.Context, commit *pfs.Commit, pattern string, regex string, maxMatches int64, cb func(*pfs.GrepFileResponse) error) error {
+	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_READER); err != nil {
+		return err
+	}
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		return fmt.Errorf("invalid regex \"%s\": %v", regex, err)
+	}
+	tree, err := d.getTreeForFile(ctx, client.NewFile(commit.Repo.Name, commit.ID, ""))
+	if err != nil {
+		return err
+	}
+	nodes, err := tree.Glob(pattern)
+	if err != nil {
+		return err
+	}
+
+	// Collect the files to search, descending into matching directories
+	files := make(map[string]*hashtree.FileNodeProto)
+	var collect func(p string, node *hashtree.NodeProto) error
+	collect = func(p string, node *hashtree.NodeProto) error {
+		if node.FileNode != nil {
+			files[p] = node.FileNode
+		}
+		if node.DirNode == nil {
+			return nil
+		}
+		children, err := tree.List(p)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if err := collect(path.Join(p, child.Name), child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, node := range nodes {
+		if err := collect(node.Name, node); err != nil {
+			return err
+		}
+	}
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	// Search the files in parallel. Searches are started in path order, and
+	// their results are passed on in the same order, so that at most
+	// grepConcurrency files are searched or waiting to be sent at once.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan *grepResult, grepConcurrency)
+	go func() {
+		defer close(results)
+		limiter := limit.New(grepConcurrency)
+		for _, p := range paths {
+			limiter.Acquire()
+			result := &grepResult{matches: make(chan *pfs.GrepFileResponse, grepBufferSize)}
+			go func(p string) {
+				defer limiter.Release()
+				defer close(result.matches)
+				result.err = d.grepObjects(ctx, client.NewFile(commit.Repo.Name, commit.ID, p), files[p].Objects, re, result.matches)
+			}(p)
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var numMatches int64
+	for result := range results {
+		for match := range result.matches {
+			if err := cb(match); err != nil {
+				return err
+			}
+			numMatches++
+			if maxMatches > 0 && numMatches >= maxMatches {
+				return nil
+			}
+		}
+		if result.err != nil {
+			return result.err
+		}
+	}
+	return ctx.Err()
+}
+
+// grepObjects sends the lines that match 're' in the content of 'file', which
+// is stored in 'objects', to 'matches'.
+func (d *driver) grepObjects(ctx context.Context, file *pfs.File, objects []*pfs.Object, re *regexp.Regexp, matches chan<- *pfs.GrepFileResponse) error {
+	getObjectsClient, err := d.pachClient.ObjectAPIClient.GetObjects(
+		ctx,
+		&pfs.GetObjectsRequest{
+			Objects: objects,
+		})
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(grpcutil.NewStreamingBytesReader(getObjectsClient))
+	for lineNumber := int64(1); ; lineNumber++ {
+		line, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if len(line) == 0 && err == io.EOF {
+			return nil
+		}
+		line = bytes.TrimSuffix(line, []byte{'\n'})
+		if re.Match(line) {
+			select {
+			case matches <- &pfs.GrepFileResponse{
+				File:       file,
+				LineNumber: lineNumber,
+				Line:       string(line),
+			}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// listFileHistory returns info about 'file' in each commit, starting from
+// file.Commit and going back through its ancestors, in which the file's hash
+// differs from its hash in the commit's parent. At most 'n' FileInfos are
+// returned, unless 'n' is 0.
+func (d *driver) listFileHistory(ctx context.Context, file *pfs.File, n int64) ([]*pfs.FileInfo, error) {
+	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	commitInfo, err := d.inspectCommit(ctx, file.Commit)
+	if err != nil {
+		return nil, err
+	}
+	var node *hashtree.NodeProto
+	if commitInfo.Finished != nil {
+		node, err = d.getNodeForCommit(ctx, commitInfo.Commit, file.Path)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Open commits change, so their nodes aren't cached
+		tree, err := d.getTreeForFile(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		node, err = tree.Get(file.Path)
+		if err != nil && hashtree.Code(err) != hashtree.PathNotFound {
+			return nil, err
+		}
+	}
+
+	commits := d.commits(file.Commit.Repo.Name).ReadOnly(ctx)
+	var fileInfos []*pfs.FileInfo
+	for n == 0 || int64(len(fileInfos)) < n {
+		var parentNode *hashtree.NodeProto
+		if commitInfo.ParentCommit != nil {
+			parentNode, err = d.getNodeForCommit(ctx, commitInfo.ParentCommit, file.Path)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if node != nil && (parentNode == nil || !bytes.Equal(node.Hash, parentNode.Hash)) {
+			fileInfos = append(fileInfos, nodeToFileInfo(commitInfo.Commit, file.Path, node, false))
+		} else if node == nil && parentNode != nil {
+			// The file was deleted in this commit
+			fileInfos = append(fileInfos, &pfs.FileInfo{
+				File:    client.NewFile(commitInfo.Commit.Repo.Name, commitInfo.Commit.ID, file.Path),
+				Deleted: true,
+			})
+		}
+		if commitInfo.ParentCommit == nil {
+			break
+		}
+		parentCommitInfo := new(pfs.CommitInfo)
+		if err := commits.Get(commitInfo.ParentCommit.ID, parentCommitInfo); err != nil {
+			return nil, err
+		}
+		commitInfo = parentCommitInfo
+		node = parentNode
+	}
+	return fileInfos, nil
+}
+
+// getNodeForCommit returns the node at 'filePath' in the finished commit
+// 'commit', or nil if there's no such node. Nodes are cached in d.nodeCache.
+func (d *driver) getNodeForCommit(ctx context.Context, commit *pfs.Commit, filePath string) (*hashtree.NodeProto, error) {
+	// Forked repos share commit IDs, so the key includes the repo
+	key := commit.Repo.Name + "@" + commit.ID + ":" + path.Clean("/"+filePath)
+	if node, ok := d.nodeCache.Get(key); ok {
+		n, ok := node.(*hashtree.NodeProto)
+		if ok {
+			return n, nil
+		}
+		return nil, fmt.Errorf("corrupted cache: expected *hashtree.NodeProto, found %v", node)
+	}
+	tree, err := d.getTreeForCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	node, err := tree.Get(filePath)
+	if err != nil {
+		if hashtree.Code(err) != hashtree.PathNotFound {
+			return nil, err
+		}
+		node = nil
+	}
+	d.nodeCache.Add(key, node)
+	return node, nil
+}
+
 func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.File, shallow bool) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
 	// Do READER authorization check for both newFile and oldFile
 	if oldFile != nil && oldFile.Commit != nil {
@@ -1780,6 +2884,109 @@ func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.F
 	return newFileInfos, oldFileInfos, nil
 }
 
+// diffFileContent returns the changes to the contents of the files in
+// newFileInfos and oldFileInfos, which are the result of diffing newRoot and
+// oldRoot with diffFile. Files are paired up by their paths relative to
+// newRoot and oldRoot. Binary files and files larger than sizeLimit bytes are
+// skipped.
+func (d *driver) diffFileContent(ctx context.Context, newRoot string, oldRoot string, newFileInfos []*pfs.FileInfo, oldFileInfos []*pfs.FileInfo, sizeLimit uint64) ([]*pfs.FileContentDiff, error) {
+	if sizeLimit == 0 {
+		sizeLimit = defaultDiffContentSizeLimit
+	}
+	type filePair struct {
+		newFileInfo *pfs.FileInfo
+		oldFileInfo *pfs.FileInfo
+	}
+	pairs := make(map[string]*filePair)
+	var relPaths []string
+	getPair := func(root string, filePath string) *filePair {
+		relPath := strings.TrimPrefix(path.Clean("/"+filePath), path.Clean("/"+root))
+		if _, ok := pairs[relPath]; !ok {
+			pairs[relPath] = &filePair{}
+			relPaths = append(relPaths, relPath)
+		}
+		return pairs[relPath]
+	}
+	for _, fileInfo := range newFileInfos {
+		if fileInfo.FileType == pfs.FileType_FILE {
+			getPair(newRoot, fileInfo.File.Path).newFileInfo = fileInfo
+		}
+	}
+	for _, fileInfo := range oldFileInfos {
+		if fileInfo.FileType == pfs.FileType_FILE {
+			getPair(oldRoot, fileInfo.File.Path).oldFileInfo = fileInfo
+		}
+	}
+	sort.Strings(relPaths)
+
+	readFile := func(fileInfo *pfs.FileInfo) ([]byte, error) {
+		if fileInfo == nil {
+			return nil, nil
+		}
+		r, err := d.getFile(ctx, fileInfo.File, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(r)
+	}
+	var result []*pfs.FileContentDiff
+	for _, relPath := range relPaths {
+		pair := pairs[relPath]
+		if (pair.newFileInfo != nil && pair.newFileInfo.SizeBytes > sizeLimit) ||
+			(pair.oldFileInfo != nil && pair.oldFileInfo.SizeBytes > sizeLimit) {
+			continue
+		}
+		newContent, err := readFile(pair.newFileInfo)
+		if err != nil {
+			return nil, err
+		}
+		oldContent, err := readFile(pair.oldFileInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !isText(newContent) || !isText(oldContent) {
+			continue
+		}
+
+		contentDiff := &pfs.FileContentDiff{}
+		newName, oldName := "/dev/null", "/dev/null"
+		if pair.newFileInfo != nil {
+			contentDiff.NewPath = pair.newFileInfo.File.Path
+			newName = contentDiff.NewPath
+		}
+		if pair.oldFileInfo != nil {
+			contentDiff.OldPath = pair.oldFileInfo.File.Path
+			oldName = contentDiff.OldPath
+		}
+		if path.Ext(contentDiff.NewPath) == ".json" || path.Ext(contentDiff.OldPath) == ".json" {
+			// Files that don't parse as JSON get a line diff instead
+			if keyDiffs, err := diff.JSON(oldContent, newContent); err == nil {
+				for _, keyDiff := range keyDiffs {
+					contentDiff.JsonDiffs = append(contentDiff.JsonDiffs, &pfs.JSONKeyDiff{
+						Key:      keyDiff.Key,
+						OldValue: keyDiff.Old,
+						NewValue: keyDiff.New,
+					})
+				}
+				if len(contentDiff.JsonDiffs) > 0 {
+					result = append(result, contentDiff)
+				}
+				continue
+			}
+		}
+		contentDiff.UnifiedDiff = diff.Unified(oldName, newName, string(oldContent), string(newContent), 3)
+		if contentDiff.UnifiedDiff != "" {
+			result = append(result, contentDiff)
+		}
+	}
+	return result, nil
+}
+
+// isText returns true if 'data' looks like the contents of a text file
+func isText(data []byte) bool {
+	return utf8.Valid(data) && bytes.IndexByte(data, 0) < 0
+}
+
 func (d *driver) deleteFile(ctx context.Context, file *pfs.File) error {
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
@@ -1798,12 +3005,12 @@ func (d *driver) deleteFile(ctx context.Context, file *pfs.File) error {
 		return err
 	}
 
-	_, err = d.etcdClient.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), tombstone)
+	_, err = d.store.Txn(ctx, &kv.Txn{Then: []kv.Op{kv.Put(path.Join(prefix, uuid.NewWithoutDashes()), tombstone, kv.NoLease)}})
 	return err
 }
 
 func (d *driver) deleteAll(ctx context.Context) error {
-	repoInfos, err := d.listRepo(ctx, nil, false)
+	repoInfos, err := d.listRepo(ctx, nil, false, 0, "")
 	if err != nil {
 		return err
 	}
@@ -1815,7 +3022,264 @@ func (d *driver) deleteAll(ctx context.Context) error {
 	return nil
 }
 
-func (d *driver) applyWrites(resp *etcd.GetResponse, tree hashtree.OpenHashTree) error {
+// fsck checks the consistency of every repo's commits, trees and branches,
+// and calls 'cb' with each problem that it finds. If 'fix' is set, problems
+// that can be fixed without losing data are fixed: open commits missing from
+// (or finished commits left in) the openCommits collection, commit sizes that
+// don't match the commit's tree, and branches whose head doesn't exist.
+// Problems may be reported spuriously if PFS is written to during the check,
+// but each fix re-checks the problem in the transaction that fixes it.
+func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse) error) error {
+	if err := d.checkIsAdmin(ctx); err != nil {
+		return err
+	}
+	// onProblem passes a problem to 'cb', after fixing it with 'repair' if
+	// 'fix' is set and the problem can be fixed
+	onProblem := func(repair func(stm col.STM) error, format string, args ...interface{}) error {
+		response := &pfs.FsckResponse{Error: fmt.Sprintf(format, args...)}
+		if fix && repair != nil {
+			if _, err := col.NewStoreSTM(ctx, d.store, repair); err != nil {
+				return err
+			}
+			response.Fixed = true
+		}
+		return cb(response)
+	}
+
+	// Read the open commits first, so that commits started during the check
+	// are reported as missing from openCommits, which is fixed safely, rather
+	// than the reverse
+	openCommits := make(map[string]*pfs.Commit)
+	iterator, err := d.openCommits.ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	for {
+		var commitID string
+		commit := new(pfs.Commit)
+		ok, err := iterator.Next(&commitID, commit)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		openCommits[path.Base(commitID)] = commit
+	}
+
+	repoInfos, err := d.listRepo(ctx, nil, false, 0, "")
+	if err != nil {
+		return err
+	}
+	repos := make(map[string]bool)
+	for _, repoInfo := range repoInfos.RepoInfo {
+		repos[repoInfo.Repo.Name] = true
+	}
+	// objects caches whether each object referenced by a tree exists
+	objects := make(map[string]bool)
+	checkObject := func(object *pfs.Object) (bool, error) {
+		exists, ok := objects[object.Hash]
+		if !ok {
+			resp, err := d.pachClient.ObjectAPIClient.CheckObject(ctx, &pfs.CheckObjectRequest{Object: object})
+			if err != nil {
+				return false, err
+			}
+			exists = resp.Exists
+			objects[object.Hash] = exists
+		}
+		return exists, nil
+	}
+	for _, repoInfo := range repoInfos.RepoInfo {
+		repo := repoInfo.Repo
+		for _, prov := range repoInfo.Provenance {
+			if !repos[prov.Name] {
+				if err := onProblem(nil, "repo %s has provenance %s, which doesn't exist", repo.Name, prov.Name); err != nil {
+					return err
+				}
+			}
+		}
+
+		commitInfos := make(map[string]*pfs.CommitInfo)
+		var commitIDs []string
+		iterator, err := d.commits(repo.Name).ReadOnly(ctx).List()
+		if err != nil {
+			return err
+		}
+		for {
+			var commitID string
+			commitInfo := new(pfs.CommitInfo)
+			ok, err := iterator.Next(&commitID, commitInfo)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			commitInfos[commitInfo.Commit.ID] = commitInfo
+			commitIDs = append(commitIDs, commitInfo.Commit.ID)
+		}
+		sort.Strings(commitIDs)
+
+		for _, commitID := range commitIDs {
+			commitInfo := commitInfos[commitID]
+			commit := commitInfo.Commit
+			if commitInfo.ParentCommit != nil && commitInfos[commitInfo.ParentCommit.ID] == nil {
+				if err := onProblem(nil, "the parent of commit %s, %s, doesn't exist", commit.FullID(), commitInfo.ParentCommit.ID); err != nil {
+					return err
+				}
+			}
+			for _, prov := range commitInfo.Provenance {
+				if err := d.commits(prov.Repo.Name).ReadOnly(ctx).Get(prov.ID, &pfs.CommitInfo{}); err != nil {
+					if !col.IsErrNotFound(err) {
+						return err
+					}
+					if err := onProblem(nil, "commit %s has provenance %s, which doesn't exist", commit.FullID(), prov.FullID()); err != nil {
+						return err
+					}
+				}
+			}
+
+			_, isOpen := openCommits[commitID]
+			delete(openCommits, commitID)
+			if commitInfo.Finished == nil {
+				if !isOpen {
+					if err := onProblem(func(stm col.STM) error {
+						commitInfo := new(pfs.CommitInfo)
+						if err := d.commits(repo.Name).ReadWrite(stm).Get(commitID, commitInfo); err != nil {
+							return err
+						}
+						if commitInfo.Finished != nil {
+							return nil
+						}
+						return d.openCommits.ReadWrite(stm).Put(commitID, commit)
+					}, "commit %s is open, but is missing from the open commits", commit.FullID()); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if isOpen {
+				if err := onProblem(d.deleteStaleOpenCommit(repo.Name, commitID), "commit %s is finished, but is listed as open", commit.FullID()); err != nil {
+					return err
+				}
+			}
+
+			if commitInfo.Tree != nil {
+				exists, err := checkObject(commitInfo.Tree)
+				if err != nil {
+					return err
+				}
+				if !exists {
+					if err := onProblem(nil, "the tree of commit %s, object %s, is missing", commit.FullID(), commitInfo.Tree.Hash); err != nil {
+						return err
+					}
+					continue
+				}
+			}
+			tree, err := d.getTreeForCommit(ctx, commit)
+			if err != nil {
+				if err := onProblem(nil, "could not read the tree of commit %s: %v", commit.FullID(), err); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := hashtree.Verify(tree); err != nil {
+				if err := onProblem(nil, "the tree of commit %s is corrupt: %v", commit.FullID(), err); err != nil {
+					return err
+				}
+			}
+			if size := uint64(tree.FSSize()); size != commitInfo.SizeBytes {
+				if err := onProblem(func(stm col.STM) error {
+					commits := d.commits(repo.Name).ReadWrite(stm)
+					commitInfo := new(pfs.CommitInfo)
+					if err := commits.Get(commitID, commitInfo); err != nil {
+						return err
+					}
+					commitInfo.SizeBytes = size
+					return commits.Put(commitID, commitInfo)
+				}, "commit %s has size %d, but its tree has size %d", commit.FullID(), commitInfo.SizeBytes, size); err != nil {
+					return err
+				}
+			}
+			if err := tree.Walk(func(path string, node *hashtree.NodeProto) error {
+				if node.FileNode == nil {
+					return nil
+				}
+				for _, object := range node.FileNode.Objects {
+					exists, err := checkObject(object)
+					if err != nil {
+						return err
+					}
+					if !exists {
+						if err := onProblem(nil, "object %s, in file %s at commit %s, is missing", object.Hash, path, commit.FullID()); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		branchInfos, err := d.listBranch(ctx, repo)
+		if err != nil {
+			return err
+		}
+		for _, branchInfo := range branchInfos {
+			if commitInfos[branchInfo.Head.ID] != nil {
+				continue
+			}
+			name, head := branchInfo.Name, branchInfo.Head
+			if err := onProblem(func(stm col.STM) error {
+				branches := d.branches(repo.Name).ReadWrite(stm)
+				current := new(pfs.Commit)
+				if err := branches.Get(name, current); err != nil {
+					if col.IsErrNotFound(err) {
+						return nil
+					}
+					return err
+				}
+				if current.ID != head.ID {
+					return nil
+				}
+				if err := d.commits(repo.Name).ReadWrite(stm).Get(head.ID, &pfs.CommitInfo{}); err == nil || !col.IsErrNotFound(err) {
+					return err
+				}
+				return branches.Delete(name)
+			}, "the head of branch %s in repo %s, %s, doesn't exist", name, repo.Name, head.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The remaining open commits aren't the open commits of any repo
+	for commitID, commit := range openCommits {
+		if err := onProblem(d.deleteStaleOpenCommit(commit.Repo.Name, commitID), "commit %s is listed as open, but doesn't exist", commit.FullID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteStaleOpenCommit returns a function that removes 'commitID' from the
+// openCommits collection if it's finished or doesn't exist.
+func (d *driver) deleteStaleOpenCommit(repo string, commitID string) func(stm col.STM) error {
+	return func(stm col.STM) error {
+		commitInfo := new(pfs.CommitInfo)
+		if err := d.commits(repo).ReadWrite(stm).Get(commitID, commitInfo); err != nil && !col.IsErrNotFound(err) {
+			return err
+		} else if err == nil && commitInfo.Finished == nil {
+			return nil
+		}
+		if err := d.openCommits.ReadWrite(stm).Delete(commitID); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return nil
+	}
+}
+
+func (d *driver) applyWrites(resp *kv.GetResponse, tree hashtree.OpenHashTree) error {
 	for _, kv := range resp.Kvs {
 		// fileStr is going to look like "some/path/UUID"
 		fileStr := d.filePathFromEtcdPath(string(kv.Key))
@@ -1837,13 +3301,23 @@ func (d *driver) applyWrites(resp *etcd.GetResponse, tree hashtree.OpenHashTree)
 			if err := records.Unmarshal(kv.Value); err != nil {
 				return err
 			}
-			if !records.Split {
+			if records.SymlinkTarget != "" {
+				if err := tree.PutSymlink(filePath, records.SymlinkTarget); err != nil {
+					return err
+				}
+				if err := tree.SetAttrs(filePath, records.Mode, records.Mtime); err != nil {
+					return err
+				}
+			} else if !records.Split {
 				if len(records.Records) != 1 {
 					return fmt.Errorf("unexpect %d length PutFileRecord (this is likely a bug)", len(records.Records))
 				}
 				if err := tree.PutFile(filePath, []*pfs.Object{{Hash: records.Records[0].ObjectHash}}, records.Records[0].SizeBytes); err != nil {
 					return err
 				}
+				if err := tree.SetAttrs(filePath, records.Mode, records.Mtime); err != nil {
+					return err
+				}
 			} else {
 				nodes, err := tree.List(filePath)
 				if err != nil && hashtree.Code(err) != hashtree.PathNotFound {
@@ -1860,7 +3334,11 @@ func (d *driver) applyWrites(resp *etcd.GetResponse, tree hashtree.OpenHashTree)
 					indexOffset++ // start writing to the file after the last file
 				}
 				for i, record := range records.Records {
-					if err := tree.PutFile(path.Join(filePath, fmt.Sprintf(splitSuffixFmt, i+int(indexOffset))), []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
+					splitPath := path.Join(filePath, fmt.Sprintf(splitSuffixFmt, i+int(indexOffset)))
+					if err := tree.PutFile(splitPath, []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
+						return err
+					}
+					if err := tree.SetAttrs(splitPath, records.Mode, records.Mtime); err != nil {
 						return err
 					}
 				}
@@ -16,6 +16,7 @@ package server
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import google_protobuf "github.com/gogo/protobuf/types"
 
 import io "io"
 
@@ -58,6 +59,12 @@ func (m *PutFileRecord) GetObjectHash() string {
 type PutFileRecords struct {
 	Split   bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
 	Records []*PutFileRecord `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+	// mode and mtime, if set, are applied to each file written
+	Mode  uint32                     `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Mtime *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=mtime" json:"mtime,omitempty"`
+	// symlink_target is set if the record creates a symlink (in which case
+	// 'records' is empty)
+	SymlinkTarget string `protobuf:"bytes,5,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
 }
 
 func (m *PutFileRecords) Reset()                    { *m = PutFileRecords{} }
@@ -79,6 +86,27 @@ func (m *PutFileRecords) GetRecords() []*PutFileRecord {
 	return nil
 }
 
+func (m *PutFileRecords) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *PutFileRecords) GetMtime() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Mtime
+	}
+	return nil
+}
+
+func (m *PutFileRecords) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PutFileRecord)(nil), "server.PutFileRecord")
 	proto.RegisterType((*PutFileRecords)(nil), "server.PutFileRecords")
@@ -149,6 +177,27 @@ func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Mode != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDriver(dAtA, i, uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDriver(dAtA, i, uint64(m.Mtime.Size()))
+		n1, err := m.Mtime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintDriver(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
+	}
 	return i, nil
 }
 
@@ -204,6 +253,17 @@ func (m *PutFileRecords) Size() (n int) {
 			n += 1 + l + sovDriver(uint64(l))
 		}
 	}
+	if m.Mode != 0 {
+		n += 1 + sovDriver(uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		l = m.Mtime.Size()
+		n += 1 + l + sovDriver(uint64(l))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovDriver(uint64(l))
+	}
 	return n
 }
 
@@ -398,6 +458,87 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDriver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDriver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDriver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mtime == nil {
+				m.Mtime = &google_protobuf.Timestamp{}
+			}
+			if err := m.Mtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDriver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDriver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDriver(dAtA[iNdEx:])
@@ -527,17 +668,23 @@ var (
 func init() { proto.RegisterFile("server/pfs/server/driver.proto", fileDescriptorDriver) }
 
 var fileDescriptorDriver = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x2f, 0x48, 0x2b, 0xd6, 0x87, 0x32, 0x53, 0x8a, 0x32, 0xcb, 0x52, 0x8b, 0xf4,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xd8, 0x20, 0x82, 0x4a, 0x7e, 0x5c, 0xbc, 0x01, 0xa5, 0x25,
-	0x6e, 0x99, 0x39, 0xa9, 0x41, 0xa9, 0xc9, 0xf9, 0x45, 0x29, 0x42, 0xb2, 0x5c, 0x5c, 0xc5, 0x99,
-	0x55, 0xa9, 0xf1, 0x49, 0x95, 0x25, 0xa9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x9c,
-	0x20, 0x11, 0x27, 0x90, 0x80, 0x90, 0x1c, 0x17, 0x57, 0x7e, 0x52, 0x56, 0x6a, 0x72, 0x89, 0x47,
-	0x62, 0x71, 0x86, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x92, 0x88, 0x52, 0x38, 0x17, 0x1f,
-	0x8a, 0x79, 0xc5, 0x42, 0x22, 0x5c, 0xac, 0xc5, 0x05, 0x39, 0x99, 0x25, 0x60, 0xb3, 0x38, 0x82,
-	0x20, 0x1c, 0x21, 0x7d, 0x2e, 0xf6, 0x22, 0x88, 0x02, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23,
-	0x51, 0x3d, 0x88, 0x8b, 0xf4, 0x50, 0xb4, 0x07, 0xc1, 0x54, 0x39, 0x09, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81,
-	0x7d, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xda, 0xf7, 0x8b, 0x53, 0xeb, 0x00, 0x00, 0x00,
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xcd, 0x4a, 0x03, 0x31,
+	0x14, 0x85, 0x4d, 0xff, 0xb4, 0xb7, 0xb4, 0x48, 0x50, 0x08, 0x05, 0xe3, 0x50, 0x10, 0x66, 0x95,
+	0x91, 0xfa, 0x06, 0x5d, 0x88, 0x2b, 0x91, 0xd0, 0x7d, 0xe9, 0xb4, 0xb7, 0x63, 0x74, 0xc6, 0x0c,
+	0x49, 0x5a, 0xa8, 0x4f, 0xe2, 0x03, 0xb9, 0x70, 0xe9, 0x23, 0xc8, 0xf8, 0x22, 0x32, 0x49, 0x07,
+	0xec, 0xee, 0xe4, 0xe3, 0xe4, 0x70, 0x3f, 0xe0, 0x16, 0xcd, 0x0e, 0x4d, 0x52, 0x6e, 0x6c, 0x72,
+	0x88, 0x6b, 0xa3, 0x76, 0x68, 0x44, 0x69, 0xb4, 0xd3, 0xb4, 0x17, 0xe0, 0xf8, 0x3a, 0xd3, 0x3a,
+	0xcb, 0x31, 0xf1, 0x34, 0xdd, 0x6e, 0x12, 0xa7, 0x0a, 0xb4, 0x6e, 0x59, 0x94, 0xa1, 0x38, 0x79,
+	0x84, 0xe1, 0xd3, 0xd6, 0xdd, 0xab, 0x1c, 0x25, 0xae, 0xb4, 0x59, 0xd3, 0x2b, 0x00, 0xab, 0xde,
+	0x71, 0x91, 0xee, 0x1d, 0x5a, 0x46, 0x22, 0x12, 0xb7, 0x65, 0xbf, 0x26, 0xb3, 0x1a, 0x50, 0x0e,
+	0xa0, 0xd3, 0x17, 0x5c, 0xb9, 0x87, 0xa5, 0x7d, 0x66, 0xad, 0x88, 0xc4, 0x7d, 0xf9, 0x8f, 0x4c,
+	0x3e, 0x09, 0x8c, 0x8e, 0x06, 0x2d, 0xbd, 0x80, 0xae, 0x2d, 0x73, 0xe5, 0xfc, 0xd8, 0x99, 0x0c,
+	0x0f, 0x9a, 0xc0, 0xa9, 0x09, 0x05, 0xd6, 0x8a, 0xda, 0xf1, 0x60, 0x7a, 0x29, 0xc2, 0xcd, 0xe2,
+	0xe8, 0xbb, 0x6c, 0x5a, 0x94, 0x42, 0xa7, 0xd0, 0x6b, 0x64, 0xed, 0x88, 0xc4, 0x43, 0xe9, 0x33,
+	0xbd, 0x85, 0x6e, 0x51, 0x1b, 0xb1, 0x4e, 0x44, 0xe2, 0xc1, 0x74, 0x2c, 0x82, 0xae, 0x68, 0x74,
+	0xc5, 0xbc, 0xd1, 0x95, 0xa1, 0x48, 0x6f, 0x60, 0x64, 0xf7, 0x45, 0xae, 0xde, 0x5e, 0x17, 0x6e,
+	0x69, 0x32, 0x74, 0xac, 0xeb, 0x1d, 0x86, 0x07, 0x3a, 0xf7, 0x70, 0x76, 0xfe, 0x55, 0x71, 0xf2,
+	0x5d, 0x71, 0xf2, 0x53, 0x71, 0xf2, 0xf1, 0xcb, 0x4f, 0xd2, 0x9e, 0xdf, 0xbc, 0xfb, 0x1b, 0x00,
+	0x88, 0xdb, 0x75, 0x85, 0x7a, 0x01, 0x00, 0x00,
 }
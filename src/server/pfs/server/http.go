@@ -39,7 +39,7 @@ type HTTPServer struct {
 }
 
 func newHTTPServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (*HTTPServer, error) {
-	d, err := newDriver(address, etcdAddresses, etcdPrefix, cacheSize)
+	d, err := newDriver(address, etcdAddresses, etcdPrefix, cacheSize, "", 0)
 	if err != nil {
 		return nil, err
 	}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
-	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -17,6 +16,8 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 type localBlockAPIServer struct {
@@ -163,7 +164,7 @@ func (s *localBlockAPIServer) InspectObject(ctx context.Context, request *pfscli
 	fileInfo, err := os.Stat(s.objectPath(request))
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("object: %s not found", request.Hash)
+			return nil, grpc.Errorf(codes.NotFound, "object: %s not found", request.Hash)
 		}
 		return nil, err
 	}
@@ -239,6 +240,20 @@ func (s *localBlockAPIServer) Compact(ctx context.Context, request *types.Empty)
 	return &types.Empty{}, nil
 }
 
+func (s *localBlockAPIServer) InspectCompaction(ctx context.Context, request *types.Empty) (response *pfsclient.CompactionInfo, retErr error) {
+	return &pfsclient.CompactionInfo{}, nil
+}
+
+// Close is a no-op, as the local server has no goroutines to stop.
+func (s *localBlockAPIServer) Close() error {
+	return nil
+}
+
+// compactionPath is where the objBlockAPIServer checkpoints compactions.
+func (s *localBlockAPIServer) compactionPath() string {
+	return filepath.Join(s.dir, "compaction")
+}
+
 func (s *localBlockAPIServer) blockDir() string {
 	return filepath.Join(s.dir, "block")
 }
@@ -13,13 +13,14 @@ import (
 	"sync"
 	"time"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/groupcache"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -27,6 +28,8 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diskcache"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
@@ -40,6 +43,10 @@ const (
 	objectInfoCacheShares = 1
 	maxCachedObjectDenom  = 4                // We will only cache objects less than 1/maxCachedObjectDenom of total cache size
 	bufferSize            = 15 * 1024 * 1024 // 15 MB
+	// Objects that are too big for the memory caches are still read through
+	// the block cache if they're smaller than this, since they're read into
+	// memory when they're cached
+	maxBlockCacheObjectSize = 64 * 1024 * 1024 // 64 MB
 )
 
 type objBlockAPIServer struct {
@@ -54,13 +61,41 @@ type objBlockAPIServer struct {
 	objectInfoCache *groupcache.Group
 	// The total number of bytes cached for objects
 	objectCacheBytes int64
+	// blockCache, if set, caches the contents of blocks on local disk, so
+	// that objects which fall out of the memory caches needn't be read from
+	// the object store again
+	blockCache *diskcache.Cache
 	// The GC generation number.  Incrementing this number effectively
 	// invalidates all current cache.
 	generation int
 	genLock    sync.RWMutex
 
-	objectIndexes     map[string]*pfsclient.ObjectIndex
+	objectIndexes     map[string]*cachedObjectIndex
 	objectIndexesLock sync.RWMutex
+
+	// store holds the GC generation, the compaction lock and garbage
+	// collection's write barrier. If it isn't set, it's connected to etcd at
+	// etcdAddress when it's first needed.
+	etcdAddress string
+	store       kv.Store
+	storeLock   sync.Mutex
+
+	compactionOptions CompactionOptions
+	prefetchOptions   PrefetchOptions
+	// compactionMu ensures that this server only runs one compaction at a
+	// time, and dlock ensures that only one server does
+	compactionMu sync.Mutex
+
+	// done is closed by Close(), to stop the server's goroutines
+	done chan struct{}
+}
+
+// cachedObjectIndex is an index that was read in a given GC generation.
+// Indexes change when objects are compacted or deleted, both of which
+// increment the generation, so indexes cached in older generations are stale.
+type cachedObjectIndex struct {
+	index      *pfsclient.ObjectIndex
+	generation int
 }
 
 func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objClient obj.Client) (*objBlockAPIServer, error) {
@@ -78,8 +113,10 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 		dir:              dir,
 		localServer:      localServer,
 		objClient:        objClient,
-		objectIndexes:    make(map[string]*pfsclient.ObjectIndex),
+		objectIndexes:    make(map[string]*cachedObjectIndex),
 		objectCacheBytes: oneCacheShare * objectCacheShares,
+		etcdAddress:      etcdAddress,
+		done:             make(chan struct{}),
 	}
 	s.objectCache = groupcache.NewGroup("object", oneCacheShare*objectCacheShares, groupcache.GetterFunc(s.objectGetter))
 	s.tagCache = groupcache.NewGroup("tag", oneCacheShare*tagCacheShares, groupcache.GetterFunc(s.tagGetter))
@@ -88,30 +125,39 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 	// TODO: make the stats accessible via HTTP or gRPC.
 	go func() {
 		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
 		for {
-			<-ticker.C
+			select {
+			case <-ticker.C:
+			case <-s.done:
+				return
+			}
 			logrus.Infof("objectCache stats: %+v", s.objectCache.Stats)
 			logrus.Infof("tagCache stats: %+v", s.tagCache.Stats)
 			logrus.Infof("objectInfoCache stats: %+v", s.objectInfoCache.Stats)
 		}
 	}()
-	go s.watchGC(etcdAddress)
 	return s, nil
 }
 
-// watchGC watches for GC runs and invalidate all cache when GC happens.
-func (s *objBlockAPIServer) watchGC(etcdAddress string) {
+// Close stops the server's goroutines that log cache stats and schedule
+// compactions. The server can still serve requests afterwards.
+func (s *objBlockAPIServer) Close() error {
+	close(s.done)
+	return nil
+}
+
+// watchGC watches for GC runs and invalidate all cache when GC happens. It's
+// started once the server's store is set.
+func (s *objBlockAPIServer) watchGC() {
 	b := backoff.NewInfiniteBackOff()
 	backoff.RetryNotify(func() error {
-		etcdClient, err := etcd.New(etcd.Config{
-			Endpoints:   []string{etcdAddress},
-			DialOptions: client.EtcdDialOptions(),
-		})
+		store, err := s.getStore()
 		if err != nil {
-			return fmt.Errorf("error instantiating etcd client: %v", err)
+			return err
 		}
 
-		watcher, err := watch.NewWatcher(context.Background(), etcdClient, client.GCGenerationKey)
+		watcher, err := watch.NewStoreWatcher(context.Background(), store, client.GCGenerationKey)
 		if err != nil {
 			return fmt.Errorf("error instantiating watch stream from generation number: %v", err)
 		}
@@ -183,6 +229,28 @@ func newMicrosoftBlockAPIServer(dir string, cacheBytes int64, etcdAddress string
 	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
 }
 
+func newLocalObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
+	// The block server names objects by their absolute paths under 'dir', so
+	// the client is rooted at "/"
+	objClient, err := obj.NewLocalClient("/")
+	if err != nil {
+		return nil, err
+	}
+	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+}
+
+func newMirrorBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, mirrorURL string) (*objBlockAPIServer, error) {
+	url, err := obj.ParseURL(mirrorURL)
+	if err != nil {
+		return nil, err
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(context.Background(), url)
+	if err != nil {
+		return nil, err
+	}
+	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+}
+
 func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer) (retErr error) {
 	func() { s.Log(nil, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
@@ -224,7 +292,16 @@ func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer
 	if err != nil {
 		return err
 	}
-	if resp.Exists {
+	exists := resp.Exists
+	if exists {
+		// Make sure that garbage collection doesn't delete the existing
+		// object now that it's being referenced again
+		exists, err = s.touchObject(server.Context(), object)
+		if err != nil {
+			return err
+		}
+	}
+	if exists {
 		// the object already exists so we delete the block we put
 		eg.Go(func() error {
 			return s.objClient.Delete(s.localServer.blockPath(block))
@@ -251,6 +328,57 @@ func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer
 	return eg.Wait()
 }
 
+// touchObject records that 'object', which already exists, has been put
+// again, so that garbage collection doesn't delete it. If garbage collection
+// may already be deleting the object, touchObject waits until it's done, and
+// returns whether the object still exists.
+func (s *objBlockAPIServer) touchObject(ctx context.Context, object *pfsclient.Object) (bool, error) {
+	store, err := s.getStore()
+	if err != nil {
+		return false, err
+	}
+	// The touch is written before the deleting key is read, and garbage
+	// collection writes the deleting key before it reads the touch, so at
+	// least one of them sees the other
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if _, err := store.Txn(ctx, &kv.Txn{
+		Then: []kv.Op{kv.Put(path.Join(client.GCTouchedPrefix, object.Hash), now, kv.NoLease)},
+	}); err != nil {
+		return false, err
+	}
+	deletingKey := path.Join(client.GCDeletingPrefix, object.Hash)
+	for {
+		resp, err := store.Get(ctx, deletingKey, kv.GetOptions{CountOnly: true})
+		if err != nil {
+			return false, err
+		}
+		if resp.Count == 0 {
+			break
+		}
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+	return s.objectExists(object)
+}
+
+// objectExists returns true if 'object' exists, either in its own file or, if
+// it's been compacted, in an index. The index is always read again, since a
+// cached one may be stale.
+func (s *objBlockAPIServer) objectExists(object *pfsclient.Object) (bool, error) {
+	if s.objClient.Exists(s.localServer.objectPath(object)) {
+		return true, nil
+	}
+	objectIndex, err := s.readObjectIndex(indexPrefix(object.Hash))
+	if err != nil {
+		return false, err
+	}
+	_, ok := objectIndex.Objects[object.Hash]
+	return ok, nil
+}
+
 func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer pfsclient.ObjectAPI_GetObjectServer) (retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
@@ -263,6 +391,13 @@ func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer
 	if (objectSize) >= uint64(s.objectCacheBytes/maxCachedObjectDenom) {
 		// The object is a substantial portion of the available cache space so
 		// we bypass the cache and stream it directly out of the underlying store.
+		if s.blockCacheable(objectSize) {
+			var data []byte
+			if err := s.readBlockRef(objectInfo.BlockRef, groupcache.AllocatingByteSliceSink(&data)); err != nil {
+				return err
+			}
+			return grpcutil.WriteToStreamingBytesServer(bytes.NewReader(data), getObjectServer)
+		}
 		blockPath := s.localServer.blockPath(objectInfo.BlockRef.Block)
 		r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower, objectSize)
 		if err != nil {
@@ -281,60 +416,40 @@ func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer
 func (s *objBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, getObjectsServer pfsclient.ObjectAPI_GetObjectsServer) (retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
-	offset := request.OffsetBytes
-	size := request.SizeBytes
-	for _, object := range request.Objects {
-		// First we inspect the object to see how big it is.
-		objectInfo, err := s.InspectObject(getObjectsServer.Context(), object)
-		if err != nil {
-			return err
-		}
-		if objectInfo == nil {
-			logrus.Debugf("objectInfo is nil; info: %+v; request: %v", objectInfo, request)
-		} else if objectInfo.BlockRef == nil {
-			logrus.Debugf("objectInfo.BlockRef is nil; info: %+v; request: %v", objectInfo, request)
-		} else if objectInfo.BlockRef.Range == nil {
-			logrus.Debugf("objectInfo.BlockRef.Range is nil; info: %+v; request: %v", objectInfo, request)
-		}
-
-		objectSize := objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
-		if offset > objectSize {
-			offset -= objectSize
-			continue
+	// Objects are read ahead of time, and concurrently, but sent in order
+	ctx, cancel := context.WithCancel(getObjectsServer.Context())
+	defer cancel()
+	for f := range s.prefetchObjects(ctx, request.Objects, request.OffsetBytes, request.SizeBytes) {
+		<-f.done
+		if f.err != nil {
+			return f.err
 		}
-		readSize := objectSize - offset
-		if size < readSize && request.SizeBytes != 0 {
-			readSize = size
-		}
-		if s.objectCacheBytes == 0 || (objectSize) > uint64(s.objectCacheBytes/maxCachedObjectDenom) {
-			// The object is a substantial portion of the available cache space so
-			// we bypass the cache and stream it directly out of the underlying store.
-			blockPath := s.localServer.blockPath(objectInfo.BlockRef.Block)
-			r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower+offset, readSize)
-			if err != nil {
+		objectInfo := f.objectInfo
+		if f.stream {
+			if err := func() (retErr error) {
+				blockPath := s.localServer.blockPath(objectInfo.BlockRef.Block)
+				r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower+f.offset, f.size)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := r.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				return grpcutil.WriteToStreamingBytesServer(r, getObjectsServer)
+			}(); err != nil {
 				return err
 			}
-			return grpcutil.WriteToStreamingBytesServer(r, getObjectsServer)
-		}
-		var data []byte
-		sink := groupcache.AllocatingByteSliceSink(&data)
-		if err := s.objectCache.Get(getObjectsServer.Context(), s.splitKey(object.Hash), sink); err != nil {
-			return err
-		}
-		if uint64(len(data)) < offset+readSize {
-			return fmt.Errorf("undersized object (this is likely a bug)")
-		}
-		if err := grpcutil.WriteToStreamingBytesServer(bytes.NewReader(data[offset:offset+readSize]), getObjectsServer); err != nil {
-			return err
-		}
-		// We've hit the offset so we set it to 0
-		offset = 0
-		if request.SizeBytes != 0 {
-			size -= readSize
-			if size == 0 {
-				break
+		} else {
+			if uint64(len(f.data)) < f.offset+f.size {
+				return fmt.Errorf("undersized object (this is likely a bug)")
+			}
+			if err := grpcutil.WriteToStreamingBytesServer(bytes.NewReader(f.data[f.offset:f.offset+f.size]), getObjectsServer); err != nil {
+				return err
 			}
 		}
+		f.release()
 	}
 	return nil
 }
@@ -370,6 +485,11 @@ func (s *objBlockAPIServer) InspectObject(ctx context.Context, request *pfsclien
 	objectInfo := &pfsclient.ObjectInfo{}
 	sink := groupcache.ProtoSink(objectInfo)
 	if err := s.objectInfoCache.Get(ctx, s.splitKey(request.Hash), sink); err != nil {
+		// Errors from other pachds' caches lose their types, so whether the
+		// object exists is checked again here
+		if exists, existsErr := s.objectExists(request); existsErr == nil && !exists {
+			return nil, grpc.Errorf(codes.NotFound, "object %s not found", request.Hash)
+		}
 		return nil, err
 	}
 	return objectInfo, nil
@@ -379,8 +499,12 @@ func (s *objBlockAPIServer) CheckObject(ctx context.Context, request *pfsclient.
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
+	exists, err := s.objectExists(request.Object)
+	if err != nil {
+		return nil, err
+	}
 	return &pfsclient.CheckObjectResponse{
-		Exists: s.objClient.Exists(s.localServer.objectPath(request.Object)),
+		Exists: exists,
 	}, nil
 }
 
@@ -388,8 +512,41 @@ func (s *objBlockAPIServer) ListObjects(request *pfsclient.ListObjectsRequest, l
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 
+	// Compacted objects are in the indexes and the rest are in their own
+	// files. An object whose compaction was interrupted is in both, and is
+	// only sent once.
+	prefixes, err := s.indexPrefixes()
+	if err != nil {
+		return err
+	}
+	indexed := make(map[string]bool)
+	for _, prefix := range prefixes {
+		indexed[prefix] = true
+		objectIndex, err := s.readObjectIndex(prefix)
+		if err != nil {
+			return err
+		}
+		for hash := range objectIndex.Objects {
+			if err := listObjectsServer.Send(&pfsclient.Object{Hash: hash}); err != nil {
+				return err
+			}
+		}
+		if err := s.objClient.Walk(s.objectPrefix(prefix), func(key string) error {
+			hash := filepath.Base(key)
+			if _, ok := objectIndex.Objects[hash]; ok || indexPrefix(hash) != prefix {
+				return nil
+			}
+			return listObjectsServer.Send(&pfsclient.Object{Hash: hash})
+		}); err != nil {
+			return err
+		}
+	}
 	return s.objClient.Walk(s.localServer.objectDir(), func(key string) error {
-		return listObjectsServer.Send(&pfsclient.Object{filepath.Base(key)})
+		hash := filepath.Base(key)
+		if indexed[indexPrefix(hash)] {
+			return nil
+		}
+		return listObjectsServer.Send(&pfsclient.Object{Hash: hash})
 	})
 }
 
@@ -397,11 +554,63 @@ func (s *objBlockAPIServer) ListTags(request *pfsclient.ListTagsRequest, server
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 
+	// Compacted tags are in the indexes and the rest are in their own files,
+	// as with objects (see ListObjects)
+	prefixes, err := s.indexPrefixes()
+	if err != nil {
+		return err
+	}
+	indexed := make(map[string]bool)
+	for _, prefix := range prefixes {
+		if !strings.HasPrefix(prefix, request.Prefix) && !strings.HasPrefix(request.Prefix, prefix) {
+			continue
+		}
+		indexed[prefix] = true
+		objectIndex, err := s.readObjectIndex(prefix)
+		if err != nil {
+			return err
+		}
+		for tag, object := range objectIndex.Tags {
+			if !strings.HasPrefix(tag, request.Prefix) {
+				continue
+			}
+			if request.IncludeObject {
+				server.Send(&pfsclient.ListTagsResponse{
+					Tag:    tag,
+					Object: object,
+				})
+			}
+			server.Send(&pfsclient.ListTagsResponse{
+				Tag: tag,
+			})
+		}
+		walkPrefix := prefix
+		if len(request.Prefix) > len(walkPrefix) {
+			walkPrefix = request.Prefix
+		}
+		if err := s.listTagFiles(s.tagPrefix(walkPrefix), request.IncludeObject, server, func(tag string) bool {
+			_, ok := objectIndex.Tags[tag]
+			return !ok && indexPrefix(tag) == prefix
+		}); err != nil {
+			return err
+		}
+	}
+	return s.listTagFiles(path.Join(s.localServer.tagDir(), request.Prefix), request.IncludeObject, server, func(tag string) bool {
+		return !indexed[indexPrefix(tag)]
+	})
+}
+
+// listTagFiles sends the tags that have their own files under 'prefix', and
+// for which 'include' returns true, to 'server'.
+func (s *objBlockAPIServer) listTagFiles(prefix string, includeObject bool, server pfsclient.ObjectAPI_ListTagsServer, include func(tag string) bool) error {
 	var eg errgroup.Group
 	limiter := limit.New(100)
-	s.objClient.Walk(path.Join(s.localServer.tagDir(), request.Prefix), func(key string) error {
+	s.objClient.Walk(prefix, func(key string) error {
 		tag := filepath.Base(key)
-		if request.IncludeObject {
+		if !include(tag) {
+			return nil
+		}
+		if includeObject {
 			limiter.Acquire()
 			eg.Go(func() error {
 				defer limiter.Release()
@@ -430,21 +639,34 @@ func (s *objBlockAPIServer) DeleteTags(ctx context.Context, request *pfsclient.D
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	limiter := limit.New(100)
-	var eg errgroup.Group
-	for _, tag := range request.Tags {
-		tag := tag
-		limiter.Acquire()
-		eg.Go(func() error {
-			defer limiter.Release()
-			tagPath := s.localServer.tagPath(&pfsclient.Tag{tag})
-			if err := s.objClient.Delete(tagPath); err != nil && !s.isNotFoundErr(err) {
-				return err
+	if err := s.withIndexLock(ctx, func(ctx context.Context) error {
+		limiter := limit.New(100)
+		var eg errgroup.Group
+		for _, tag := range request.Tags {
+			tag := tag
+			limiter.Acquire()
+			eg.Go(func() error {
+				defer limiter.Release()
+				tagPath := s.localServer.tagPath(&pfsclient.Tag{tag})
+				if err := s.objClient.Delete(tagPath); err != nil && !s.isNotFoundErr(err) {
+					return err
+				}
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+		// Compacted tags are removed from their indexes
+		_, err := s.removeFromIndexes(request.Tags, func(objectIndex *pfsclient.ObjectIndex, tag string) bool {
+			if _, ok := objectIndex.Tags[tag]; !ok {
+				return false
 			}
-			return nil
+			delete(objectIndex.Tags, tag)
+			return true
 		})
-	}
-	if err := eg.Wait(); err != nil {
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
@@ -467,38 +689,158 @@ func (s *objBlockAPIServer) DeleteObjects(ctx context.Context, request *pfsclien
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
+	// deleted holds the hashes of the deleted objects, and freed is the
+	// number of bytes deleted from the object store: the blocks that were
+	// written for single objects, and the blocks that compaction wrote once
+	// none of the objects in them are left
+	var mu sync.Mutex
+	deleted := make(map[string]bool)
+	var freed uint64
+	recordDeleted := func(hash string, blockBytes uint64) {
+		mu.Lock()
+		defer mu.Unlock()
+		deleted[hash] = true
+		freed += blockBytes
+	}
+	if err := s.withIndexLock(ctx, func(ctx context.Context) error {
+		limiter := limit.New(100)
+		var eg errgroup.Group
+		for _, object := range request.Objects {
+			object := object
+			limiter.Acquire()
+			eg.Go(func() error {
+				defer limiter.Release()
+				// The block named by the object's own file was written for
+				// the object alone, so it's deleted along with the file
+				objPath := s.localServer.objectPath(object)
+				blockRef := &pfsclient.BlockRef{}
+				if err := s.readProto(objPath, blockRef); err != nil {
+					if s.isNotFoundErr(err) {
+						return nil
+					}
+					return err
+				}
+
+				if err := s.objClient.Delete(objPath); err != nil && !s.isNotFoundErr(err) {
+					return err
+				}
+
+				if blockRef.Block != nil {
+					blockPath := s.localServer.blockPath(blockRef.Block)
+					if err := s.objClient.Delete(blockPath); err != nil && !s.isNotFoundErr(err) {
+						return err
+					}
+				}
+				var size uint64
+				if blockRef.Range != nil {
+					size = blockRef.Range.Upper - blockRef.Range.Lower
+				}
+				recordDeleted(object.Hash, size)
+
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+		// Compacted objects are removed from their indexes, and the blocks
+		// that they share with other objects are deleted once none of the
+		// objects in them are left
+		hashes := make([]string, len(request.Objects))
+		for i, object := range request.Objects {
+			hashes[i] = object.Hash
+		}
+		removed, err := s.removeFromIndexes(hashes, func(objectIndex *pfsclient.ObjectIndex, hash string) bool {
+			if _, ok := objectIndex.Objects[hash]; !ok {
+				return false
+			}
+			delete(objectIndex.Objects, hash)
+			recordDeleted(hash, 0)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		blockBytes, err := s.releaseBlockRefs(ctx, removed)
+		if err != nil {
+			return err
+		}
+		freed += blockBytes
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	response = &pfsclient.DeleteObjectsResponse{Bytes: freed}
+	for _, object := range request.Objects {
+		if !deleted[object.Hash] {
+			continue
+		}
+		// Objects that were requested more than once are only reported once
+		delete(deleted, object.Hash)
+		response.Objects = append(response.Objects, object)
+	}
+	return response, nil
+}
+
+// removeFromIndexes calls 'remove' on the index of each of 'keys', which
+// removes the key from the index if it's there and returns whether it was.
+// The indexes that change are rewritten. It returns the blocks that the
+// removed objects were in, with the number of objects removed from each. The
+// caller must hold the index lock (see withIndexLock).
+func (s *objBlockAPIServer) removeFromIndexes(keys []string, remove func(objectIndex *pfsclient.ObjectIndex, key string) bool) (map[string]int64, error) {
+	byPrefix := make(map[string][]string)
+	for _, key := range keys {
+		prefix := indexPrefix(key)
+		byPrefix[prefix] = append(byPrefix[prefix], key)
+	}
+	var mu sync.Mutex
+	removed := make(map[string]int64)
 	limiter := limit.New(100)
 	var eg errgroup.Group
-	for _, object := range request.Objects {
-		object := object
+	for prefix, keys := range byPrefix {
+		prefix, keys := prefix, keys
 		limiter.Acquire()
 		eg.Go(func() error {
 			defer limiter.Release()
-			objectInfo, err := s.InspectObject(ctx, object)
-			if err != nil && !s.isNotFoundErr(err) {
+			objectIndex := &pfsclient.ObjectIndex{}
+			if err := s.readProto(s.localServer.indexPath(prefix), objectIndex); err != nil {
+				if s.isNotFoundErr(err) {
+					return nil
+				}
 				return err
 			}
-
-			objPath := s.localServer.objectPath(object)
-			if err := s.objClient.Delete(objPath); err != nil && !s.isNotFoundErr(err) {
+			changed := false
+			var blocks []string
+			for _, key := range keys {
+				blockRef := objectIndex.Objects[key]
+				if !remove(objectIndex, key) {
+					continue
+				}
+				changed = true
+				if blockRef != nil && blockRef.Block != nil {
+					blocks = append(blocks, blockRef.Block.Hash)
+				}
+			}
+			if !changed {
+				return nil
+			}
+			if err := s.writeProto(s.localServer.indexPath(prefix), objectIndex); err != nil {
 				return err
 			}
-
-			if objectInfo != nil && objectInfo.BlockRef != nil && objectInfo.BlockRef.Block != nil {
-				blockPath := s.localServer.blockPath(objectInfo.BlockRef.Block)
-				if err := s.objClient.Delete(blockPath); err != nil && !s.isNotFoundErr(err) {
-					return err
-				}
+			s.setObjectIndex(prefix, objectIndex, s.getGeneration())
+			mu.Lock()
+			defer mu.Unlock()
+			for _, block := range blocks {
+				removed[block]++
 			}
-
 			return nil
 		})
 	}
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
-
-	return &pfsclient.DeleteObjectsResponse{}, nil
+	return removed, nil
 }
 
 func (s *objBlockAPIServer) GetTag(request *pfsclient.Tag, getTagServer pfsclient.ObjectAPI_GetTagServer) (retErr error) {
@@ -526,12 +868,18 @@ func (s *objBlockAPIServer) InspectTag(ctx context.Context, request *pfsclient.T
 func (s *objBlockAPIServer) Compact(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	if err := s.compact(); err != nil {
+	if err := s.compact(ctx); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
 }
 
+func (s *objBlockAPIServer) InspectCompaction(ctx context.Context, request *types.Empty) (response *pfsclient.CompactionInfo, retErr error) {
+	func() { s.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return s.readCompactionInfo()
+}
+
 func (s *objBlockAPIServer) objectPrefix(prefix string) string {
 	return s.localServer.objectPath(&pfsclient.Object{Hash: prefix})
 }
@@ -540,126 +888,6 @@ func (s *objBlockAPIServer) tagPrefix(prefix string) string {
 	return s.localServer.tagPath(&pfsclient.Tag{Name: prefix})
 }
 
-func (s *objBlockAPIServer) compact() (retErr error) {
-	w, err := s.newBlockWriter(&pfsclient.Block{Hash: uuid.NewWithoutDashes()})
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err := w.Close(); err != nil && retErr == nil {
-			retErr = err
-		}
-	}()
-	var mu sync.Mutex
-	var eg errgroup.Group
-	objectIndex := &pfsclient.ObjectIndex{
-		Objects: make(map[string]*pfsclient.BlockRef),
-		Tags:    make(map[string]*pfsclient.Object),
-	}
-	var toDelete []string
-	eg.Go(func() error {
-		return s.objClient.Walk(s.localServer.objectDir(), func(name string) error {
-			eg.Go(func() (retErr error) {
-				blockRef := &pfsclient.BlockRef{}
-				if err := s.readProto(name, blockRef); err != nil {
-					return err
-				}
-				blockPath := s.localServer.blockPath(blockRef.Block)
-				r, err := s.objClient.Reader(blockPath, blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower)
-				if err != nil {
-					return err
-				}
-				defer func() {
-					if err := r.Close(); err != nil && retErr == nil {
-						retErr = err
-					}
-				}()
-				object, err := ioutil.ReadAll(r)
-				if err != nil {
-					return err
-				}
-				blockRef, err = w.Write(object)
-				if err != nil {
-					return err
-				}
-				mu.Lock()
-				defer mu.Unlock()
-				objectIndex.Objects[filepath.Base(name)] = blockRef
-				toDelete = append(toDelete, name, blockPath)
-				return nil
-			})
-			return nil
-		})
-	})
-	eg.Go(func() error {
-		return s.objClient.Walk(s.localServer.tagDir(), func(name string) error {
-			eg.Go(func() error {
-				tagObjectIndex := &pfsclient.ObjectIndex{}
-				if err := s.readProto(name, tagObjectIndex); err != nil {
-					return err
-				}
-				mu.Lock()
-				defer mu.Unlock()
-				for tag, object := range tagObjectIndex.Tags {
-					objectIndex.Tags[tag] = object
-				}
-				toDelete = append(toDelete, name)
-				return nil
-			})
-			return nil
-		})
-	})
-	if err := eg.Wait(); err != nil {
-		return err
-	}
-	prefixes := make(map[string]bool)
-	for hash := range objectIndex.Objects {
-		prefixes[hash[:2]] = true
-	}
-	for tag := range objectIndex.Tags {
-		p := tag
-		if len(p) > 2 {
-			p = p[:2]
-		}
-		prefixes[p] = true
-	}
-	eg = errgroup.Group{}
-	for prefix := range prefixes {
-		prefix := prefix
-		eg.Go(func() error {
-			prefixObjectIndex := &pfsclient.ObjectIndex{
-				Objects: make(map[string]*pfsclient.BlockRef),
-				Tags:    make(map[string]*pfsclient.Object),
-			}
-			if err := s.readProto(s.localServer.indexPath(prefix), prefixObjectIndex); err != nil && !s.isNotFoundErr(err) {
-				return err
-			}
-			for hash, blockRef := range objectIndex.Objects {
-				if strings.HasPrefix(hash, prefix) {
-					prefixObjectIndex.Objects[hash] = blockRef
-				}
-			}
-			for tag, object := range objectIndex.Tags {
-				if strings.HasPrefix(tag, prefix) {
-					prefixObjectIndex.Tags[tag] = object
-				}
-			}
-			return s.writeProto(s.localServer.indexPath(prefix), prefixObjectIndex)
-		})
-	}
-	if err := eg.Wait(); err != nil {
-		return err
-	}
-	eg = errgroup.Group{}
-	for _, file := range toDelete {
-		file := file
-		eg.Go(func() error {
-			return s.objClient.Delete(file)
-		})
-	}
-	return eg.Wait()
-}
-
 func (s *objBlockAPIServer) readProto(path string, pb proto.Unmarshaler) (retErr error) {
 	r, err := s.objClient.Reader(path, 0, 0)
 	if err != nil {
@@ -715,13 +943,14 @@ func (s *objBlockAPIServer) tagGetter(ctx groupcache.Context, key string, dest g
 	var updated bool
 	// First check if we already have the index for this Tag in memory, if
 	// not read it for the first time.
-	if _, ok := s.getObjectIndex(prefix); !ok {
+	objectIndex, ok := s.getObjectIndex(prefix)
+	if !ok {
 		updated = true
-		if err := s.readObjectIndex(prefix); err != nil {
+		var err error
+		if objectIndex, err = s.readObjectIndex(prefix); err != nil {
 			return err
 		}
 	}
-	objectIndex, _ := s.getObjectIndex(prefix)
 	// Check if the index contains the tag we're looking for, if so read
 	// it into the cache and return
 	if object, ok := objectIndex.Tags[tag.Name]; ok {
@@ -744,10 +973,10 @@ func (s *objBlockAPIServer) tagGetter(ctx groupcache.Context, key string, dest g
 	// The last chance to find this object is to update the index since the
 	// object may have been recently incorporated into it.
 	if len(splitKey) == 3 && !updated {
-		if err := s.readObjectIndex(prefix); err != nil {
+		objectIndex, err := s.readObjectIndex(prefix)
+		if err != nil {
 			return err
 		}
-		objectIndex, _ = s.getObjectIndex(prefix)
 		if object, ok := objectIndex.Tags[tag.Name]; ok {
 			dest.SetProto(object)
 			return nil
@@ -767,13 +996,14 @@ func (s *objBlockAPIServer) objectInfoGetter(ctx groupcache.Context, key string,
 	updated := false
 	// First check if we already have the index for this Object in memory, if
 	// not read it for the first time.
-	if _, ok := s.getObjectIndex(prefix); !ok {
+	objectIndex, ok := s.getObjectIndex(prefix)
+	if !ok {
 		updated = true
-		if err := s.readObjectIndex(prefix); err != nil {
+		var err error
+		if objectIndex, err = s.readObjectIndex(prefix); err != nil {
 			return err
 		}
 	}
-	objectIndex, _ := s.getObjectIndex(prefix)
 	// Check if the index contains a the object we're looking for, if so read
 	// it into the cache and return
 	if blockRef, ok := objectIndex.Objects[object.Hash]; ok {
@@ -796,10 +1026,10 @@ func (s *objBlockAPIServer) objectInfoGetter(ctx groupcache.Context, key string,
 	// The last chance to find this object is to update the index since the
 	// object may have been recently incorporated into it.
 	if !updated {
-		if err := s.readObjectIndex(prefix); err != nil {
+		objectIndex, err := s.readObjectIndex(prefix)
+		if err != nil {
 			return err
 		}
-		objectIndex, _ := s.getObjectIndex(prefix)
 		if blockRef, ok := objectIndex.Objects[object.Hash]; ok {
 			result.BlockRef = blockRef
 			dest.SetProto(result)
@@ -840,34 +1070,95 @@ func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, des
 	return dest.SetBytes(data)
 }
 
+// readBlockRef reads the range of a block referred to by 'blockRef' into
+// 'dest', from the block cache if it's there.
 func (s *objBlockAPIServer) readBlockRef(blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
-	return s.readObj(s.localServer.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
+	path := s.localServer.blockPath(blockRef.Block)
+	size := blockRef.Range.Upper - blockRef.Range.Lower
+	if s.blockCache == nil {
+		return s.readObj(path, blockRef.Range.Lower, size, dest)
+	}
+	// Blocks are never modified once they're written, so cached ranges of
+	// them never go stale
+	key := fmt.Sprintf("%s.%d.%d", blockRef.Block.Hash, blockRef.Range.Lower, blockRef.Range.Upper)
+	if data, ok := s.blockCache.Get(key); ok {
+		return dest.SetBytes(data)
+	}
+	var data []byte
+	if err := s.readObj(path, blockRef.Range.Lower, size, groupcache.AllocatingByteSliceSink(&data)); err != nil {
+		return err
+	}
+	// Failing to cache isn't fatal, so errors are only logged
+	if err := s.blockCache.Put(key, data); err != nil {
+		logrus.Errorf("could not cache block %s on disk: %v", blockRef.Block.Hash, err)
+	}
+	return dest.SetBytes(data)
 }
 
+// blockCacheable returns true if objects of size 'objectSize', which are too
+// big for the memory caches, should be read through the block cache.
+func (s *objBlockAPIServer) blockCacheable(objectSize uint64) bool {
+	return s.blockCache != nil && objectSize < maxBlockCacheObjectSize
+}
+
+// getObjectIndex returns the cached index for 'prefix', if it was cached in
+// the current GC generation.
 func (s *objBlockAPIServer) getObjectIndex(prefix string) (*pfsclient.ObjectIndex, bool) {
 	s.objectIndexesLock.RLock()
 	defer s.objectIndexesLock.RUnlock()
-	index, ok := s.objectIndexes[prefix]
-	return index, ok
+	cached, ok := s.objectIndexes[prefix]
+	if !ok || cached.generation != s.getGeneration() {
+		return nil, false
+	}
+	return cached.index, true
 }
 
-func (s *objBlockAPIServer) setObjectIndex(prefix string, index *pfsclient.ObjectIndex) {
+func (s *objBlockAPIServer) setObjectIndex(prefix string, index *pfsclient.ObjectIndex, generation int) {
 	s.objectIndexesLock.Lock()
 	defer s.objectIndexesLock.Unlock()
-	s.objectIndexes[prefix] = index
+	s.objectIndexes[prefix] = &cachedObjectIndex{
+		index:      index,
+		generation: generation,
+	}
 }
 
-func (s *objBlockAPIServer) readObjectIndex(prefix string) error {
+// readObjectIndex reads the index for 'prefix' from the object store, caches
+// it and returns it.
+func (s *objBlockAPIServer) readObjectIndex(prefix string) (*pfsclient.ObjectIndex, error) {
+	// The generation is read first, so that if it changes while the index is
+	// being read, the cached index is already stale
+	generation := s.getGeneration()
 	objectIndex := &pfsclient.ObjectIndex{}
 	if err := s.readProto(s.localServer.indexPath(prefix), objectIndex); err != nil && !s.isNotFoundErr(err) {
-		return err
+		return nil, err
 	}
 	// Note that we only return the error above if it's something other than a
 	// NonExist error, in the case of a NonExist error we'll put a blank index
 	// in the map. This prevents us from having requesting an index that
 	// doesn't exist everytime a request tries to access it.
-	s.setObjectIndex(prefix, objectIndex)
-	return nil
+	s.setObjectIndex(prefix, objectIndex, generation)
+	return objectIndex, nil
+}
+
+// indexPrefixes returns the prefixes of the indexes that exist.
+func (s *objBlockAPIServer) indexPrefixes() ([]string, error) {
+	var prefixes []string
+	if err := s.objClient.Walk(s.localServer.indexDir(), func(key string) error {
+		prefixes = append(prefixes, filepath.Base(key))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return prefixes, nil
+}
+
+// indexPrefix returns the prefix of the index that the object or tag 'key' is
+// compacted into.
+func indexPrefix(key string) string {
+	if len(key) < prefixLength {
+		return key
+	}
+	return key[:prefixLength]
 }
 
 // splitKey splits a key into the format we want, and also postpends
@@ -0,0 +1,268 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/groupcache"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+const (
+	defaultPrefetchParallelism = 16
+	defaultPrefetchWindowBytes = 256 * 1024 * 1024
+	// maxPrefetchObjects bounds the number of objects that are read ahead,
+	// however small they are
+	maxPrefetchObjects = 1024
+)
+
+// PrefetchOptions configures how a BlockAPIServer reads ahead the objects
+// requested by GetObjects.
+type PrefetchOptions struct {
+	// Parallelism is the number of objects that are inspected and read
+	// concurrently. If it's 0, a default is used.
+	Parallelism int
+	// WindowBytes is the number of bytes of objects that are read ahead of
+	// the one being sent. An object that's bigger than the window is read
+	// once the objects before it have been sent. If it's 0, a default is
+	// used.
+	WindowBytes int64
+}
+
+func (o PrefetchOptions) parallelism() int {
+	if o.Parallelism <= 0 {
+		return defaultPrefetchParallelism
+	}
+	return o.Parallelism
+}
+
+func (o PrefetchOptions) windowBytes() uint64 {
+	if o.WindowBytes <= 0 {
+		return defaultPrefetchWindowBytes
+	}
+	return uint64(o.WindowBytes)
+}
+
+// objectInspection is an object whose info is being read. Its info is set
+// once 'done' is closed.
+type objectInspection struct {
+	object     *pfsclient.Object
+	done       chan struct{}
+	objectInfo *pfsclient.ObjectInfo
+	err        error
+}
+
+// objectFetch is an object that's being read ahead of time. Its info and the
+// range that's requested are set when it's created, and the rest of its
+// fields are set once 'done' is closed.
+type objectFetch struct {
+	done       chan struct{}
+	objectInfo *pfsclient.ObjectInfo
+	// offset and size are the range of the object that's requested
+	offset uint64
+	size   uint64
+	// data is the object's contents, unless 'stream' is set, in which case
+	// the object is too big to read into memory and must be streamed out of
+	// the object store instead
+	data   []byte
+	stream bool
+	err    error
+	// window and reserved are the window that the object's contents are
+	// counted against, and how many bytes of it they take up
+	window   *byteWindow
+	reserved uint64
+}
+
+// release returns the bytes that the fetch takes up to its window, once its
+// contents have been sent
+func (f *objectFetch) release() {
+	if f.window != nil {
+		f.window.release(f.reserved)
+		f.window = nil
+	}
+}
+
+// prefetchObjects starts reading the parts of 'objects' that overlap the
+// range of 'size' bytes (or the rest of the objects, if 'size' is 0) at
+// 'offset' in their concatenation, and returns their fetches, in order, on
+// the returned channel. The objects are inspected first, so that the objects
+// outside the range aren't read. The caller must call release on each fetch
+// once it's done with it. Reading stops when 'ctx' is cancelled.
+func (s *objBlockAPIServer) prefetchObjects(ctx context.Context, objects []*pfsclient.Object, offset uint64, size uint64) <-chan *objectFetch {
+	fetches := make(chan *objectFetch, maxPrefetchObjects)
+	// Inspections and reads share the limiter, so that no more than
+	// 'parallelism' requests are made at once
+	limiter := limit.New(s.prefetchOptions.parallelism())
+	window := newByteWindow(s.prefetchOptions.windowBytes())
+	// Objects are inspected ahead of the reads, in order, but no further
+	// ahead than the limiter allows. They stop once all the objects in the
+	// range have been read.
+	inspectCtx, cancelInspections := context.WithCancel(ctx)
+	inspections := make(chan *objectInspection, s.prefetchOptions.parallelism())
+	go func() {
+		defer close(inspections)
+		for _, object := range objects {
+			i := &objectInspection{object: object, done: make(chan struct{})}
+			select {
+			case inspections <- i:
+			case <-inspectCtx.Done():
+				return
+			}
+			limiter.Acquire()
+			go func() {
+				defer limiter.Release()
+				defer close(i.done)
+				i.objectInfo, i.err = s.InspectObject(inspectCtx, i.object)
+			}()
+		}
+	}()
+	go func() {
+		defer close(fetches)
+		defer cancelInspections()
+		send := func(f *objectFetch) bool {
+			select {
+			case fetches <- f:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for i := range inspections {
+			select {
+			case <-i.done:
+			case <-ctx.Done():
+				return
+			}
+			if i.err != nil {
+				f := &objectFetch{done: make(chan struct{}), err: i.err}
+				close(f.done)
+				send(f)
+				return
+			}
+			objectInfo := i.objectInfo
+			objectSize := objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
+			if offset >= objectSize {
+				// The object is before the range
+				offset -= objectSize
+				continue
+			}
+			readSize := objectSize - offset
+			if size != 0 && size < readSize {
+				readSize = size
+			}
+			// Objects that are streamed aren't held in memory
+			var reserved uint64
+			if !s.streamsObject(objectSize) {
+				reserved = objectSize
+			}
+			if err := window.acquire(ctx, reserved); err != nil {
+				return
+			}
+			f := &objectFetch{
+				done:       make(chan struct{}),
+				objectInfo: objectInfo,
+				offset:     offset,
+				size:       readSize,
+				window:     window,
+				reserved:   reserved,
+			}
+			if !send(f) {
+				return
+			}
+			limiter.Acquire()
+			go func(object *pfsclient.Object) {
+				defer limiter.Release()
+				defer close(f.done)
+				f.data, f.stream, f.err = s.fetchObject(ctx, object, objectInfo)
+			}(i.object)
+			offset = 0
+			if size != 0 {
+				size -= readSize
+				if size == 0 {
+					// The rest of the objects are after the range
+					return
+				}
+			}
+		}
+	}()
+	return fetches
+}
+
+// byteWindow bounds the number of bytes that are held at once. A request
+// for more bytes than the window holds is granted once the window is empty.
+type byteWindow struct {
+	mu   sync.Mutex
+	max  uint64
+	used uint64
+	// released is closed, and replaced, whenever bytes are released
+	released chan struct{}
+}
+
+func newByteWindow(max uint64) *byteWindow {
+	return &byteWindow{
+		max:      max,
+		released: make(chan struct{}),
+	}
+}
+
+// acquire waits until 'n' bytes fit in the window and takes them, or returns
+// an error if 'ctx' is cancelled first
+func (w *byteWindow) acquire(ctx context.Context, n uint64) error {
+	for {
+		w.mu.Lock()
+		if w.used == 0 || w.used+n <= w.max {
+			w.used += n
+			w.mu.Unlock()
+			return nil
+		}
+		released := w.released
+		w.mu.Unlock()
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// release returns 'n' bytes to the window
+func (w *byteWindow) release(n uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.used -= n
+	close(w.released)
+	w.released = make(chan struct{})
+}
+
+// streamsObject returns whether an object of 'objectSize' bytes is too big to
+// read into memory, and so is streamed out of the object store instead
+func (s *objBlockAPIServer) streamsObject(objectSize uint64) bool {
+	return (s.objectCacheBytes == 0 || objectSize > uint64(s.objectCacheBytes/maxCachedObjectDenom)) &&
+		!s.blockCacheable(objectSize)
+}
+
+// fetchObject returns the contents of 'object', whose info is 'objectInfo'.
+// If the object is too big to cache, its contents aren't read and the
+// returned bool is true.
+func (s *objBlockAPIServer) fetchObject(ctx context.Context, object *pfsclient.Object, objectInfo *pfsclient.ObjectInfo) ([]byte, bool, error) {
+	objectSize := objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
+	var data []byte
+	sink := groupcache.AllocatingByteSliceSink(&data)
+	if s.objectCacheBytes == 0 || (objectSize) > uint64(s.objectCacheBytes/maxCachedObjectDenom) {
+		// The object is a substantial portion of the available cache space so
+		// we bypass the cache and stream it directly out of the underlying store.
+		if !s.blockCacheable(objectSize) {
+			return nil, true, nil
+		}
+		if err := s.readBlockRef(objectInfo.BlockRef, sink); err != nil {
+			return nil, false, err
+		}
+		return data, false, nil
+	}
+	if err := s.objectCache.Get(ctx, s.splitKey(object.Hash), sink); err != nil {
+		return nil, false, err
+	}
+	return data, false, nil
+}
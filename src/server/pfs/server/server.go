@@ -1,7 +1,14 @@
 package server
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diskcache"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 )
 
@@ -11,6 +18,8 @@ const (
 	AmazonBackendEnvVar    = "AMAZON"
 	GoogleBackendEnvVar    = "GOOGLE"
 	MicrosoftBackendEnvVar = "MICROSOFT"
+	LocalBackendEnvVar     = "LOCAL"
+	MirrorBackendEnvVar    = "MIRROR"
 )
 
 var (
@@ -22,17 +31,29 @@ var (
 // APIServer represents and api server.
 type APIServer interface {
 	pfsclient.APIServer
+	// Close releases the server's connections and stops its goroutines.
+	Close() error
 }
 
 // BlockAPIServer combines BlockAPIServer and ObjectAPIServer.
 type BlockAPIServer interface {
 	pfsclient.ObjectAPIServer
+	// Close stops the server's background goroutines.
+	Close() error
 }
 
 // NewAPIServer creates an APIServer.
 // cacheSize is the number of commit trees which will be cached in the server.
-func NewAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (APIServer, error) {
-	return newAPIServer(address, etcdAddresses, etcdPrefix, cacheSize)
+// If diskCacheDir is set, up to diskCacheBytes bytes of commit trees are also
+// cached there, so that they survive restarts.
+func NewAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64, diskCacheDir string, diskCacheBytes int64) (APIServer, error) {
+	return newAPIServer(address, etcdAddresses, etcdPrefix, cacheSize, diskCacheDir, diskCacheBytes)
+}
+
+// NewAPIServerWithStore is like NewAPIServer, but PFS's metadata is kept in
+// 'store' instead of etcd.
+func NewAPIServerWithStore(address string, store kv.Store, etcdPrefix string, cacheSize int64, diskCacheDir string, diskCacheBytes int64) (APIServer, error) {
+	return newStoreAPIServer(address, store, etcdPrefix, cacheSize, diskCacheDir, diskCacheBytes)
 }
 
 // NewHTTPServer creates an APIServer.
@@ -48,47 +69,131 @@ func NewLocalBlockAPIServer(dir string) (BlockAPIServer, error) {
 
 // NewObjBlockAPIServer create a BlockAPIServer from an obj.Client.
 func NewObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objClient obj.Client) (BlockAPIServer, error) {
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+	blockAPIServer, err := newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+	if err != nil {
+		return nil, err
+	}
+	go blockAPIServer.watchGC()
+	return blockAPIServer, nil
+}
+
+// BlockAPIServerOptions configures where a BlockAPIServer stores objects, and
+// how it caches them.
+type BlockAPIServerOptions struct {
+	// Backend is the object store that objects are kept in, e.g.
+	// AmazonBackendEnvVar. Unknown backends use a LocalBlockAPIServer.
+	Backend string
+	// Dir is the directory (or bucket prefix) that objects are kept in.
+	Dir string
+	// MirrorURL lists the object stores that the MIRROR backend mirrors
+	// objects across (see obj.ParseURL). It's ignored by other backends.
+	MirrorURL string
+	// CacheBytes is the number of bytes of objects cached in memory.
+	CacheBytes int64
+	// If DiskCacheDir is set, up to DiskCacheBytes bytes of blocks are cached
+	// there, behind the ones cached in memory.
+	DiskCacheDir   string
+	DiskCacheBytes int64
+	// If HotTierDir is set, up to HotTierBytes bytes of recently used objects
+	// are kept there, in front of the object store (see obj.NewTieredClient).
+	// The hot tier is always write-through, since workers and other pachds
+	// read objects from the object store.
+	HotTierDir   string
+	HotTierBytes int64
+	// Compaction configures the compaction of objects in the background.
+	Compaction CompactionOptions
+	// Prefetch configures how GetObjects reads objects ahead.
+	Prefetch PrefetchOptions
+}
+
+// NewBlockAPIServer creates a BlockAPIServer, configured by 'options', using
+// the credentials it finds in the environment.
+func NewBlockAPIServer(etcdAddress string, options BlockAPIServerOptions) (BlockAPIServer, error) {
+	return newBlockAPIServer(etcdAddress, nil, options)
+}
+
+// NewBlockAPIServerWithStore is like NewBlockAPIServer, but the GC generation,
+// the compaction lock and garbage collection's write barrier are kept in
+// 'store' instead of etcd.
+func NewBlockAPIServerWithStore(store kv.Store, options BlockAPIServerOptions) (BlockAPIServer, error) {
+	return newBlockAPIServer("", store, options)
 }
 
-// NewBlockAPIServer creates a BlockAPIServer using the credentials it finds in
-// the environment
-func NewBlockAPIServer(dir string, cacheBytes int64, backend string, etcdAddress string) (BlockAPIServer, error) {
-	switch backend {
+// newBlockAPIServer implements NewBlockAPIServer and
+// NewBlockAPIServerWithStore. If store is nil, the server connects to etcd at
+// etcdAddress.
+func newBlockAPIServer(etcdAddress string, store kv.Store, options BlockAPIServerOptions) (BlockAPIServer, error) {
+	dir := options.Dir
+	cacheBytes := options.CacheBytes
+	var blockAPIServer *objBlockAPIServer
+	var err error
+	switch options.Backend {
 	case MinioBackendEnvVar:
 		// S3 compatible doesn't like leading slashes
 		if len(dir) > 0 && dir[0] == '/' {
 			dir = dir[1:]
 		}
-		blockAPIServer, err := newMinioBlockAPIServer(dir, cacheBytes, etcdAddress)
-		if err != nil {
-			return nil, err
-		}
-		return blockAPIServer, nil
+		blockAPIServer, err = newMinioBlockAPIServer(dir, cacheBytes, etcdAddress)
 	case AmazonBackendEnvVar:
 		// amazon doesn't like leading slashes
 		if len(dir) > 0 && dir[0] == '/' {
 			dir = dir[1:]
 		}
-		blockAPIServer, err := newAmazonBlockAPIServer(dir, cacheBytes, etcdAddress)
-		if err != nil {
-			return nil, err
-		}
-		return blockAPIServer, nil
+		blockAPIServer, err = newAmazonBlockAPIServer(dir, cacheBytes, etcdAddress)
 	case GoogleBackendEnvVar:
 		// TODO figure out if google likes leading slashses
-		blockAPIServer, err := newGoogleBlockAPIServer(dir, cacheBytes, etcdAddress)
+		blockAPIServer, err = newGoogleBlockAPIServer(dir, cacheBytes, etcdAddress)
+	case MicrosoftBackendEnvVar:
+		blockAPIServer, err = newMicrosoftBlockAPIServer(dir, cacheBytes, etcdAddress)
+	case LocalBackendEnvVar:
+		blockAPIServer, err = newLocalObjBlockAPIServer(dir, cacheBytes, etcdAddress)
+	case MirrorBackendEnvVar:
+		// Object stores don't like leading slashes
+		if len(dir) > 0 && dir[0] == '/' {
+			dir = dir[1:]
+		}
+		blockAPIServer, err = newMirrorBlockAPIServer(dir, cacheBytes, etcdAddress, options.MirrorURL)
+	default:
+		return NewLocalBlockAPIServer(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if options.HotTierDir != "" {
+		// Nothing has used the server's object client yet, so it can be
+		// swapped for a tiered one
+		objClient, err := obj.NewTieredClient(blockAPIServer.objClient, options.HotTierDir, options.HotTierBytes, false)
 		if err != nil {
 			return nil, err
 		}
-		return blockAPIServer, nil
-	case MicrosoftBackendEnvVar:
-		blockAPIServer, err := newMicrosoftBlockAPIServer(dir, cacheBytes, etcdAddress)
+		blockAPIServer.objClient = objClient
+	}
+	if options.DiskCacheDir != "" {
+		blockCache, err := diskcache.NewCache(options.DiskCacheDir, options.DiskCacheBytes)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("could not initialize blockCache: %s", err.Error())
 		}
-		return blockAPIServer, nil
-	default:
-		return NewLocalBlockAPIServer(dir)
+		blockAPIServer.blockCache = blockCache
+		// Periodically print cache stats for debugging purposes
+		go func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+				case <-blockAPIServer.done:
+					return
+				}
+				logrus.Infof("blockCache stats: %+v", blockCache.Stats())
+			}
+		}()
+	}
+	blockAPIServer.store = store
+	blockAPIServer.compactionOptions = options.Compaction
+	blockAPIServer.prefetchOptions = options.Prefetch
+	go blockAPIServer.watchGC()
+	if options.Compaction.enabled() {
+		go blockAPIServer.scheduleCompaction()
 	}
+	return blockAPIServer, nil
 }
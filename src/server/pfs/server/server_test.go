@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"math/rand"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -24,10 +26,18 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	authtesting "github.com/pachyderm/pachyderm/src/server/auth/testing"
+	"github.com/pachyderm/pachyderm/src/server/pfs/replicate"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diskcache"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
 	pfssync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/golang/groupcache"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -537,6 +547,451 @@ func TestDeleteProvenanceRepo(t *testing.T) {
 	require.Equal(t, 1, len(repoInfos))
 }
 
+func TestForkRepo(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	src := "src"
+	require.NoError(t, client.CreateRepo(src))
+	commit1, err := client.StartCommit(src, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(src, commit1.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(src, commit1.ID))
+	commit2, err := client.StartCommit(src, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(src, commit2.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(src, commit2.ID))
+
+	// Open commits are not forked
+	commit3, err := client.StartCommit(src, "master")
+	require.NoError(t, err)
+
+	dst := "dst"
+	require.NoError(t, client.ForkRepo(src, dst))
+	require.YesError(t, client.ForkRepo(src, dst))
+
+	srcInfo, err := client.InspectRepo(src)
+	require.NoError(t, err)
+	dstInfo, err := client.InspectRepo(dst)
+	require.NoError(t, err)
+	require.Equal(t, srcInfo.SizeBytes, dstInfo.SizeBytes)
+
+	var buffer bytes.Buffer
+	require.NoError(t, client.GetFile(dst, "master", "foo", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+	_, err = client.InspectCommit(dst, commit3.ID)
+	require.YesError(t, err)
+
+	// Forked commits get new IDs, but keep their ancestry
+	commitInfos, err := client.ListCommit(dst, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	for _, commit := range []*pfs.Commit{commit1, commit2} {
+		_, err = client.InspectCommit(dst, commit.ID)
+		require.YesError(t, err)
+		for _, commitInfo := range commitInfos {
+			require.NotEqual(t, commit.ID, commitInfo.Commit.ID)
+		}
+	}
+	forked1, forked2 := commitInfos[1].Commit, commitInfos[0].Commit
+	require.Nil(t, commitInfos[1].ParentCommit)
+	require.Equal(t, forked1.ID, commitInfos[0].ParentCommit.ID)
+	headInfo, err := client.InspectCommit(dst, "master")
+	require.NoError(t, err)
+	require.Equal(t, forked2.ID, headInfo.Commit.ID)
+
+	// Commits to one repo after the fork aren't visible in the other
+	_, err = client.PutFile(src, commit3.ID, "foo", strings.NewReader("src\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(src, commit3.ID))
+	commit4, err := client.StartCommit(dst, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(dst, commit4.ID, "foo", strings.NewReader("dst\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(dst, commit4.ID))
+
+	buffer.Reset()
+	require.NoError(t, client.GetFile(src, "master", "foo", 0, 0, &buffer))
+	require.Equal(t, "foo\nsrc\n", buffer.String())
+	buffer.Reset()
+	require.NoError(t, client.GetFile(dst, "master", "foo", 0, 0, &buffer))
+	require.Equal(t, "foo\ndst\n", buffer.String())
+
+	// Deleting the source repo doesn't affect the fork
+	require.NoError(t, client.DeleteRepo(src, false))
+	buffer.Reset()
+	require.NoError(t, client.GetFile(dst, forked1.ID, "foo", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+}
+
+func TestFsck(t *testing.T) {
+	t.Parallel()
+	client, prefix := getClientAndPrefix(t)
+
+	repo := "TestFsck"
+	require.NoError(t, client.CreateRepo(repo))
+	commit1, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit1.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(repo, commit1.ID))
+	commit2, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+
+	responses, err := client.Fsck(false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(responses))
+
+	// Corrupt the metadata of the repo
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{"localhost:32379"},
+		DialOptions: pclient.EtcdDialOptions(),
+	})
+	require.NoError(t, err)
+	store := kv.NewEtcdStore(etcdClient)
+	_, err = col.NewSTM(context.Background(), etcdClient, func(stm col.STM) error {
+		if err := pfsdb.OpenCommits(store, prefix).ReadWrite(stm).Delete(commit2.ID); err != nil {
+			return err
+		}
+		commits := pfsdb.Commits(store, prefix, repo).ReadWrite(stm)
+		commitInfo := new(pfs.CommitInfo)
+		if err := commits.Get(commit1.ID, commitInfo); err != nil {
+			return err
+		}
+		commitInfo.SizeBytes = 100
+		if err := commits.Put(commit1.ID, commitInfo); err != nil {
+			return err
+		}
+		return pfsdb.Branches(store, prefix, repo).ReadWrite(stm).Put("dangling", pclient.NewCommit(repo, "nonexistent"))
+	})
+	require.NoError(t, err)
+
+	responses, err = client.Fsck(false)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(responses))
+	for _, response := range responses {
+		require.False(t, response.Fixed)
+	}
+	responses, err = client.Fsck(true)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(responses))
+	for _, response := range responses {
+		require.True(t, response.Fixed)
+	}
+	responses, err = client.Fsck(false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(responses))
+
+	// The open commit can be finished again
+	require.NoError(t, client.FinishCommit(repo, commit2.ID))
+	commitInfo, err := client.InspectCommit(repo, commit1.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), commitInfo.SizeBytes)
+}
+
+func TestFsckAfterCompaction(t *testing.T) {
+	t.Parallel()
+	// Compaction needs the object store-backed block server
+	root := uniqueString("/tmp/pach_test/run")
+	defer os.RemoveAll(root)
+	blockAPIServer, err := newLocalObjBlockAPIServer(root, 64*1024*1024, "localhost:32379")
+	require.NoError(t, err)
+	defer blockAPIServer.Close()
+	port := atomic.AddInt32(&port, 1)
+	address := fmt.Sprintf("localhost:%d", port)
+	apiServer, err := newLocalAPIServer(address, generateRandomString(32))
+	require.NoError(t, err)
+	runServers(t, port, apiServer, blockAPIServer)
+	c, err := pclient.NewFromAddress(address)
+	require.NoError(t, err)
+
+	repo := "TestFsckAfterCompaction"
+	require.NoError(t, c.CreateRepo(repo))
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+
+	// The trees and files are only in indexes after compaction, and fsck
+	// still finds them
+	require.NoError(t, blockAPIServer.compact(context.Background()))
+	objects, tags, err := blockAPIServer.uncompacted(compactionBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(objects)+len(tags))
+	responses, err := c.Fsck(false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(responses))
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(repo, commit.ID, "foo", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+
+	// A compacted object that's deleted is reported as missing
+	fileInfo, err := c.InspectFile(repo, commit.ID, "foo")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfo.Objects))
+	_, err = c.ObjectAPIClient.DeleteObjects(context.Background(), &pfs.DeleteObjectsRequest{
+		Objects: fileInfo.Objects,
+	})
+	require.NoError(t, err)
+	responses, err = c.Fsck(false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(responses))
+	require.True(t, strings.Contains(responses[0].Error, fileInfo.Objects[0].Hash))
+}
+
+func TestReplicate(t *testing.T) {
+	t.Parallel()
+	source := getClient(t)
+	target := getClient(t)
+
+	require.NoError(t, source.CreateRepo("TestReplicateIn"))
+	_, err := source.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+		Repo:       pclient.NewRepo("TestReplicateOut"),
+		Provenance: []*pfs.Repo{pclient.NewRepo("TestReplicateIn")},
+	})
+	require.NoError(t, err)
+	inCommit, err := source.StartCommit("TestReplicateIn", "master")
+	require.NoError(t, err)
+	_, err = source.PutFile("TestReplicateIn", inCommit.ID, "in", strings.NewReader("in\n"))
+	require.NoError(t, err)
+	require.NoError(t, source.FinishCommit("TestReplicateIn", inCommit.ID))
+	// An empty commit, which has no tree
+	_, err = source.StartCommit("TestReplicateOut", "master")
+	require.NoError(t, err)
+	require.NoError(t, source.FinishCommit("TestReplicateOut", "master"))
+	outCommit, err := source.PfsAPIClient.StartCommit(context.Background(), &pfs.StartCommitRequest{
+		Parent:     pclient.NewCommit("TestReplicateOut", ""),
+		Branch:     "master",
+		Provenance: []*pfs.Commit{inCommit},
+	})
+	require.NoError(t, err)
+	_, err = source.PutFile("TestReplicateOut", outCommit.ID, "out", strings.NewReader("out\n"))
+	require.NoError(t, err)
+	require.NoError(t, source.FinishCommit("TestReplicateOut", outCommit.ID))
+
+	startReplication := func() context.CancelFunc {
+		ctx, cancel := context.WithCancel(context.Background())
+		go replicate.Replicate(source.WithCtx(ctx), &target, "TestReplicateOut", "master")
+		return cancel
+	}
+	// waitForCommit waits until the target has the given commit on master
+	waitForCommit := func(repo string, commitID string) {
+		require.NoError(t, backoff.Retry(func() error {
+			commitInfo, err := target.InspectCommit(repo, "master")
+			if err != nil {
+				return err
+			}
+			if commitInfo.Commit.ID != commitID {
+				return fmt.Errorf("expected head %s, got %s", commitID, commitInfo.Commit.ID)
+			}
+			return nil
+		}, backoff.NewTestingBackOff()))
+	}
+
+	cancel := startReplication()
+	waitForCommit("TestReplicateOut", outCommit.ID)
+	commitInfos, err := target.ListCommit("TestReplicateOut", "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	require.Equal(t, 1, len(commitInfos[0].Provenance))
+	require.Equal(t, inCommit.ID, commitInfos[0].Provenance[0].ID)
+	var buf bytes.Buffer
+	require.NoError(t, target.GetFile("TestReplicateOut", outCommit.ID, "out", 0, 0, &buf))
+	require.Equal(t, "out\n", buf.String())
+	// The provenance was replicated as well, but not onto a branch
+	buf.Reset()
+	require.NoError(t, target.GetFile("TestReplicateIn", inCommit.ID, "in", 0, 0, &buf))
+	require.Equal(t, "in\n", buf.String())
+	cancel()
+
+	// Replication resumes where it left off
+	commit, err := source.StartCommit("TestReplicateOut", "master")
+	require.NoError(t, err)
+	_, err = source.PutFile("TestReplicateOut", commit.ID, "out2", strings.NewReader("out2\n"))
+	require.NoError(t, err)
+	require.NoError(t, source.FinishCommit("TestReplicateOut", commit.ID))
+	cancel = startReplication()
+	defer cancel()
+	waitForCommit("TestReplicateOut", commit.ID)
+	commitInfos, err = target.ListCommit("TestReplicateOut", "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(commitInfos))
+	buf.Reset()
+	require.NoError(t, target.GetFile("TestReplicateOut", commit.ID, "out2", 0, 0, &buf))
+	require.Equal(t, "out2\n", buf.String())
+	cancel()
+
+	// Commits keep their authors
+	commitInfo, err := source.InspectCommit("TestReplicateOut", "master")
+	require.NoError(t, err)
+	_, err = source.PfsAPIClient.BuildCommit(context.Background(), &pfs.BuildCommitRequest{
+		Parent: pclient.NewCommit("TestReplicateOut", ""),
+		Branch: "master",
+		Tree:   commitInfo.Tree,
+		Author: pfs.PipelineAuthor("pipeline"),
+	})
+	require.NoError(t, err)
+	commitInfo, err = source.InspectCommit("TestReplicateOut", "master")
+	require.NoError(t, err)
+	cancel = startReplication()
+	defer cancel()
+	waitForCommit("TestReplicateOut", commitInfo.Commit.ID)
+	targetInfo, err := target.InspectCommit("TestReplicateOut", "master")
+	require.NoError(t, err)
+	require.Equal(t, pfs.PipelineAuthor("pipeline"), targetInfo.Author)
+}
+
+func TestBuildCommitID(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "TestBuildCommitID"
+	require.NoError(t, client.CreateRepo(repo))
+	commit, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(repo, commit.ID))
+	commitInfo, err := client.InspectCommit(repo, commit.ID)
+	require.NoError(t, err)
+
+	buildCommit := func(id string) error {
+		_, err := client.PfsAPIClient.BuildCommit(context.Background(), &pfs.BuildCommitRequest{
+			Parent: pclient.NewCommit(repo, ""),
+			Branch: "master",
+			Tree:   commitInfo.Tree,
+			ID:     id,
+		})
+		return err
+	}
+	// IDs that aren't in the format of generated commit IDs are rejected
+	require.YesError(t, buildCommit("master"))
+	require.YesError(t, buildCommit(strings.ToUpper(uuid.NewWithoutDashes())))
+	// So are the IDs of existing commits
+	require.YesError(t, buildCommit(commit.ID))
+
+	id := uuid.NewWithoutDashes()
+	require.NoError(t, buildCommit(id))
+	commitInfo, err = client.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, id, commitInfo.Commit.ID)
+	// Without auth, commits are attributed to the author they're built with
+	require.Equal(t, "", commitInfo.Author)
+	_, err = client.PfsAPIClient.BuildCommit(context.Background(), &pfs.BuildCommitRequest{
+		Parent: pclient.NewCommit(repo, ""),
+		Branch: "master",
+		Tree:   commitInfo.Tree,
+		Author: "alice",
+	})
+	require.NoError(t, err)
+	commitInfo, err = client.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, "alice", commitInfo.Author)
+}
+
+func TestRepoQuota(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "TestRepoQuota"
+	require.NoError(t, client.CreateRepo(repo))
+	require.YesError(t, client.SetRepoQuota("nonexistent", 10, 0))
+	require.NoError(t, client.SetRepoQuota(repo, 10, 2))
+
+	commit1, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit1.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(repo, commit1.ID))
+
+	// A write that would take the repo over its size quota is rejected
+	commit2, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit2.ID, "bar", strings.NewReader("barbarbar\n"))
+	require.YesError(t, err)
+	require.Matches(t, "quota exceeded", err.Error())
+	// Uploads are stopped once they go over quota
+	big := strings.Repeat("aaaaaaaaa\n", 100000)
+	_, err = client.PutFile(repo, commit2.ID, "bar", strings.NewReader(big))
+	require.YesError(t, err)
+	require.Matches(t, "quota exceeded", err.Error())
+	_, err = client.PutFileSplit(repo, commit2.ID, "bar", pfs.Delimiter_LINE, 0, 0, false, strings.NewReader(big))
+	require.YesError(t, err)
+	require.Matches(t, "quota exceeded", err.Error())
+
+	// A commit that would take the repo over its file quota can't be finished
+	// until files are deleted
+	_, err = client.PutFile(repo, commit2.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit2.ID, "buzz", strings.NewReader("z\n"))
+	require.NoError(t, err)
+	err = client.FinishCommit(repo, commit2.ID)
+	require.YesError(t, err)
+	require.Matches(t, "quota exceeded", err.Error())
+	require.NoError(t, client.DeleteFile(repo, commit2.ID, "buzz"))
+	require.NoError(t, client.FinishCommit(repo, commit2.ID))
+
+	repoInfo, err := client.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), repoInfo.SizeBytes)
+	require.Equal(t, uint64(2), repoInfo.FileCount)
+
+	quotaInfos, err := client.ListQuota()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(quotaInfos))
+	require.Equal(t, repo, quotaInfos[0].Repo.Name)
+	require.Equal(t, &pfs.Quota{SizeBytes: 10, FileCount: 2}, quotaInfos[0].Quota)
+	require.Equal(t, &pfs.Usage{SizeBytes: 8, FileCount: 2}, quotaInfos[0].Usage)
+
+	// Removing the quota allows more writes
+	require.NoError(t, client.SetRepoQuota(repo, 0, 0))
+	commit3, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit3.ID, "buzz", strings.NewReader("buzzbuzz\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(repo, commit3.ID))
+	quotaInfos, err = client.ListQuota()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(quotaInfos))
+	require.Nil(t, quotaInfos[0].Quota)
+}
+
+func TestQuotaReader(t *testing.T) {
+	// Reading stops one byte past the quota, however much data is left
+	r := &quotaReader{r: endlessReader{}, remaining: 10}
+	data, err := ioutil.ReadAll(r)
+	require.Equal(t, errQuotaReached, err)
+	require.Equal(t, 11, len(data))
+	require.True(t, r.exceeded)
+
+	// Data that fits is read in full
+	r = &quotaReader{r: strings.NewReader("foo"), remaining: 3}
+	data, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(data))
+	require.False(t, r.exceeded)
+
+	r = &quotaReader{r: strings.NewReader("foo"), remaining: math.MaxUint64}
+	data, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(data))
+}
+
+// endlessReader reads an endless series of bytes
+type endlessReader struct{}
+
+func (endlessReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'a'
+	}
+	return len(p), nil
+}
+
 func TestInspectCommit(t *testing.T) {
 	t.Parallel()
 	client := getClient(t)
@@ -584,6 +1039,51 @@ func TestInspectCommit(t *testing.T) {
 	require.True(t, finished.After(tFinished))
 }
 
+func TestCommitDescription(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "test"
+	require.NoError(t, client.CreateRepo(repo))
+
+	commit, err := client.PfsAPIClient.StartCommit(
+		context.Background(),
+		&pfs.StartCommitRequest{
+			Parent:      pclient.NewCommit(repo, ""),
+			Branch:      "master",
+			Description: "started",
+		},
+	)
+	require.NoError(t, err)
+	commitInfo, err := client.InspectCommit(repo, commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, "started", commitInfo.Description)
+	// The auth system isn't activated, so there's no author
+	require.Equal(t, "", commitInfo.Author)
+
+	// Finishing the commit without a description keeps the existing one
+	require.NoError(t, client.FinishCommit(repo, commit.ID))
+	commitInfo, err = client.InspectCommit(repo, commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, "started", commitInfo.Description)
+
+	commit, err = client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PfsAPIClient.FinishCommit(
+		context.Background(),
+		&pfs.FinishCommitRequest{
+			Commit:      commit,
+			Description: "finished",
+		},
+	)
+	require.NoError(t, err)
+	commitInfos, err := client.ListCommit(repo, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	require.Equal(t, "finished", commitInfos[0].Description)
+	require.Equal(t, "started", commitInfos[1].Description)
+}
+
 func TestDeleteCommit(t *testing.T) {
 	t.Parallel()
 	client := getClient(t)
@@ -683,6 +1183,78 @@ func TestBasicFile(t *testing.T) {
 	require.Equal(t, data, b.String())
 }
 
+func TestListFileHistory(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "test"
+	require.NoError(t, client.CreateRepo(repo))
+
+	putCommit := func(path string, content string) string {
+		commit, err := client.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = client.PutFile(repo, commit.ID, path, strings.NewReader(content))
+		require.NoError(t, err)
+		require.NoError(t, client.FinishCommit(repo, commit.ID))
+		return commit.ID
+	}
+	commit1 := putCommit("foo", "foo\n")
+	putCommit("bar", "bar\n")
+	commit3 := putCommit("foo", "foo\n")
+	putCommit("bar", "bar\n")
+
+	// Query twice so the second query is answered from the cache
+	for i := 0; i < 2; i++ {
+		fileInfos, err := client.ListFileHistory(repo, "master", "foo", 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		require.Equal(t, commit3, fileInfos[0].File.Commit.ID)
+		require.Equal(t, uint64(8), fileInfos[0].SizeBytes)
+		require.Equal(t, commit1, fileInfos[1].File.Commit.ID)
+		require.Equal(t, uint64(4), fileInfos[1].SizeBytes)
+	}
+
+	fileInfos, err := client.ListFileHistory(repo, "master", "foo", 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfos))
+	require.Equal(t, commit3, fileInfos[0].File.Commit.ID)
+
+	fileInfos, err = client.ListFileHistory(repo, "master^2", "foo", 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfos))
+	require.Equal(t, commit1, fileInfos[0].File.Commit.ID)
+
+	// The head of the branch may be an open commit
+	commit5, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit5.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	fileInfos, err = client.ListFileHistory(repo, "master", "foo", 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(fileInfos))
+	require.Equal(t, commit5.ID, fileInfos[0].File.Commit.ID)
+
+	fileInfos, err = client.ListFileHistory(repo, "master", "nonexistent", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(fileInfos))
+
+	// Deleting the file is a change too
+	require.NoError(t, client.FinishCommit(repo, commit5.ID))
+	commit6, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteFile(repo, commit6.ID, "foo"))
+	require.NoError(t, client.FinishCommit(repo, commit6.ID))
+	commit7 := putCommit("foo", "bar\n")
+	fileInfos, err = client.ListFileHistory(repo, "master", "foo", 0)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(fileInfos))
+	require.Equal(t, commit7, fileInfos[0].File.Commit.ID)
+	require.False(t, fileInfos[0].Deleted)
+	require.Equal(t, commit6.ID, fileInfos[1].File.Commit.ID)
+	require.True(t, fileInfos[1].Deleted)
+	require.Equal(t, commit5.ID, fileInfos[2].File.Commit.ID)
+}
+
 func TestSimpleFile(t *testing.T) {
 	t.Parallel()
 	client := getClient(t)
@@ -1833,6 +2405,58 @@ func TestListCommit(t *testing.T) {
 	}
 }
 
+func TestListPagination(t *testing.T) {
+	client := getClient(t)
+	numRepos := 5
+	for i := 0; i < numRepos; i++ {
+		require.NoError(t, client.CreateRepo(fmt.Sprintf("repo%d", i)))
+	}
+	// Repos are listed in pages, newest first
+	var repoNames []string
+	var pageToken string
+	for {
+		repoInfos, nextPageToken, err := client.ListRepoPage(nil, 2, pageToken)
+		require.NoError(t, err)
+		require.True(t, len(repoInfos) <= 2)
+		for _, repoInfo := range repoInfos {
+			repoNames = append(repoNames, repoInfo.Repo.Name)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	require.Equal(t, []string{"repo4", "repo3", "repo2", "repo1", "repo0"}, repoNames)
+
+	repo := "repo0"
+	numCommits := 5
+	for i := 0; i < numCommits; i++ {
+		_, err := client.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, client.FinishCommit(repo, "master"))
+	}
+	commitInfos, err := client.ListCommit(repo, "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, numCommits, len(commitInfos))
+	for _, to := range []string{"", "master"} {
+		var pagedCommitInfos []*pfs.CommitInfo
+		pageToken = ""
+		for {
+			page, nextPageToken, err := client.ListCommitPage(repo, to, "", 2, pageToken)
+			require.NoError(t, err)
+			pagedCommitInfos = append(pagedCommitInfos, page...)
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		require.Equal(t, numCommits, len(pagedCommitInfos))
+		for i := range commitInfos {
+			require.Equal(t, commitInfos[i].Commit.ID, pagedCommitInfos[i].Commit.ID)
+		}
+	}
+}
+
 func TestOffsetRead(t *testing.T) {
 	t.Parallel()
 	client := getClient(t)
@@ -2312,6 +2936,179 @@ func TestSyncEmptyDir(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSymlinksAndModes(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "repo"
+	require.NoError(t, client.CreateRepo(repo))
+	mtime := time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC)
+	commit, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFileWithAttrs(repo, commit.ID, "bin/run", 0755, mtime, false, strings.NewReader("#!/bin/sh\n"))
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit.ID, "data", strings.NewReader("data\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.PutSymlink(repo, commit.ID, "run", "bin/run"))
+	require.NoError(t, client.FinishCommit(repo, commit.ID))
+
+	fileInfo, err := client.InspectFile(repo, commit.ID, "bin/run")
+	require.NoError(t, err)
+	require.Equal(t, uint32(0755), fileInfo.Mode)
+	fileMtime, err := types.TimestampFromProto(fileInfo.Mtime)
+	require.NoError(t, err)
+	require.True(t, mtime.Equal(fileMtime))
+	fileInfo, err = client.InspectFile(repo, commit.ID, "data")
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), fileInfo.Mode)
+	fileInfo, err = client.InspectFile(repo, commit.ID, "run")
+	require.NoError(t, err)
+	require.Equal(t, pfs.FileType_SYMLINK, fileInfo.FileType)
+	require.Equal(t, "bin/run", fileInfo.SymlinkTarget)
+	var buffer bytes.Buffer
+	require.YesError(t, client.GetFile(repo, commit.ID, "run", 0, 0, &buffer))
+
+	// Pull and Push preserve symlinks and modes
+	tmpDir, err := ioutil.TempDir("/tmp", "pfs")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	puller := pfssync.NewPuller()
+	require.NoError(t, puller.Pull(&client, tmpDir, repo, commit.ID, "", false, 2, nil, ""))
+	_, err = puller.CleanUp()
+	require.NoError(t, err)
+	info, err := os.Stat(filepath.Join(tmpDir, "bin/run"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	require.True(t, mtime.Equal(info.ModTime()))
+	target, err := os.Readlink(filepath.Join(tmpDir, "run"))
+	require.NoError(t, err)
+	require.Equal(t, "bin/run", target)
+
+	repo2 := "repo2"
+	require.NoError(t, client.CreateRepo(repo2))
+	commit2, err := client.StartCommit(repo2, "master")
+	require.NoError(t, err)
+	require.NoError(t, pfssync.Push(&client, tmpDir, commit2, false))
+	require.NoError(t, client.FinishCommit(repo2, commit2.ID))
+	fileInfo, err = client.InspectFile(repo2, commit2.ID, "bin/run")
+	require.NoError(t, err)
+	require.Equal(t, uint32(0755), fileInfo.Mode)
+	fileInfo, err = client.InspectFile(repo2, commit2.ID, "run")
+	require.NoError(t, err)
+	require.Equal(t, pfs.FileType_SYMLINK, fileInfo.FileType)
+	require.Equal(t, "bin/run", fileInfo.SymlinkTarget)
+}
+
+func TestSyncUploadDownload(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "repo"
+	require.NoError(t, client.CreateRepo(repo))
+	src, err := ioutil.TempDir("/tmp", "pfs")
+	require.NoError(t, err)
+	defer os.RemoveAll(src)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "a"), []byte("a\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dir"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "dir", "b"), []byte("b\n"), 0755))
+	require.NoError(t, os.Symlink("a", filepath.Join(src, "link")))
+
+	changeList := func(changes []*pfssync.Change) []string {
+		var result []string
+		for _, change := range changes {
+			result = append(result, fmt.Sprintf("%s %s", change.Type, change.Path))
+		}
+		return result
+	}
+
+	// The first upload writes everything in one commit
+	changes, err := pfssync.Upload(&client, src, repo, "master", "/data", false, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"write a", "write dir/b", "write link"}, changeList(changes))
+	commitInfos, err := client.ListCommit(repo, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfos))
+	var buffer bytes.Buffer
+	require.NoError(t, client.GetFile(repo, "master", "data/dir/b", 0, 0, &buffer))
+	require.Equal(t, "b\n", buffer.String())
+
+	// Nothing has changed, so there's nothing to upload
+	changes, err = pfssync.Upload(&client, src, repo, "master", "/data", false, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes))
+
+	// Only changed files are uploaded, and deletions only happen with 'del'
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "a"), []byte("changed\n"), 0644))
+	require.NoError(t, os.Chmod(filepath.Join(src, "dir", "b"), 0644))
+	require.NoError(t, os.Remove(filepath.Join(src, "link")))
+	changes, err = pfssync.Upload(&client, src, repo, "master", "/data", true, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"delete link", "write a", "write dir/b"}, changeList(changes))
+	commitInfos, err = client.ListCommit(repo, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfos))
+	_, err = pfssync.Upload(&client, src, repo, "master", "/data", true, false)
+	require.NoError(t, err)
+	_, err = client.InspectFile(repo, "master", "data/link")
+	require.YesError(t, err)
+	fileInfo, err := client.InspectFile(repo, "master", "data/dir/b")
+	require.NoError(t, err)
+	require.Equal(t, uint32(0644), fileInfo.Mode)
+
+	// Download into a directory that has a stale file and an extra file
+	dst, err := ioutil.TempDir("/tmp", "pfs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dst)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dst, "a"), []byte("a\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dst, "extra"), []byte("extra\n"), 0644))
+	changes, err = pfssync.Download(&client, dst, repo, "master", "/data", false, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"write a", "write dir/b"}, changeList(changes))
+	data, err := ioutil.ReadFile(filepath.Join(dst, "a"))
+	require.NoError(t, err)
+	require.Equal(t, "changed\n", string(data))
+	changes, err = pfssync.Download(&client, dst, repo, "master", "/data", true, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"delete extra"}, changeList(changes))
+	_, err = os.Stat(filepath.Join(dst, "extra"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestGrepFile(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "repo"
+	require.NoError(t, client.CreateRepo(repo))
+	commit, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit.ID, "logs/a", strings.NewReader("ok\nerror: foo\nok\nerror: bar"))
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit.ID, "logs/nested/b", strings.NewReader("error: buzz\n"))
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit.ID, "other", strings.NewReader("error: other\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(repo, commit.ID))
+
+	grep := func(pattern, regex string, maxMatches int64) []string {
+		var result []string
+		require.NoError(t, client.GrepFile(repo, commit.ID, pattern, regex, maxMatches, func(match *pfs.GrepFileResponse) error {
+			result = append(result, fmt.Sprintf("%s:%d:%s", match.File.Path, match.LineNumber, match.Line))
+			return nil
+		}))
+		return result
+	}
+	// Matching directories are searched recursively and matches are
+	// returned in path order
+	require.Equal(t, []string{"/logs/a:2:error: foo", "/logs/a:4:error: bar", "/logs/nested/b:1:error: buzz"}, grep("logs", "^error", 0))
+	require.Equal(t, []string{"/other:1:error: other"}, grep("o*", "error", 0))
+	require.Equal(t, []string{"/logs/a:2:error: foo", "/logs/a:4:error: bar"}, grep("*", "error", 2))
+	require.Equal(t, 0, len(grep("*", "nothing", 0)))
+
+	err = client.GrepFile(repo, commit.ID, "*", "(", 0, func(*pfs.GrepFileResponse) error { return nil })
+	require.YesError(t, err)
+}
+
 func generateRandomString(n int) string {
 	rand.Seed(time.Now().UnixNano())
 	b := make([]byte, n)
@@ -2321,6 +3118,368 @@ func generateRandomString(n int) string {
 	return string(b)
 }
 
+func TestBlockCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "block")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	localServer, err := newLocalBlockAPIServer(filepath.Join(dir, "pach"))
+	require.NoError(t, err)
+	objClient, err := obj.NewLocalClient("/")
+	require.NoError(t, err)
+	blockCache, err := diskcache.NewCache(filepath.Join(dir, "cache"), 1024)
+	require.NoError(t, err)
+	s := &objBlockAPIServer{
+		localServer: localServer,
+		objClient:   objClient,
+		blockCache:  blockCache,
+	}
+
+	block := pclient.NewBlock(uuid.NewWithoutDashes())
+	w, err := objClient.Writer(localServer.blockPath(block))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("foobar"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	blockRef := &pfs.BlockRef{Block: block, Range: &pfs.ByteRange{Lower: 1, Upper: 4}}
+	readBlockRef := func() string {
+		var data []byte
+		require.NoError(t, s.readBlockRef(blockRef, groupcache.AllocatingByteSliceSink(&data)))
+		return string(data)
+	}
+	require.Equal(t, "oob", readBlockRef())
+	require.Equal(t, "oob", readBlockRef())
+	require.Equal(t, int64(1), blockCache.Stats().Hits)
+	require.Equal(t, int64(1), blockCache.Stats().Misses)
+
+	// Once it's cached, the range is read from the cache rather than the
+	// object store
+	require.NoError(t, objClient.Delete(localServer.blockPath(block)))
+	require.Equal(t, "oob", readBlockRef())
+}
+
+func TestCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "block")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	localServer, err := newLocalBlockAPIServer(dir)
+	require.NoError(t, err)
+	objClient, err := obj.NewLocalClient("/")
+	require.NoError(t, err)
+	s := &objBlockAPIServer{
+		Logger:            log.NewLogger("pfs.BlockAPI.Obj"),
+		localServer:       localServer,
+		objClient:         objClient,
+		objectIndexes:     make(map[string]*cachedObjectIndex),
+		etcdAddress:       "localhost:32379",
+		compactionOptions: CompactionOptions{RequestsPerSecond: 1000},
+	}
+	listObjects := func() []string {
+		server := &listObjectsServer{}
+		require.NoError(t, s.ListObjects(&pfs.ListObjectsRequest{}, server))
+		sort.Strings(server.hashes)
+		return server.hashes
+	}
+	listTags := func() []string {
+		server := &listTagsServer{}
+		require.NoError(t, s.ListTags(&pfs.ListTagsRequest{IncludeObject: true}, server))
+		sort.Strings(server.tags)
+		return server.tags
+	}
+	checkObject := func(hash string) bool {
+		resp, err := s.CheckObject(context.Background(), &pfs.CheckObjectRequest{Object: &pfs.Object{Hash: hash}})
+		require.NoError(t, err)
+		return resp.Exists
+	}
+
+	// putObject writes an object the same way that PutObject does
+	putObject := func(hash string, data string, tags ...string) {
+		block := pclient.NewBlock(uuid.NewWithoutDashes())
+		w, err := objClient.Writer(localServer.blockPath(block))
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		object := &pfs.Object{Hash: hash}
+		require.NoError(t, s.writeProto(localServer.objectPath(object), &pfs.BlockRef{
+			Block: block,
+			Range: &pfs.ByteRange{Lower: 0, Upper: uint64(len(data))},
+		}))
+		for _, tag := range tags {
+			require.NoError(t, s.writeProto(localServer.tagPath(&pfs.Tag{Name: tag}), &pfs.ObjectIndex{
+				Tags: map[string]*pfs.Object{tag: object},
+			}))
+		}
+	}
+	putObject("aa01", "foo", "tag1")
+	putObject("aa02", "bar")
+	putObject("bb01", "buzz", "tag2")
+	require.Equal(t, []string{"aa01", "aa02", "bb01"}, listObjects())
+	require.Equal(t, []string{"tag1:aa01", "tag2:bb01"}, listTags())
+	info, err := s.readCompactionInfo()
+	require.NoError(t, err)
+	require.False(t, info.Running)
+	require.Nil(t, info.Started)
+
+	generation := s.getGeneration()
+	require.NoError(t, s.compact(context.Background()))
+	// Cached object infos are invalidated
+	require.True(t, s.getGeneration() > generation)
+	info, err = s.readCompactionInfo()
+	require.NoError(t, err)
+	require.False(t, info.Running)
+	require.NotNil(t, info.Finished)
+	require.Equal(t, "", info.Error)
+	require.Equal(t, uint64(3), info.ObjectsCompacted)
+	require.Equal(t, uint64(2), info.TagsCompacted)
+	require.Equal(t, uint64(10), info.BytesRewritten)
+	// The objects are in the "aa" and "bb" indexes, and the tags are in the
+	// "ta" index
+	require.Equal(t, uint64(3), info.IndexesMerged)
+	objects, tags, err := s.uncompacted(compactionBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(objects)+len(tags))
+
+	index := &pfs.ObjectIndex{}
+	require.NoError(t, s.readProto(localServer.indexPath("aa"), index))
+	require.Equal(t, 2, len(index.Objects))
+	var data []byte
+	require.NoError(t, s.readBlockRef(index.Objects["aa02"], groupcache.AllocatingByteSliceSink(&data)))
+	require.Equal(t, "bar", string(data))
+	index = &pfs.ObjectIndex{}
+	require.NoError(t, s.readProto(localServer.indexPath("ta"), index))
+	require.Equal(t, "bb01", index.Tags["tag2"].Hash)
+	// Compacted objects and tags are still listed and found
+	require.Equal(t, []string{"aa01", "aa02", "bb01"}, listObjects())
+	require.Equal(t, []string{"tag1:aa01", "tag2:bb01"}, listTags())
+	require.True(t, checkObject("aa02"))
+	require.False(t, checkObject("aa04"))
+	index = &pfs.ObjectIndex{}
+	require.NoError(t, s.readProto(localServer.indexPath("aa"), index))
+	compactedBlock := index.Objects["aa02"]
+
+	// Deleting a compacted object removes it from its index, but doesn't
+	// delete the block that it shares with other objects. Objects that don't
+	// exist aren't reported as deleted.
+	deleteResp, err := s.DeleteObjects(context.Background(), &pfs.DeleteObjectsRequest{
+		Objects: []*pfs.Object{{Hash: "bb01"}, {Hash: "aa04"}, {Hash: "bb01"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*pfs.Object{{Hash: "bb01"}}, deleteResp.Objects)
+	require.Equal(t, uint64(0), deleteResp.Bytes)
+	require.False(t, checkObject("bb01"))
+	require.Equal(t, []string{"aa01", "aa02"}, listObjects())
+	data = nil
+	require.NoError(t, s.readBlockRef(compactedBlock, groupcache.AllocatingByteSliceSink(&data)))
+	require.Equal(t, "bar", string(data))
+	_, err = s.DeleteTags(context.Background(), &pfs.DeleteTagsRequest{Tags: []string{"tag2"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tag1:aa01"}, listTags())
+
+	// An interrupted compaction is resumed, and the new objects are merged
+	// into the existing indexes
+	started, err := types.TimestampProto(time.Now())
+	require.NoError(t, err)
+	require.NoError(t, s.writeCompactionInfo(&pfs.CompactionInfo{
+		Running:          true,
+		Started:          started,
+		ObjectsCompacted: 5,
+	}))
+	due, err := s.compactionDue()
+	require.NoError(t, err)
+	require.True(t, due)
+	putObject("aa03", "fizz")
+	require.NoError(t, s.compact(context.Background()))
+	info, err = s.readCompactionInfo()
+	require.NoError(t, err)
+	require.Equal(t, started, info.Started)
+	require.Equal(t, uint64(6), info.ObjectsCompacted)
+	index = &pfs.ObjectIndex{}
+	require.NoError(t, s.readProto(localServer.indexPath("aa"), index))
+	require.Equal(t, 3, len(index.Objects))
+
+	// The shared block is deleted along with the last object in it, and
+	// its whole size is reported then
+	deleteResp, err = s.DeleteObjects(context.Background(), &pfs.DeleteObjectsRequest{
+		Objects: []*pfs.Object{{Hash: "aa01"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), deleteResp.Bytes)
+	require.True(t, objClient.Exists(localServer.blockPath(compactedBlock.Block)))
+	deleteResp, err = s.DeleteObjects(context.Background(), &pfs.DeleteObjectsRequest{
+		Objects: []*pfs.Object{{Hash: "aa02"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(deleteResp.Objects))
+	require.Equal(t, uint64(10), deleteResp.Bytes)
+	require.False(t, objClient.Exists(localServer.blockPath(compactedBlock.Block)))
+	require.Equal(t, []string{"aa03"}, listObjects())
+
+	// Compaction is due once enough objects have been written
+	s.compactionOptions.Threshold = 2
+	putObject("cc01", "a")
+	due, err = s.compactionDue()
+	require.NoError(t, err)
+	require.False(t, due)
+	putObject("cc02", "b")
+	due, err = s.compactionDue()
+	require.NoError(t, err)
+	require.True(t, due)
+
+	// An object that's still in its own block frees that block
+	deleteResp, err = s.DeleteObjects(context.Background(), &pfs.DeleteObjectsRequest{
+		Objects: []*pfs.Object{{Hash: "cc01"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*pfs.Object{{Hash: "cc01"}}, deleteResp.Objects)
+	require.Equal(t, uint64(1), deleteResp.Bytes)
+}
+
+// listObjectsServer collects the objects that ListObjects sends
+type listObjectsServer struct {
+	grpc.ServerStream
+	hashes []string
+}
+
+func (s *listObjectsServer) Send(object *pfs.Object) error {
+	s.hashes = append(s.hashes, object.Hash)
+	return nil
+}
+
+// listTagsServer collects the tags, with their objects, that ListTags sends
+type listTagsServer struct {
+	grpc.ServerStream
+	mu   sync.Mutex
+	tags []string
+}
+
+func (s *listTagsServer) Send(resp *pfs.ListTagsResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if resp.Object != nil {
+		s.tags = append(s.tags, resp.Tag+":"+resp.Object.Hash)
+	}
+	return nil
+}
+
+// getObjectsServer collects the data that GetObjects sends
+type getObjectsServer struct {
+	grpc.ServerStream
+	buf bytes.Buffer
+}
+
+func (s *getObjectsServer) Send(value *types.BytesValue) error {
+	_, err := s.buf.Write(value.Value)
+	return err
+}
+
+func (s *getObjectsServer) Context() context.Context {
+	return context.Background()
+}
+
+func TestGetObjects(t *testing.T) {
+	dir, err := ioutil.TempDir("", "block")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	localServer, err := newLocalBlockAPIServer(dir)
+	require.NoError(t, err)
+	objClient, err := obj.NewLocalClient("/")
+	require.NoError(t, err)
+	s := &objBlockAPIServer{
+		Logger:        log.NewLogger("pfs.BlockAPI.Obj"),
+		localServer:   localServer,
+		objClient:     objClient,
+		objectIndexes: make(map[string]*cachedObjectIndex),
+		// Objects of 10 bytes or more bypass the cache
+		objectCacheBytes: 40,
+		prefetchOptions:  PrefetchOptions{Parallelism: 3, WindowBytes: 20},
+	}
+	s.objectCache = groupcache.NewGroup(uniqueString("object"), 1024, groupcache.GetterFunc(s.objectGetter))
+	s.objectInfoCache = groupcache.NewGroup(uniqueString("objectInfo"), 1024, groupcache.GetterFunc(s.objectInfoGetter))
+
+	var objects []*pfs.Object
+	var blocks []*pfs.Block
+	var expected bytes.Buffer
+	for i := 0; i < 20; i++ {
+		data := fmt.Sprintf("object-%d", i)
+		if i == 10 {
+			data = strings.Repeat("big", 10)
+		}
+		block := pclient.NewBlock(uuid.NewWithoutDashes())
+		w, err := objClient.Writer(localServer.blockPath(block))
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		object := &pfs.Object{Hash: fmt.Sprintf("%04d", i)}
+		require.NoError(t, s.writeProto(localServer.objectPath(object), &pfs.BlockRef{
+			Block: block,
+			Range: &pfs.ByteRange{Lower: 0, Upper: uint64(len(data))},
+		}))
+		objects = append(objects, object)
+		blocks = append(blocks, block)
+		expected.WriteString(data)
+	}
+
+	getObjects := func(offset uint64, size uint64) string {
+		server := &getObjectsServer{}
+		require.NoError(t, s.GetObjects(&pfs.GetObjectsRequest{
+			Objects:     objects,
+			OffsetBytes: offset,
+			SizeBytes:   size,
+		}, server))
+		return server.buf.String()
+	}
+	// Objects are sent in order, including those after the big one
+	require.Equal(t, expected.String(), getObjects(0, 0))
+	require.Equal(t, expected.String()[5:], getObjects(5, 0))
+	require.Equal(t, expected.String()[85:125], getObjects(85, 40))
+
+	// Only the objects that overlap the range are read: objects 10 to 12
+	// hold bytes 80 to 128
+	s.objectCache = groupcache.NewGroup(uniqueString("object"), 1024, groupcache.GetterFunc(s.objectGetter))
+	for i, block := range blocks {
+		if i < 10 || i > 12 {
+			require.NoError(t, objClient.Delete(localServer.blockPath(block)))
+		}
+	}
+	require.Equal(t, expected.String()[85:125], getObjects(85, 40))
+}
+
+func TestByteWindow(t *testing.T) {
+	w := newByteWindow(10)
+	ctx := context.Background()
+	require.NoError(t, w.acquire(ctx, 6))
+	require.NoError(t, w.acquire(ctx, 4))
+	// A request that doesn't fit waits until enough bytes are released
+	acquired := make(chan error)
+	go func() {
+		acquired <- w.acquire(ctx, 5)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("acquired bytes that don't fit in the window")
+	case <-time.After(10 * time.Millisecond):
+	}
+	w.release(4)
+	select {
+	case <-acquired:
+		t.Fatal("acquired bytes that don't fit in the window")
+	case <-time.After(10 * time.Millisecond):
+	}
+	w.release(6)
+	require.NoError(t, <-acquired)
+
+	// Requests bigger than the window are granted once it's empty
+	cancelCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		acquired <- w.acquire(cancelCtx, 20)
+	}()
+	cancel()
+	require.YesError(t, <-acquired)
+	w.release(5)
+	require.NoError(t, w.acquire(ctx, 20))
+}
+
 func runServers(t *testing.T, port int32, apiServer pfs.APIServer,
 	blockAPIServer BlockAPIServer) {
 	ready := make(chan bool)
@@ -2346,6 +3505,13 @@ func runServers(t *testing.T, port int32, apiServer pfs.APIServer,
 var etcdOnce sync.Once
 
 func getClient(t *testing.T) pclient.APIClient {
+	c, _ := getClientAndPrefix(t)
+	return c
+}
+
+// getClientAndPrefix is like getClient, but also returns the etcd prefix
+// under which the servers store their metadata
+func getClientAndPrefix(t *testing.T) (pclient.APIClient, string) {
 	// src/server/pfs/server/driver.go expects an etcd server at "localhost:32379"
 	// Try to establish a connection before proceeding with the test (which will
 	// fail if the connection can't be established)
@@ -2385,7 +3551,7 @@ func getClient(t *testing.T) pclient.APIClient {
 	}
 	c, err := pclient.NewFromAddress(addresses[0])
 	require.NoError(t, err)
-	return *c
+	return *c, prefix
 }
 
 func collectCommitInfos(commitInfoIter pclient.CommitInfoIterator) ([]*pfs.CommitInfo, error) {
@@ -2906,6 +4072,63 @@ func TestDiff(t *testing.T) {
 	require.Equal(t, "dir/fizz", oldFiles[0].File.Path)
 }
 
+func TestDiffContent(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestDiffContent")
+	require.NoError(t, c.CreateRepo(repo))
+
+	_, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "text", strings.NewReader("a\nb\nc\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "labels.json", strings.NewReader(`{"name": "cat", "id": 1}`))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "binary", strings.NewReader("\x00\x01"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "removed", strings.NewReader("removed\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, "master"))
+
+	_, err = c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteFile(repo, "master", "text"))
+	_, err = c.PutFile(repo, "master", "text", strings.NewReader("a\nB\nc\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteFile(repo, "master", "labels.json"))
+	_, err = c.PutFile(repo, "master", "labels.json", strings.NewReader(`{"name": "dog", "id": 1}`))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "binary", strings.NewReader("\x02"))
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteFile(repo, "master", "removed"))
+	require.NoError(t, c.FinishCommit(repo, "master"))
+
+	_, _, contentDiffs, err := c.DiffFileContent(repo, "master", "", "", "", "", false, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(contentDiffs))
+
+	require.Equal(t, "labels.json", contentDiffs[0].NewPath)
+	require.Equal(t, "labels.json", contentDiffs[0].OldPath)
+	require.Equal(t, "", contentDiffs[0].UnifiedDiff)
+	require.Equal(t, []*pfs.JSONKeyDiff{{Key: "name", OldValue: `"cat"`, NewValue: `"dog"`}}, contentDiffs[0].JsonDiffs)
+
+	require.Equal(t, "", contentDiffs[1].NewPath)
+	require.Equal(t, "removed", contentDiffs[1].OldPath)
+	require.Equal(t, "--- removed\n+++ /dev/null\n@@ -1 +0,0 @@\n-removed\n", contentDiffs[1].UnifiedDiff)
+
+	require.Equal(t, "text", contentDiffs[2].NewPath)
+	require.Equal(t, "--- text\n+++ text\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n", contentDiffs[2].UnifiedDiff)
+
+	// Files over the size limit are skipped
+	_, _, contentDiffs, err = c.DiffFileContent(repo, "master", "", "", "", "", false, 5)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(contentDiffs))
+}
+
 func TestGlob(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	etcd "github.com/coreos/etcd/clientv3"
@@ -15,9 +17,9 @@ import (
 )
 
 type collection struct {
-	etcdClient *etcd.Client
-	prefix     string
-	indexes    []Index
+	store   kv.Store
+	prefix  string
+	indexes []Index
 	// We need this to figure out the concrete type of the objects
 	// that this collection is storing. It's pretty retarded, but
 	// not sure what else we can do since types in Go are not first-class
@@ -32,6 +34,12 @@ type collection struct {
 
 // NewCollection creates a new collection.
 func NewCollection(etcdClient *etcd.Client, prefix string, indexes []Index, template proto.Message, keyCheck func(string) error) Collection {
+	return NewStoreCollection(kv.NewEtcdStore(etcdClient), prefix, indexes, template, keyCheck)
+}
+
+// NewStoreCollection is like NewCollection, except that the collection is
+// kept in any kv.Store.
+func NewStoreCollection(store kv.Store, prefix string, indexes []Index, template proto.Message, keyCheck func(string) error) Collection {
 	// We want to ensure that the prefix always ends with a trailing
 	// slash.  Otherwise, when you list the items under a collection
 	// such as `foo`, you might end up listing items under `foobar`
@@ -41,11 +49,11 @@ func NewCollection(etcdClient *etcd.Client, prefix string, indexes []Index, temp
 	}
 
 	return &collection{
-		prefix:     prefix,
-		etcdClient: etcdClient,
-		indexes:    indexes,
-		template:   template,
-		keyCheck:   keyCheck,
+		prefix:   prefix,
+		store:    store,
+		indexes:  indexes,
+		template: template,
+		keyCheck: keyCheck,
 	}
 }
 
@@ -135,13 +143,13 @@ func (c *readWriteCollection) Put(key string, val proto.Marshaler) error {
 }
 
 func (c *readWriteCollection) PutTTL(key string, val proto.Marshaler, ttl int64) error {
-	var options []etcd.OpOption
+	lease := kv.NoLease
 	if ttl > 0 {
-		lease, err := c.collection.etcdClient.Grant(context.Background(), ttl)
+		var err error
+		lease, err = c.collection.store.Grant(context.Background(), ttl)
 		if err != nil {
 			return fmt.Errorf("error granting lease: %v", err)
 		}
-		options = append(options, etcd.WithLease(lease.ID))
 	}
 
 	if c.collection.keyCheck != nil {
@@ -161,7 +169,7 @@ func (c *readWriteCollection) PutTTL(key string, val proto.Marshaler, ttl int64)
 					// we might trigger an unnecessary event if someone is
 					// watching the index
 					if c.stm.Get(indexPath) == "" {
-						c.stm.Put(indexPath, key, options...)
+						c.stm.Put(indexPath, key, lease)
 					}
 				}
 				// If we can get the original value, we remove the original indexes
@@ -191,13 +199,13 @@ func (c *readWriteCollection) PutTTL(key string, val proto.Marshaler, ttl int64)
 				// we might trigger an unnecessary event if someone is
 				// watching the index
 				if c.stm.Get(indexPath) == "" {
-					c.stm.Put(indexPath, key, options...)
+					c.stm.Put(indexPath, key, lease)
 				}
 			}
 		}
 	}
 	bytes, _ := val.Marshal()
-	c.stm.Put(c.Path(key), string(bytes), options...)
+	c.stm.Put(c.Path(key), string(bytes), lease)
 	return nil
 }
 
@@ -257,7 +265,7 @@ func (c *readWriteIntCollection) Create(key string, val int) error {
 	if valStr != "" {
 		return ErrExists{c.prefix, key}
 	}
-	c.stm.Put(fullKey, strconv.Itoa(val))
+	c.stm.Put(fullKey, strconv.Itoa(val), kv.NoLease)
 	return nil
 }
 
@@ -283,7 +291,7 @@ func (c *readWriteIntCollection) IncrementBy(key string, n int) error {
 	if err != nil {
 		return ErrMalformedValue{c.prefix, key, valStr}
 	}
-	c.stm.Put(fullKey, strconv.Itoa(val+n))
+	c.stm.Put(fullKey, strconv.Itoa(val+n), kv.NoLease)
 	return nil
 }
 
@@ -301,7 +309,7 @@ func (c *readWriteIntCollection) DecrementBy(key string, n int) error {
 	if err != nil {
 		return ErrMalformedValue{c.prefix, key, valStr}
 	}
-	c.stm.Put(fullKey, strconv.Itoa(val-n))
+	c.stm.Put(fullKey, strconv.Itoa(val-n), kv.NoLease)
 	return nil
 }
 
@@ -320,7 +328,7 @@ type readonlyCollection struct {
 }
 
 func (c *readonlyCollection) Get(key string, val proto.Unmarshaler) error {
-	resp, err := c.etcdClient.Get(c.ctx, c.Path(key))
+	resp, err := c.store.Get(c.ctx, c.Path(key), kv.GetOptions{})
 	if err != nil {
 		return err
 	}
@@ -335,9 +343,10 @@ func (c *readonlyCollection) Get(key string, val proto.Unmarshaler) error {
 // an indirect iterator goes through a list of keys and retrieve those
 // items from the collection.
 type indirectIterator struct {
-	index int
-	resp  *etcd.GetResponse
-	col   *readonlyCollection
+	index  int
+	resp   *kv.GetResponse
+	col    *readonlyCollection
+	target SortTarget
 }
 
 func (i *indirectIterator) Next(key *string, val proto.Unmarshaler) (ok bool, retErr error) {
@@ -364,9 +373,18 @@ func (i *indirectIterator) Next(key *string, val proto.Unmarshaler) (ok bool, re
 	}
 }
 
+// PageToken returns the token of the index entry of the last item, since
+// listings by index are sorted by their entries' revisions.
+func (i *indirectIterator) PageToken() string {
+	if i.index == 0 {
+		return ""
+	}
+	return pageToken(i.resp.Kvs[i.index-1], i.target)
+}
+
 func (c *readonlyCollection) GetByIndex(index Index, val interface{}) (Iterator, error) {
 	valStr := fmt.Sprintf("%s", val)
-	resp, err := c.etcdClient.Get(c.ctx, c.indexDir(index, valStr), etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortDescend))
+	resp, err := c.store.Get(c.ctx, c.indexDir(index, valStr), kv.GetOptions{Prefix: true, Sort: kv.SortByModRevisionDescend})
 	if err != nil {
 		return nil, err
 	}
@@ -380,7 +398,7 @@ func (c *readonlyCollection) GetByIndex(index Index, val interface{}) (Iterator,
 // The objects are sorted by revision time in descending order, i.e. newer
 // objects are returned first.
 func (c *readonlyCollection) List() (Iterator, error) {
-	resp, err := c.etcdClient.Get(c.ctx, c.prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortDescend))
+	resp, err := c.store.Get(c.ctx, c.prefix, kv.GetOptions{Prefix: true, Sort: kv.SortByModRevisionDescend})
 	if err != nil {
 		return nil, err
 	}
@@ -389,13 +407,190 @@ func (c *readonlyCollection) List() (Iterator, error) {
 	}, nil
 }
 
+// ListWithOptions lists the items of the collection, as 'opts' specify.
+func (c *readonlyCollection) ListWithOptions(opts ListOptions) (ListIterator, error) {
+	prefix := c.prefix
+	var startRev int64
+	var startPath string
+	if opts.PageToken != "" {
+		var startKey string
+		var err error
+		startRev, startKey, err = parsePageToken(opts.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		startPath = c.Path(startKey)
+		if opts.Index != nil {
+			startPath = c.indexPath(*opts.Index, fmt.Sprintf("%s", opts.IndexVal), startKey)
+		}
+	}
+	if opts.Index != nil {
+		prefix = c.indexDir(*opts.Index, fmt.Sprintf("%s", opts.IndexVal)) + "/"
+	}
+	kvs, err := c.list(prefix, startPath, startRev, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp := &kv.GetResponse{Kvs: kvs, Count: int64(len(kvs))}
+	if opts.Index != nil {
+		return &indirectIterator{
+			resp:   resp,
+			col:    c,
+			target: opts.Target,
+		}, nil
+	}
+	return &iterator{
+		resp:   resp,
+		target: opts.Target,
+	}, nil
+}
+
+// pageToken returns the page token that continues a listing sorted by
+// 'target' after 'item'. It holds the item's revision and key, so the
+// listing can be continued even if the item is deleted.
+func pageToken(item *kv.KeyValue, target SortTarget) string {
+	rev := item.ModRevision
+	if target == SortByCreateRevision {
+		rev = item.CreateRevision
+	}
+	return fmt.Sprintf("%d/%s", rev, path.Base(string(item.Key)))
+}
+
+// parsePageToken returns the revision and key in a token from pageToken.
+func parsePageToken(token string) (int64, string, error) {
+	parts := strings.SplitN(token, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", fmt.Errorf("invalid page token %q", token)
+	}
+	rev, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || rev <= 0 {
+		return 0, "", fmt.Errorf("invalid page token %q", token)
+	}
+	return rev, parts[1], nil
+}
+
+// list returns the keys under 'prefix' that come after the item at
+// 'startPath' whose revision was 'startRev' (if 'startPath' is set), in the
+// order of 'opts'. The item needn't exist anymore.
+func (c *readonlyCollection) list(prefix string, startPath string, startRev int64, opts ListOptions) ([]*kv.KeyValue, error) {
+	revision := func(item *kv.KeyValue) int64 {
+		if opts.Target == SortByCreateRevision {
+			return item.CreateRevision
+		}
+		return item.ModRevision
+	}
+	less := func(a, b *kv.KeyValue) bool {
+		if revision(a) != revision(b) {
+			if opts.Order == SortAscend {
+				return revision(a) < revision(b)
+			}
+			return revision(a) > revision(b)
+		}
+		return string(a.Key) < string(b.Key)
+	}
+	// getOptions reads the keys whose revisions are between 'min' and 'max'
+	// (0 means no bound)
+	getOptions := func(min int64, max int64) kv.GetOptions {
+		getOpts := kv.GetOptions{Prefix: true}
+		if opts.Target == SortByCreateRevision {
+			getOpts.MinCreateRevision, getOpts.MaxCreateRevision = min, max
+		} else {
+			getOpts.MinModRevision, getOpts.MaxModRevision = min, max
+		}
+		return getOpts
+	}
+	// atRevision returns the keys at revision 'rev', ordered by key
+	atRevision := func(rev int64) ([]*kv.KeyValue, error) {
+		resp, err := c.store.Get(c.ctx, prefix, getOptions(rev, rev))
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(resp.Kvs, func(i, j int) bool { return less(resp.Kvs[i], resp.Kvs[j]) })
+		return resp.Kvs, nil
+	}
+
+	var result []*kv.KeyValue
+	var min, max int64
+	if startPath != "" {
+		// The items with the same revision as the start item come after it
+		// if their keys do
+		ties, err := atRevision(startRev)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range ties {
+			if string(item.Key) > startPath {
+				result = append(result, item)
+			}
+		}
+		if opts.Order == SortAscend {
+			min = startRev + 1
+		} else if startRev > 1 {
+			max = startRev - 1
+		} else {
+			// No item has a revision before the first one
+			return truncate(result, opts.Limit), nil
+		}
+	}
+	if opts.Limit > 0 && int64(len(result)) >= opts.Limit {
+		return truncate(result, opts.Limit), nil
+	}
+
+	getOpts := getOptions(min, max)
+	switch {
+	case opts.Target == SortByCreateRevision && opts.Order == SortAscend:
+		getOpts.Sort = kv.SortByCreateRevisionAscend
+	case opts.Target == SortByCreateRevision:
+		getOpts.Sort = kv.SortByCreateRevisionDescend
+	case opts.Order == SortAscend:
+		getOpts.Sort = kv.SortByModRevisionAscend
+	default:
+		getOpts.Sort = kv.SortByModRevisionDescend
+	}
+	if opts.Limit > 0 {
+		getOpts.Limit = opts.Limit - int64(len(result))
+	}
+	resp, err := c.store.Get(c.ctx, prefix, getOpts)
+	if err != nil {
+		return nil, err
+	}
+	kvs := resp.Kvs
+	// The store doesn't order items that have the same revision
+	sort.SliceStable(kvs, func(i, j int) bool { return less(kvs[i], kvs[j]) })
+	if getOpts.Limit > 0 && int64(len(kvs)) == getOpts.Limit {
+		// The limit may have cut off some of the items with the last
+		// revision, which aren't necessarily the ones with the greatest
+		// keys, so replace them with the first of all the items with that
+		// revision
+		lastRev := revision(kvs[len(kvs)-1])
+		i := len(kvs) - 1
+		for i > 0 && revision(kvs[i-1]) == lastRev {
+			i--
+		}
+		ties, err := atRevision(lastRev)
+		if err != nil {
+			return nil, err
+		}
+		kvs = truncate(append(kvs[:i], ties...), getOpts.Limit)
+	}
+	return append(result, kvs...), nil
+}
+
+func truncate(kvs []*kv.KeyValue, limit int64) []*kv.KeyValue {
+	if limit > 0 && int64(len(kvs)) > limit {
+		return kvs[:limit]
+	}
+	return kvs
+}
+
 type iterator struct {
-	index int
-	resp  *etcd.GetResponse
+	index  int
+	resp   *kv.GetResponse
+	target SortTarget
 }
 
 func (c *readonlyCollection) Count() (int64, error) {
-	resp, err := c.etcdClient.Get(c.ctx, c.prefix, etcd.WithPrefix(), etcd.WithCountOnly())
+	resp, err := c.store.Get(c.ctx, c.prefix, kv.GetOptions{Prefix: true, CountOnly: true})
 	if err != nil {
 		return 0, err
 	}
@@ -417,21 +612,28 @@ func (i *iterator) Next(key *string, val proto.Unmarshaler) (ok bool, retErr err
 	return false, nil
 }
 
+func (i *iterator) PageToken() string {
+	if i.index == 0 {
+		return ""
+	}
+	return pageToken(i.resp.Kvs[i.index-1], i.target)
+}
+
 // Watch a collection, returning the current content of the collection as
 // well as any future additions.
 func (c *readonlyCollection) Watch() (watch.Watcher, error) {
-	return watch.NewWatcher(c.ctx, c.etcdClient, c.prefix)
+	return watch.NewStoreWatcher(c.ctx, c.store, c.prefix)
 }
 
 func (c *readonlyCollection) WatchWithPrev() (watch.Watcher, error) {
-	return watch.NewWatcherWithPrev(c.ctx, c.etcdClient, c.prefix)
+	return watch.NewStoreWatcherWithPrev(c.ctx, c.store, c.prefix)
 }
 
 // WatchByIndex watches items in a collection that match a particular index
 func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.Watcher, error) {
 	eventCh := make(chan *watch.Event)
 	done := make(chan struct{})
-	watcher, err := watch.NewWatcher(c.ctx, c.etcdClient, c.indexDir(index, fmt.Sprintf("%s", val)))
+	watcher, err := watch.NewStoreWatcher(c.ctx, c.store, c.indexDir(index, fmt.Sprintf("%s", val)))
 	if err != nil {
 		return nil, err
 	}
@@ -466,7 +668,7 @@ func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.W
 				// pass along the error
 				return ev.Err
 			case watch.EventPut:
-				resp, err := c.etcdClient.Get(c.ctx, c.Path(path.Base(string(ev.Key))))
+				resp, err := c.store.Get(c.ctx, c.Path(path.Base(string(ev.Key))), kv.GetOptions{})
 				if err != nil {
 					return err
 				}
@@ -495,5 +697,5 @@ func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.W
 // WatchOne watches a given item.  The first value returned from the watch
 // will be the current value of the item.
 func (c *readonlyCollection) WatchOne(key string) (watch.Watcher, error) {
-	return watch.NewWatcher(c.ctx, c.etcdClient, c.Path(key))
+	return watch.NewStoreWatcher(c.ctx, c.store, c.Path(key))
 }
@@ -9,9 +9,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"golang.org/x/sync/errgroup"
 )
 
 var (
@@ -291,6 +293,201 @@ func TestMultiIndex(t *testing.T) {
 	require.Equal(t, j2, job)
 }
 
+func TestEmbeddedStore(t *testing.T) {
+	store, err := kv.NewEmbedded("")
+	require.NoError(t, err)
+	defer store.Close()
+
+	jobInfos := NewStoreCollection(store, "jobs", []Index{pipelineIndex}, &pps.JobInfo{}, nil)
+	j1 := &pps.JobInfo{
+		Job:      &pps.Job{ID: "j1"},
+		Pipeline: &pps.Pipeline{Name: "p1"},
+	}
+	j2 := &pps.JobInfo{
+		Job:      &pps.Job{ID: "j2"},
+		Pipeline: &pps.Pipeline{Name: "p2"},
+	}
+	_, err = NewStoreSTM(context.Background(), store, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Put(j1.Job.ID, j1)
+	})
+	require.NoError(t, err)
+
+	jobInfosReadonly := jobInfos.ReadOnly(context.Background())
+	watcher, err := jobInfosReadonly.Watch()
+	require.NoError(t, err)
+	defer watcher.Close()
+	var ID string
+	job := new(pps.JobInfo)
+	event := <-watcher.Watch()
+	require.NoError(t, event.Err)
+	require.Equal(t, watch.EventPut, event.Type)
+	require.NoError(t, event.Unmarshal(&ID, job))
+	require.Equal(t, j1, job)
+
+	_, err = NewStoreSTM(context.Background(), store, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Put(j2.Job.ID, j2)
+	})
+	require.NoError(t, err)
+	event = <-watcher.Watch()
+	require.NoError(t, event.Err)
+	require.Equal(t, watch.EventPut, event.Type)
+	require.NoError(t, event.Unmarshal(&ID, job))
+	require.Equal(t, j2, job)
+
+	iter, err := jobInfosReadonly.GetByIndex(pipelineIndex, j2.Pipeline)
+	require.NoError(t, err)
+	ok, err := iter.Next(&ID, job)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, j2, job)
+	ok, err = iter.Next(&ID, job)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = NewStoreSTM(context.Background(), store, func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Delete(j1.Job.ID)
+	})
+	require.NoError(t, err)
+	event = <-watcher.Watch()
+	require.NoError(t, event.Err)
+	require.Equal(t, watch.EventDelete, event.Type)
+	count, err := jobInfosReadonly.Count()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// Concurrent transactions that conflict are retried
+	counters := NewStoreCollection(store, "counters", nil, nil, nil)
+	_, err = NewStoreSTM(context.Background(), store, func(stm STM) error {
+		return counters.ReadWriteInt(stm).Create("counter", 0)
+	})
+	require.NoError(t, err)
+	var eg errgroup.Group
+	for i := 0; i < 10; i++ {
+		eg.Go(func() error {
+			for j := 0; j < 10; j++ {
+				if _, err := NewStoreSTM(context.Background(), store, func(stm STM) error {
+					return counters.ReadWriteInt(stm).Increment("counter")
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	require.NoError(t, eg.Wait())
+	_, err = NewStoreSTM(context.Background(), store, func(stm STM) error {
+		n, err := counters.ReadWriteInt(stm).Get("counter")
+		if err != nil {
+			return err
+		}
+		require.Equal(t, 100, n)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// listAll lists the items of 'c' in pages of 'pageSize' items, and returns
+// their keys. If 'betweenPages' is set, it's called after each page with the
+// page's keys.
+func listAll(t *testing.T, c ReadonlyCollection, opts ListOptions, pageSize int64, betweenPages func(page []string)) []string {
+	var keys []string
+	opts.Limit = pageSize
+	for {
+		iter, err := c.ListWithOptions(opts)
+		require.NoError(t, err)
+		var page []string
+		for {
+			var key string
+			ok, err := iter.Next(&key, &pps.JobInfo{})
+			require.NoError(t, err)
+			if !ok {
+				break
+			}
+			page = append(page, key)
+			opts.PageToken = iter.PageToken()
+		}
+		keys = append(keys, page...)
+		if pageSize == 0 || int64(len(page)) < pageSize {
+			return keys
+		}
+		if betweenPages != nil {
+			betweenPages(page)
+		}
+	}
+}
+
+func TestListWithOptions(t *testing.T) {
+	store, err := kv.NewEmbedded("")
+	require.NoError(t, err)
+	defer store.Close()
+	jobInfos := NewStoreCollection(store, "jobs", []Index{pipelineIndex}, &pps.JobInfo{}, nil)
+	putJobs := func(jobInfos Collection, pipeline string, ids ...string) {
+		_, err := NewStoreSTM(context.Background(), store, func(stm STM) error {
+			for _, id := range ids {
+				if err := jobInfos.ReadWrite(stm).Put(id, &pps.JobInfo{
+					Job:      &pps.Job{ID: id},
+					Pipeline: &pps.Pipeline{Name: pipeline},
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	// Jobs that are put in the same transaction have the same revision
+	putJobs(jobInfos, "p1", "a")
+	putJobs(jobInfos, "p2", "d", "b", "e")
+	putJobs(jobInfos, "p1", "c")
+	putJobs(jobInfos, "p2", "f", "g")
+	// Modifying "a" changes its mod revision, but not its create revision
+	putJobs(jobInfos, "p1", "a")
+
+	readonly := jobInfos.ReadOnly(context.Background())
+	for _, pageSize := range []int64{0, 1, 2, 3, 10} {
+		require.Equal(t, []string{"a", "f", "g", "c", "b", "d", "e"}, listAll(t, readonly, ListOptions{}, pageSize, nil))
+		require.Equal(t, []string{"f", "g", "c", "b", "d", "e", "a"}, listAll(t, readonly, ListOptions{Target: SortByCreateRevision}, pageSize, nil))
+		require.Equal(t, []string{"a", "b", "d", "e", "c", "f", "g"}, listAll(t, readonly, ListOptions{Target: SortByCreateRevision, Order: SortAscend}, pageSize, nil))
+		require.Equal(t, []string{"b", "d", "e", "f", "g"}, listAll(t, readonly, ListOptions{
+			Target:   SortByCreateRevision,
+			Order:    SortAscend,
+			Index:    &pipelineIndex,
+			IndexVal: &pps.Pipeline{Name: "p2"},
+		}, pageSize, nil))
+	}
+
+	// Listings continue after the last item of the previous page even if
+	// it's deleted in between
+	deleteLast := func(jobInfos Collection) func(page []string) {
+		return func(page []string) {
+			_, err := NewStoreSTM(context.Background(), store, func(stm STM) error {
+				return jobInfos.ReadWrite(stm).Delete(page[len(page)-1])
+			})
+			require.NoError(t, err)
+		}
+	}
+	require.Equal(t, []string{"f", "g", "c", "b", "d", "e", "a"}, listAll(t, readonly, ListOptions{Target: SortByCreateRevision}, 2, deleteLast(jobInfos)))
+	// The last items of the first three pages were deleted
+	require.Equal(t, []string{"f", "c", "d", "a"}, listAll(t, readonly, ListOptions{Target: SortByCreateRevision}, 0, nil))
+	// Listings by index continue the same way
+	jobInfos2 := NewStoreCollection(store, "jobs2", []Index{pipelineIndex}, &pps.JobInfo{}, nil)
+	putJobs(jobInfos2, "p2", "d", "b", "e")
+	putJobs(jobInfos2, "p1", "c")
+	putJobs(jobInfos2, "p2", "f", "g")
+	require.Equal(t, []string{"b", "d", "e", "f", "g"}, listAll(t, jobInfos2.ReadOnly(context.Background()), ListOptions{
+		Target:   SortByCreateRevision,
+		Order:    SortAscend,
+		Index:    &pipelineIndex,
+		IndexVal: &pps.Pipeline{Name: "p2"},
+	}, 2, deleteLast(jobInfos2)))
+
+	// Page tokens must come from a listing
+	_, err = readonly.ListWithOptions(ListOptions{PageToken: "z"})
+	require.YesError(t, err)
+	_, err = readonly.ListWithOptions(ListOptions{PageToken: "0/z"})
+	require.YesError(t, err)
+}
+
 func getEtcdClient() (*etcd.Client, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   []string{"localhost:32379"},
@@ -20,6 +20,8 @@ package collection
 import (
 	v3 "github.com/coreos/etcd/clientv3"
 	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 )
 
 // STM is an interface for software transactional memory.
@@ -27,8 +29,9 @@ type STM interface {
 	// Get returns the value for a key and inserts the key in the txn's read set.
 	// If Get fails, it aborts the transaction with an error, never returning.
 	Get(key string) string
-	// Put adds a value for a key to the write set.
-	Put(key, val string, opts ...v3.OpOption)
+	// Put adds a value for a key to the write set. The key is deleted when
+	// 'lease' expires, unless it's kv.NoLease.
+	Put(key, val string, lease kv.LeaseID)
 	// Rev returns the revision of a key in the read set.
 	Rev(key string) int64
 	// Del deletes a key.
@@ -41,7 +44,7 @@ type STM interface {
 	Context() context.Context
 
 	// commit attempts to apply the txn's changes to the server.
-	commit() *v3.TxnResponse
+	commit() *kv.TxnResponse
 	reset()
 }
 
@@ -49,39 +52,45 @@ type STM interface {
 type stmError struct{ err error }
 
 // NewSTM intiates a new STM operation. It uses a serializable model.
-func NewSTM(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
+func NewSTM(ctx context.Context, c *v3.Client, apply func(STM) error) (*kv.TxnResponse, error) {
+	return NewStoreSTM(ctx, kv.NewEtcdStore(c), apply)
+}
+
+// NewStoreSTM is like NewSTM, except that it runs the transaction against any
+// kv.Store.
+func NewStoreSTM(ctx context.Context, c kv.Store, apply func(STM) error) (*kv.TxnResponse, error) {
 	return newSTMSerializable(ctx, c, apply)
 }
 
 // newSTMRepeatable initiates new repeatable read transaction; reads within
 // the same transaction attempt always return the same data.
-func newSTMRepeatable(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
-	s := &stm{client: c, ctx: ctx, getOpts: []v3.OpOption{v3.WithSerializable()}}
+func newSTMRepeatable(ctx context.Context, c kv.Store, apply func(STM) error) (*kv.TxnResponse, error) {
+	s := &stm{client: c, ctx: ctx}
 	return runSTM(s, apply)
 }
 
 // newSTMSerializable initiates a new serialized transaction; reads within the
 // same transactiona attempt return data from the revision of the first read.
-func newSTMSerializable(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
+func newSTMSerializable(ctx context.Context, c kv.Store, apply func(STM) error) (*kv.TxnResponse, error) {
 	s := &stmSerializable{
 		stm:      stm{client: c, ctx: ctx},
-		prefetch: make(map[string]*v3.GetResponse),
+		prefetch: make(map[string]*kv.GetResponse),
 	}
 	return runSTM(s, apply)
 }
 
 // newSTMReadCommitted initiates a new read committed transaction.
-func newSTMReadCommitted(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
-	s := &stmReadCommitted{stm{client: c, ctx: ctx, getOpts: []v3.OpOption{v3.WithSerializable()}}}
+func newSTMReadCommitted(ctx context.Context, c kv.Store, apply func(STM) error) (*kv.TxnResponse, error) {
+	s := &stmReadCommitted{stm{client: c, ctx: ctx}}
 	return runSTM(s, apply)
 }
 
 type stmResponse struct {
-	resp *v3.TxnResponse
+	resp *kv.TxnResponse
 	err  error
 }
 
-func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
+func runSTM(s STM, apply func(STM) error) (*kv.TxnResponse, error) {
 	outc := make(chan stmResponse, 1)
 	go func() {
 		defer func() {
@@ -110,21 +119,22 @@ func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
 	return r.resp, r.err
 }
 
-// stm implements repeatable-read software transactional memory over etcd
+// stm implements repeatable-read software transactional memory over a
+// kv.Store
 type stm struct {
-	client *v3.Client
+	client kv.Store
 	ctx    context.Context
 	// rset holds read key values and revisions
-	rset map[string]*v3.GetResponse
+	rset map[string]*kv.GetResponse
 	// wset holds overwritten keys and their values
 	wset map[string]stmPut
 	// getOpts are the opts used for gets
-	getOpts []v3.OpOption
+	getOpts kv.GetOptions
 }
 
 type stmPut struct {
 	val string
-	op  v3.Op
+	op  kv.Op
 }
 
 func (s *stm) Context() context.Context {
@@ -138,13 +148,13 @@ func (s *stm) Get(key string) string {
 	return respToValue(s.fetch(key))
 }
 
-func (s *stm) Put(key, val string, opts ...v3.OpOption) {
-	s.wset[key] = stmPut{val, v3.OpPut(key, val, opts...)}
+func (s *stm) Put(key, val string, lease kv.LeaseID) {
+	s.wset[key] = stmPut{val, kv.Put(key, val, lease)}
 }
 
-func (s *stm) Del(key string) { s.wset[key] = stmPut{"", v3.OpDelete(key)} }
+func (s *stm) Del(key string) { s.wset[key] = stmPut{"", kv.Delete(key)} }
 
-func (s *stm) DelAll(key string) { s.wset[key] = stmPut{"", v3.OpDelete(key, v3.WithPrefix())} }
+func (s *stm) DelAll(key string) { s.wset[key] = stmPut{"", kv.DeletePrefix(key)} }
 
 func (s *stm) Rev(key string) int64 {
 	if resp := s.fetch(key); resp != nil && len(resp.Kvs) != 0 {
@@ -153,8 +163,8 @@ func (s *stm) Rev(key string) int64 {
 	return 0
 }
 
-func (s *stm) commit() *v3.TxnResponse {
-	txnresp, err := s.client.Txn(s.ctx).If(s.cmps()...).Then(s.puts()...).Commit()
+func (s *stm) commit() *kv.TxnResponse {
+	txnresp, err := s.client.Txn(s.ctx, &kv.Txn{If: s.cmps(), Then: s.puts()})
 	if err != nil {
 		panic(stmError{err})
 	}
@@ -165,19 +175,19 @@ func (s *stm) commit() *v3.TxnResponse {
 }
 
 // cmps guards the txn from updates to read set
-func (s *stm) cmps() []v3.Cmp {
-	cmps := make([]v3.Cmp, 0, len(s.rset))
+func (s *stm) cmps() []kv.Cmp {
+	cmps := make([]kv.Cmp, 0, len(s.rset))
 	for k, rk := range s.rset {
 		cmps = append(cmps, isKeyCurrent(k, rk))
 	}
 	return cmps
 }
 
-func (s *stm) fetch(key string) *v3.GetResponse {
+func (s *stm) fetch(key string) *kv.GetResponse {
 	if resp, ok := s.rset[key]; ok {
 		return resp
 	}
-	resp, err := s.client.Get(s.ctx, key, s.getOpts...)
+	resp, err := s.client.Get(s.ctx, key, s.getOpts)
 	if err != nil {
 		panic(stmError{err})
 	}
@@ -186,8 +196,8 @@ func (s *stm) fetch(key string) *v3.GetResponse {
 }
 
 // puts is the list of ops for all pending writes
-func (s *stm) puts() []v3.Op {
-	puts := make([]v3.Op, 0, len(s.wset))
+func (s *stm) puts() []kv.Op {
+	puts := make([]kv.Op, 0, len(s.wset))
 	for _, v := range s.wset {
 		puts = append(puts, v.op)
 	}
@@ -195,13 +205,13 @@ func (s *stm) puts() []v3.Op {
 }
 
 func (s *stm) reset() {
-	s.rset = make(map[string]*v3.GetResponse)
+	s.rset = make(map[string]*kv.GetResponse)
 	s.wset = make(map[string]stmPut)
 }
 
 type stmSerializable struct {
 	stm
-	prefetch map[string]*v3.GetResponse
+	prefetch map[string]*kv.GetResponse
 }
 
 func (s *stmSerializable) Get(key string) string {
@@ -216,10 +226,7 @@ func (s *stmSerializable) Get(key string) string {
 	resp := s.stm.fetch(key)
 	if firstRead {
 		// txn's base revision is defined by the first read
-		s.getOpts = []v3.OpOption{
-			v3.WithRev(resp.Header.Revision),
-			v3.WithSerializable(),
-		}
+		s.getOpts = kv.GetOptions{Revision: resp.Revision}
 	}
 	return respToValue(resp)
 }
@@ -229,21 +236,18 @@ func (s *stmSerializable) Rev(key string) int64 {
 	return s.stm.Rev(key)
 }
 
-func (s *stmSerializable) gets() ([]string, []v3.Op) {
+func (s *stmSerializable) gets() []string {
 	keys := make([]string, 0, len(s.rset))
-	ops := make([]v3.Op, 0, len(s.rset))
 	for k := range s.rset {
 		keys = append(keys, k)
-		ops = append(ops, v3.OpGet(k))
 	}
-	return keys, ops
+	return keys
 }
 
-func (s *stmSerializable) commit() *v3.TxnResponse {
-	keys, getops := s.gets()
-	txn := s.client.Txn(s.ctx).If(s.cmps()...).Then(s.puts()...)
+func (s *stmSerializable) commit() *kv.TxnResponse {
+	keys := s.gets()
 	// use Else to prefetch keys in case of conflict to save a round trip
-	txnresp, err := txn.Else(getops...).Commit()
+	txnresp, err := s.client.Txn(s.ctx, &kv.Txn{If: s.cmps(), Then: s.puts(), Else: keys})
 	if err != nil {
 		panic(stmError{err})
 	}
@@ -252,30 +256,29 @@ func (s *stmSerializable) commit() *v3.TxnResponse {
 	}
 	// load prefetch with Else data
 	for i := range keys {
-		resp := txnresp.Responses[i].GetResponseRange()
-		s.rset[keys[i]] = (*v3.GetResponse)(resp)
+		s.rset[keys[i]] = txnresp.Else[i]
 	}
 	s.prefetch = s.rset
-	s.getOpts = nil
+	s.getOpts = kv.GetOptions{}
 	return nil
 }
 
 type stmReadCommitted struct{ stm }
 
 // commit always goes through when read committed
-func (s *stmReadCommitted) commit() *v3.TxnResponse {
+func (s *stmReadCommitted) commit() *kv.TxnResponse {
 	s.rset = nil
 	return s.stm.commit()
 }
 
-func isKeyCurrent(k string, r *v3.GetResponse) v3.Cmp {
+func isKeyCurrent(k string, r *kv.GetResponse) kv.Cmp {
 	if len(r.Kvs) != 0 {
-		return v3.Compare(v3.ModRevision(k), "=", r.Kvs[0].ModRevision)
+		return kv.Cmp{Key: k, ModRevision: r.Kvs[0].ModRevision}
 	}
-	return v3.Compare(v3.ModRevision(k), "=", 0)
+	return kv.Cmp{Key: k}
 }
 
-func respToValue(resp *v3.GetResponse) string {
+func respToValue(resp *kv.GetResponse) string {
 	if len(resp.Kvs) == 0 {
 		return ""
 	}
@@ -82,11 +82,53 @@ type ReadWriteIntCollection interface {
 	Delete(key string) error
 }
 
+// SortTarget is what a listing of a collection is sorted by.
+type SortTarget int
+
+const (
+	// SortByModRevision sorts items by when they were last modified
+	SortByModRevision SortTarget = iota
+	// SortByCreateRevision sorts items by when they were created
+	SortByCreateRevision
+)
+
+// SortOrder is the order of a listing of a collection.
+type SortOrder int
+
+const (
+	// SortDescend lists the newest items first
+	SortDescend SortOrder = iota
+	// SortAscend lists the oldest items first
+	SortAscend
+)
+
+// ListOptions modifies a listing of a collection.  Items that have the same
+// revision are listed in the order of their keys, so that a listing can be
+// continued from any item.
+type ListOptions struct {
+	Target SortTarget
+	Order  SortOrder
+	// PageToken, if set, lists the items that come after the item that the
+	// token was returned for (see ListIterator), which continues a listing
+	// from its last item.  The item needn't still exist.
+	PageToken string
+	// Limit is the maximum number of items listed.  If it's 0, all items
+	// are listed.
+	Limit int64
+	// Index, if set, only lists the items whose value for the index is
+	// IndexVal.  Items are sorted by the revisions of their index entries.
+	Index    *Index
+	IndexVal interface{}
+}
+
 // ReadonlyCollection is a collection interface that only supports read ops.
 type ReadonlyCollection interface {
 	Get(key string, val proto.Unmarshaler) error
 	GetByIndex(index Index, val interface{}) (Iterator, error)
 	List() (Iterator, error)
+	// ListWithOptions is like List, except that 'opts' sort, filter and
+	// limit the listed items.
+	ListWithOptions(opts ListOptions) (ListIterator, error)
 	Count() (int64, error)
 	Watch() (watch.Watcher, error)
 	// WatchWithPrev is like Watch, but the events will include the previous
@@ -104,3 +146,12 @@ type Iterator interface {
 	// collection has been exhausted.
 	Next(key *string, val proto.Unmarshaler) (ok bool, retErr error)
 }
+
+// ListIterator is an Iterator over a listing of a collection.
+type ListIterator interface {
+	Iterator
+	// PageToken returns a token that continues the listing after the last
+	// item that Next returned (see ListOptions), or "" if Next hasn't
+	// returned an item.
+	PageToken() string
+}
@@ -9,6 +9,7 @@ import (
 
 	auth "github.com/pachyderm/pachyderm/src/server/auth/server"
 	pfs "github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/ugorji/go/codec"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/resource"
@@ -60,7 +61,11 @@ const (
 	googleBackend
 	microsoftBackend
 	minioBackend
-	s3CustomArgs = 6
+	// localDirBackend stores objects in a directory on the host (which may be
+	// an NFS mount shared by all nodes), rather than in an object store
+	localDirBackend
+	s3CustomArgs    = 6
+	localCustomArgs = 3
 )
 
 // AssetOpts are options that are applicable to all the asset types.
@@ -101,6 +106,18 @@ type AssetOpts struct {
 	// EtcdMemRequest is the amount of memory we request for each etcd node. If
 	// empty, assets.go will choose a default size.
 	EtcdMemRequest string
+
+	// PFSCacheSize is the amount of disk that each pachd node's cache of
+	// hashtrees may use. If empty, assets.go will choose a default size.
+	PFSCacheSize string
+
+	// PFSCacheHostPath is the directory on each node in which pachd caches
+	// hashtrees, so that the cache survives pachd being restarted or
+	// rescheduled onto the same node. If empty, the cache is kept in the
+	// pod's scratch space (or, for local deployments, under the host path).
+	// The version of the kubernetes API that we use can't limit the size of
+	// either, so the cache is kept under PFSCacheSize by pachd itself.
+	PFSCacheHostPath string
 }
 
 // fillDefaultResourceRequests sets any of:
@@ -109,6 +126,7 @@ type AssetOpts struct {
 //   opts.PachdCPURequest
 //   opts.EtcdCPURequest
 //   opts.EtcdMemRequest
+//   opts.PFSCacheSize
 // that are unset in 'opts' to the appropriate default ('persistentDiskBackend'
 // just used to determine if this is a local deployment, and if so, make the
 // resource requests smaller)
@@ -132,6 +150,9 @@ func fillDefaultResourceRequests(opts *AssetOpts, persistentDiskBackend backend)
 		if opts.EtcdCPURequest == "" {
 			opts.EtcdCPURequest = "0.25"
 		}
+		if opts.PFSCacheSize == "" {
+			opts.PFSCacheSize = "1G"
+		}
 	} else {
 		// For non-local deployments, we set the resource requirements and cache
 		// sizes higher, so that the cluster is stable and performant
@@ -151,6 +172,9 @@ func fillDefaultResourceRequests(opts *AssetOpts, persistentDiskBackend backend)
 		if opts.EtcdCPURequest == "" {
 			opts.EtcdCPURequest = "1"
 		}
+		if opts.PFSCacheSize == "" {
+			opts.PFSCacheSize = "10G"
+		}
 	}
 }
 
@@ -225,6 +249,46 @@ func GetSecretVolumeAndMount(backend string) (api.Volume, api.VolumeMount, error
 	return api.Volume{}, api.VolumeMount{}, fmt.Errorf("not found")
 }
 
+// GetMirrorSecretVolumesAndMounts returns the volumes and mounts for the
+// secrets of each of the object stores in mirrorURL (see obj.ParseURL).
+func GetMirrorSecretVolumesAndMounts(mirrorURL string) ([]api.Volume, []api.VolumeMount, error) {
+	url, err := obj.ParseURL(mirrorURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	mirrors := url.Mirrors
+	if url.Store != "mirror" {
+		mirrors = []*obj.ObjectStoreURL{url}
+	}
+	var volumes []api.Volume
+	var mounts []api.VolumeMount
+	seen := make(map[string]bool)
+	for _, mirror := range mirrors {
+		var backend string
+		switch mirror.Store {
+		case "s3":
+			backend = pfs.AmazonBackendEnvVar
+		case "gcs", "gs":
+			backend = pfs.GoogleBackendEnvVar
+		case "as", "wasb":
+			backend = pfs.MicrosoftBackendEnvVar
+		default:
+			continue
+		}
+		if seen[backend] {
+			continue
+		}
+		seen[backend] = true
+		volume, mount, err := GetSecretVolumeAndMount(backend)
+		if err != nil {
+			return nil, nil, err
+		}
+		volumes = append(volumes, volume)
+		mounts = append(mounts, mount)
+	}
+	return volumes, mounts, nil
+}
+
 // PachdDeployment returns a pachd k8s Deployment.
 func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath string) *extensions.Deployment {
 	mem := resource.MustParse(opts.BlockCacheSize)
@@ -238,12 +302,34 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 		{
 			Name: "pach-disk",
 		},
+		{
+			// pachd caches hashtrees here. See AssetOpts.PFSCacheHostPath.
+			Name: "pach-cache",
+		},
 	}
 	volumeMounts := []api.VolumeMount{
 		{
 			Name:      "pach-disk",
 			MountPath: "/pach",
 		},
+		{
+			Name:      "pach-cache",
+			MountPath: "/pach-cache",
+		},
+	}
+
+	// Keep the hashtree cache on the node if we can, so that it survives pachd
+	// restarts
+	cacheHostPath := opts.PFSCacheHostPath
+	if cacheHostPath == "" && objectStoreBackend == localBackend {
+		cacheHostPath = filepath.Join(hostPath, "pachd-cache")
+	}
+	if cacheHostPath != "" {
+		volumes[1].HostPath = &api.HostPathVolumeSource{
+			Path: cacheHostPath,
+		}
+	} else {
+		volumes[1].EmptyDir = &api.EmptyDirVolumeSource{}
 	}
 
 	// Set up storage options
@@ -263,6 +349,12 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 		backendEnvVar = pfs.GoogleBackendEnvVar
 	case microsoftBackend:
 		backendEnvVar = pfs.MicrosoftBackendEnvVar
+	case localDirBackend:
+		backendEnvVar = pfs.LocalBackendEnvVar
+		storageHostPath = hostPath
+		volumes[0].HostPath = &api.HostPathVolumeSource{
+			Path: storageHostPath,
+		}
 	}
 	volume, mount, err := GetSecretVolumeAndMount(backendEnvVar)
 	if err == nil {
@@ -347,6 +439,14 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 									Name:  "BLOCK_CACHE_BYTES",
 									Value: opts.BlockCacheSize,
 								},
+								{
+									Name:  "PFS_DISK_CACHE_DIR",
+									Value: "/pach-cache",
+								},
+								{
+									Name:  "PFS_DISK_CACHE_BYTES",
+									Value: opts.PFSCacheSize,
+								},
 								{
 									Name:  auth.DisableAuthenticationEnvVar,
 									Value: strconv.FormatBool(opts.DisableAuthentication),
@@ -1138,6 +1238,28 @@ func WriteCustomAssets(w io.Writer, opts *AssetOpts, args []string, objectStoreB
 		MinioSecret(args[2], args[3], args[4], args[5], secure).CodecEncodeSelf(encoder)
 		fmt.Fprintf(w, "\n")
 		return nil
+	case "local":
+		if len(args) != localCustomArgs {
+			return fmt.Errorf("Expected %d arguments for disk+local backend", localCustomArgs)
+		}
+		volumeSize, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("volume size needs to be an integer; instead got %v", args[1])
+		}
+		var diskBackend backend
+		switch persistentDiskBackend {
+		case "aws":
+			diskBackend = amazonBackend
+		case "google":
+			diskBackend = googleBackend
+		case "azure":
+			diskBackend = microsoftBackend
+		default:
+			return fmt.Errorf("Did not recognize the choice of persistent-disk")
+		}
+		// Objects are stored in args[2] on each host, so pachd doesn't need
+		// any object store credentials
+		return WriteAssets(w, opts, localDirBackend, diskBackend, volumeSize, args[2])
 	default:
 		return fmt.Errorf("Did not recognize the choice of object-store")
 	}
@@ -57,6 +57,8 @@ func DeployCmd(noMetrics *bool) *cobra.Command {
 	var pachdCPURequest string
 	var pachdNonCacheMemRequest string
 	var blockCacheSize string
+	var pfsCacheSize string
+	var pfsCacheHostPath string
 	var etcdCPURequest string
 	var etcdMemRequest string
 	var logLevel string
@@ -138,8 +140,12 @@ func DeployCmd(noMetrics *bool) *cobra.Command {
 		Short: "(in progress) Deploy a custom Pachyderm cluster configuration",
 		Long: "(in progress) Deploy a custom Pachyderm cluster configuration.\n" +
 			"If <object store backend> is \"s3\", then the arguments are:\n" +
-			"    <volumes> <size of volumes (in GB)> <bucket> <id> <secret> <endpoint>\n",
-		Run: pkgcobra.RunBoundedArgs(pkgcobra.Bounds{Min: 4, Max: 7}, func(args []string) (retErr error) {
+			"    <volumes> <size of volumes (in GB)> <bucket> <id> <secret> <endpoint>\n" +
+			"If <object store backend> is \"local\", then the arguments are:\n" +
+			"    <volumes> <size of volumes (in GB)> <storage path>\n" +
+			"where <storage path> is a directory on every host (e.g. a shared NFS mount)\n" +
+			"in which pachyderm stores its data.\n",
+		Run: pkgcobra.RunBoundedArgs(pkgcobra.Bounds{Min: 3, Max: 7}, func(args []string) (retErr error) {
 			if metrics && !dev {
 				start := time.Now()
 				startMetricsWait := _metrics.StartReportAndFlushUserAction("Deploy", start)
@@ -163,7 +169,7 @@ func DeployCmd(noMetrics *bool) *cobra.Command {
 			"One of: aws, google, or azure.")
 	deployCustom.Flags().StringVar(&objectStoreBackend, "object-store", "s3",
 		"(required) Backend providing an object-storage API to pachyderm. One of: "+
-			"s3, gcs, or azure-blob.")
+			"s3, local, gcs, or azure-blob.")
 	var cloudfrontDistribution string
 	deployAmazon := &cobra.Command{
 		Use:   "amazon <S3 bucket> <id> <secret> <token> <region> <size of volumes (in GB)>",
@@ -254,6 +260,8 @@ func DeployCmd(noMetrics *bool) *cobra.Command {
 				PachdCPURequest:         pachdCPURequest,
 				PachdNonCacheMemRequest: pachdNonCacheMemRequest,
 				BlockCacheSize:          blockCacheSize,
+				PFSCacheSize:            pfsCacheSize,
+				PFSCacheHostPath:        pfsCacheHostPath,
 				EtcdCPURequest:          etcdCPURequest,
 				EtcdMemRequest:          etcdMemRequest,
 				EtcdNodes:               etcdNodes,
@@ -292,6 +300,14 @@ func DeployCmd(noMetrics *bool) *cobra.Command {
 	deploy.PersistentFlags().StringVar(&blockCacheSize, "block-cache-size", "",
 		"Size of pachd's in-memory cache for PFS files. Size is specified in "+
 			"bytes, with allowed SI suffixes (M, K, G, Mi, Ki, Gi, etc).")
+	deploy.PersistentFlags().StringVar(&pfsCacheSize, "pfs-cache-size", "",
+		"Size of pachd's on-disk cache for hashtrees. Size is specified in "+
+			"bytes, with allowed SI suffixes (M, K, G, Mi, Ki, Gi, etc).")
+	deploy.PersistentFlags().StringVar(&pfsCacheHostPath, "pfs-cache-host-path", "",
+		"The directory on each node in which pachd caches hashtrees, so that "+
+			"the cache survives pachd restarts. If empty, the cache is lost "+
+			"whenever pachd's pod is deleted (except for local deployments, "+
+			"which keep it under --host-path).")
 	deploy.PersistentFlags().StringVar(&pachdNonCacheMemRequest,
 		"pachd-memory-request", "", "(rarely set) The size of PachD's memory "+
 			"request in addition to its block cache (set via --block-cache-size). "+
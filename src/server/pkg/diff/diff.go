@@ -0,0 +1,290 @@
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// maxLCSSize is the largest (number of old lines) * (number of new lines) for
+// which the lines between two unique common lines are diffed with a full LCS.
+// Larger regions are reported as a single replacement.
+const maxLCSSize = 1 << 20
+
+// editKind identifies what happened to a line in an edit script.
+type editKind byte
+
+const (
+	equal    editKind = ' '
+	deleted  editKind = '-'
+	inserted editKind = '+'
+)
+
+type edit struct {
+	kind editKind
+	line string
+}
+
+// Unified returns a unified diff, with 'context' lines of context around each
+// change, that turns oldText (labelled oldName) into newText (labelled
+// newName). If the texts are identical, the empty string is returned.
+func Unified(oldName string, newName string, oldText string, newText string, context int) string {
+	edits := diffLines(splitLines(oldText), splitLines(newText))
+	var buf bytes.Buffer
+	for i := 0; i < len(edits); {
+		// Find the next change
+		for i < len(edits) && edits[i].kind == equal {
+			i++
+		}
+		if i == len(edits) {
+			break
+		}
+		// A hunk starts 'context' lines before the change and extends until
+		// more than 2*context unchanged lines separate it from the next change
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for end < len(edits) {
+			if edits[end].kind != equal {
+				end++
+				continue
+			}
+			run := end
+			for run < len(edits) && edits[run].kind == equal {
+				run++
+			}
+			if run == len(edits) || run-end > 2*context {
+				end += context
+				if end > run {
+					end = run
+				}
+				break
+			}
+			end = run
+		}
+		if buf.Len() == 0 {
+			fmt.Fprintf(&buf, "--- %s\n+++ %s\n", oldName, newName)
+		}
+		writeHunk(&buf, edits, start, end)
+		i = end
+	}
+	return buf.String()
+}
+
+// writeHunk writes edits[start:end] to 'buf' as a unified diff hunk.
+func writeHunk(buf *bytes.Buffer, edits []edit, start int, end int) {
+	// Compute the line numbers at which the hunk starts
+	oldStart, newStart := 1, 1
+	for _, e := range edits[:start] {
+		if e.kind != inserted {
+			oldStart++
+		}
+		if e.kind != deleted {
+			newStart++
+		}
+	}
+	var oldCount, newCount int
+	for _, e := range edits[start:end] {
+		if e.kind != inserted {
+			oldCount++
+		}
+		if e.kind != deleted {
+			newCount++
+		}
+	}
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+	for _, e := range edits[start:end] {
+		buf.WriteByte(byte(e.kind))
+		buf.WriteString(e.line)
+		if !strings.HasSuffix(e.line, "\n") {
+			buf.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		// An empty range is identified by the line before it
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// splitLines splits 'text' into lines, each of which retains its trailing
+// newline (the last line may not have one).
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns an edit script that turns 'a' into 'b'. It uses patience
+// diff: lines that occur exactly once in both 'a' and 'b' are matched up and
+// the regions between them are diffed recursively.
+func diffLines(a []string, b []string) []edit {
+	// Strip the common prefix and suffix
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	var result []edit
+	for _, line := range a[:prefix] {
+		result = append(result, edit{equal, line})
+	}
+	result = append(result, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		result = append(result, edit{equal, line})
+	}
+	return result
+}
+
+// diffMiddle diffs 'a' and 'b', which have no common prefix or suffix.
+func diffMiddle(a []string, b []string) []edit {
+	if len(a) == 0 || len(b) == 0 {
+		return replace(a, b)
+	}
+	anchors := uniqueCommonLines(a, b)
+	if len(anchors) == 0 {
+		if len(a)*len(b) <= maxLCSSize {
+			return lcs(a, b)
+		}
+		return replace(a, b)
+	}
+	var result []edit
+	var i, j int
+	for _, anchor := range anchors {
+		result = append(result, diffLines(a[i:anchor[0]], b[j:anchor[1]])...)
+		result = append(result, edit{equal, a[anchor[0]]})
+		i, j = anchor[0]+1, anchor[1]+1
+	}
+	return append(result, diffLines(a[i:], b[j:])...)
+}
+
+// replace returns an edit script that deletes all of 'a' and inserts all of
+// 'b'.
+func replace(a []string, b []string) []edit {
+	var result []edit
+	for _, line := range a {
+		result = append(result, edit{deleted, line})
+	}
+	for _, line := range b {
+		result = append(result, edit{inserted, line})
+	}
+	return result
+}
+
+// uniqueCommonLines returns the pairs of indices (i, j) such that a[i] ==
+// b[j] and a[i] occurs exactly once in each of 'a' and 'b'. Of those pairs,
+// the longest sequence that is increasing in both i and j is returned.
+func uniqueCommonLines(a []string, b []string) [][2]int {
+	type count struct {
+		a, b   int
+		aIndex int
+		bIndex int
+	}
+	counts := make(map[string]*count)
+	for i, line := range a {
+		c, ok := counts[line]
+		if !ok {
+			c = &count{}
+			counts[line] = c
+		}
+		c.a++
+		c.aIndex = i
+	}
+	for j, line := range b {
+		if c, ok := counts[line]; ok {
+			c.b++
+			c.bIndex = j
+		}
+	}
+	var pairs [][2]int
+	for i, line := range a {
+		if c := counts[line]; c.a == 1 && c.b == 1 {
+			pairs = append(pairs, [2]int{i, c.bIndex})
+		}
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	// Find the longest increasing subsequence of 'pairs' by j using patience
+	// sorting. tails[k] is the index in 'pairs' of the smallest last element of
+	// an increasing subsequence of length k+1.
+	var tails []int
+	prev := make([]int, len(pairs))
+	for p, pair := range pairs {
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if pairs[tails[mid]][1] < pair[1] {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo > 0 {
+			prev[p] = tails[lo-1]
+		} else {
+			prev[p] = -1
+		}
+		if lo == len(tails) {
+			tails = append(tails, p)
+		} else {
+			tails[lo] = p
+		}
+	}
+	result := make([][2]int, len(tails))
+	for k, p := len(tails)-1, tails[len(tails)-1]; k >= 0; k, p = k-1, prev[p] {
+		result[k] = pairs[p]
+	}
+	return result
+}
+
+// lcs diffs 'a' and 'b' by computing their longest common subsequence.
+func lcs(a []string, b []string) []edit {
+	// lengths[i][j] is the length of the LCS of a[i:] and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	var result []edit
+	var i, j int
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, edit{equal, a[i]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			result = append(result, edit{deleted, a[i]})
+			i++
+		default:
+			result = append(result, edit{inserted, b[j]})
+			j++
+		}
+	}
+	return append(result, replace(a[i:], b[j:])...)
+}
@@ -0,0 +1,133 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestUnifiedIdentical(t *testing.T) {
+	require.Equal(t, "", Unified("a", "b", "foo\nbar\n", "foo\nbar\n", 3))
+	require.Equal(t, "", Unified("a", "b", "", "", 3))
+}
+
+func TestUnifiedAddRemove(t *testing.T) {
+	require.Equal(t, "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+foo\n+bar\n", Unified("a", "b", "", "foo\nbar\n", 3))
+	require.Equal(t, "--- a\n+++ b\n@@ -1,2 +0,0 @@\n-foo\n-bar\n", Unified("a", "b", "foo\nbar\n", "", 3))
+}
+
+func TestUnifiedContext(t *testing.T) {
+	var oldLines, newLines []string
+	for i := 0; i < 20; i++ {
+		line := string('a' + rune(i))
+		oldLines = append(oldLines, line)
+		if i == 2 {
+			newLines = append(newLines, "X")
+		} else if i != 15 {
+			newLines = append(newLines, line)
+		}
+	}
+	oldText := strings.Join(oldLines, "\n") + "\n"
+	newText := strings.Join(newLines, "\n") + "\n"
+	expected := `--- a
++++ b
+@@ -1,5 +1,5 @@
+ a
+ b
+-c
++X
+ d
+ e
+@@ -14,5 +14,4 @@
+ n
+ o
+-p
+ q
+ r
+`
+	require.Equal(t, expected, Unified("a", "b", oldText, newText, 2))
+}
+
+func TestUnifiedMergedHunks(t *testing.T) {
+	oldText := "a\nb\nc\nd\ne\nf\n"
+	newText := "A\nb\nc\nd\ne\nF\n"
+	expected := `--- a
++++ b
+@@ -1,6 +1,6 @@
+-a
++A
+ b
+ c
+ d
+ e
+-f
++F
+`
+	require.Equal(t, expected, Unified("a", "b", oldText, newText, 2))
+}
+
+func TestUnifiedNoNewline(t *testing.T) {
+	expected := `--- a
++++ b
+@@ -1,2 +1,2 @@
+ foo
+-bar
+\ No newline at end of file
++bar
+`
+	require.Equal(t, expected, Unified("a", "b", "foo\nbar", "foo\nbar\n", 3))
+}
+
+func TestUnifiedRepeatedLines(t *testing.T) {
+	// No line is unique, so the LCS fallback is used
+	expected := `--- a
++++ b
+@@ -1,4 +1,4 @@
+-x
+ y
+ x
+ y
++x
+`
+	require.Equal(t, expected, Unified("a", "b", "x\ny\nx\ny\n", "y\nx\ny\nx\n", 3))
+}
+
+func TestJSON(t *testing.T) {
+	oldData := []byte(`{"name": "cat", "labels": [{"id": 1}, {"id": 2}], "size": 3, "removed": true}`)
+	newData := []byte(`{"name": "dog", "labels": [{"id": 1}, {"id": 3}, {"id": 4}], "size": 3, "added": null}`)
+	keyDiffs, err := JSON(oldData, newData)
+	require.NoError(t, err)
+	require.Equal(t, []*KeyDiff{
+		{Key: "added", Old: "", New: "null"},
+		{Key: "labels[1].id", Old: "2", New: "3"},
+		{Key: "labels[2]", Old: "", New: `{"id":4}`},
+		{Key: "name", Old: `"cat"`, New: `"dog"`},
+		{Key: "removed", Old: "true", New: ""},
+	}, keyDiffs)
+}
+
+func TestJSONArrayOrder(t *testing.T) {
+	keyDiffs, err := JSON([]byte(`{"a": [0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11]}`), []byte(`{"a": [1, 1, 3, 3, 4, 5, 6, 7, 8, 9, 11, 11, 12], "a0": 0}`))
+	require.NoError(t, err)
+	require.Equal(t, []*KeyDiff{
+		{Key: "a[0]", Old: "0", New: "1"},
+		{Key: "a[2]", Old: "2", New: "3"},
+		{Key: "a[10]", Old: "10", New: "11"},
+		{Key: "a[12]", Old: "", New: "12"},
+		{Key: "a0", Old: "", New: "0"},
+	}, keyDiffs)
+}
+
+func TestJSONTypeChange(t *testing.T) {
+	keyDiffs, err := JSON([]byte(`{"a": [1]}`), []byte(`{"a": {"b": 1}}`))
+	require.NoError(t, err)
+	require.Equal(t, []*KeyDiff{{Key: "a", Old: "[1]", New: `{"b":1}`}}, keyDiffs)
+
+	keyDiffs, err = JSON(nil, []byte(`[1]`))
+	require.NoError(t, err)
+	require.Equal(t, []*KeyDiff{{Key: "", Old: "", New: "[1]"}}, keyDiffs)
+
+	_, err = JSON([]byte(`{`), []byte(`{}`))
+	require.YesError(t, err)
+}
@@ -0,0 +1,160 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// KeyDiff describes a change to the value at one key of a JSON document.
+type KeyDiff struct {
+	// Key identifies the changed value, e.g. "labels[0].name". The empty key
+	// refers to the document as a whole.
+	Key string
+	// Old and New are the JSON encodings of the old and new values. Old is
+	// empty if the key was added, and New is empty if the key was removed.
+	Old string
+	New string
+}
+
+// JSON returns the keys at which the JSON documents oldData and newData
+// differ, sorted by key, with array elements in index order. Objects are
+// compared key by key and arrays element by element; any other values are
+// compared as a whole. Empty input is treated as a missing document.
+func JSON(oldData []byte, newData []byte) ([]*KeyDiff, error) {
+	var oldValue, newValue interface{}
+	if len(oldData) > 0 {
+		if err := json.Unmarshal(oldData, &oldValue); err != nil {
+			return nil, err
+		}
+	}
+	if len(newData) > 0 {
+		if err := json.Unmarshal(newData, &newValue); err != nil {
+			return nil, err
+		}
+	}
+	var diffs []keyDiff
+	if err := diffValues("", nil, oldValue, len(oldData) > 0, newValue, len(newData) > 0, &diffs); err != nil {
+		return nil, err
+	}
+	sort.Slice(diffs, func(i, j int) bool { return lessPath(diffs[i].path, diffs[j].path) })
+	var result []*KeyDiff
+	for _, diff := range diffs {
+		result = append(result, diff.KeyDiff)
+	}
+	return result, nil
+}
+
+// keySegment is one step of the path to a value in a JSON document: either
+// an object key or, if isIndex is set, an array index.
+type keySegment struct {
+	name    string
+	index   int
+	isIndex bool
+}
+
+// keyDiff is a KeyDiff along with the path to its key, by which diffs are
+// sorted, since "[10]" sorts before "[2]" as a string.
+type keyDiff struct {
+	*KeyDiff
+	path []keySegment
+}
+
+// lessPath orders paths segment by segment, comparing array indexes
+// numerically. A path sorts before the paths of the values inside it.
+func lessPath(a []keySegment, b []keySegment) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i].isIndex && b[i].isIndex {
+			if a[i].index != b[i].index {
+				return a[i].index < b[i].index
+			}
+			continue
+		}
+		if a[i].isIndex != b[i].isIndex {
+			// Siblings are either all keys or all indexes, but keep the
+			// order total anyway
+			return a[i].isIndex
+		}
+		if a[i].name != b[i].name {
+			return a[i].name < b[i].name
+		}
+	}
+	return len(a) < len(b)
+}
+
+func diffValues(key string, path []keySegment, oldValue interface{}, oldOK bool, newValue interface{}, newOK bool, result *[]keyDiff) error {
+	if oldOK && newOK {
+		switch oldValue := oldValue.(type) {
+		case map[string]interface{}:
+			if newValue, ok := newValue.(map[string]interface{}); ok {
+				keys := make(map[string]bool)
+				for k := range oldValue {
+					keys[k] = true
+				}
+				for k := range newValue {
+					keys[k] = true
+				}
+				for k := range keys {
+					o, oOK := oldValue[k]
+					n, nOK := newValue[k]
+					if err := diffValues(joinKey(key, k), appendSegment(path, keySegment{name: k}), o, oOK, n, nOK, result); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		case []interface{}:
+			if newValue, ok := newValue.([]interface{}); ok {
+				for i := 0; i < len(oldValue) || i < len(newValue); i++ {
+					var o, n interface{}
+					if i < len(oldValue) {
+						o = oldValue[i]
+					}
+					if i < len(newValue) {
+						n = newValue[i]
+					}
+					if err := diffValues(fmt.Sprintf("%s[%d]", key, i), appendSegment(path, keySegment{index: i, isIndex: true}), o, i < len(oldValue), n, i < len(newValue), result); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		}
+		if reflect.DeepEqual(oldValue, newValue) {
+			return nil
+		}
+	}
+	diff := &KeyDiff{Key: key}
+	if oldOK {
+		data, err := json.Marshal(oldValue)
+		if err != nil {
+			return err
+		}
+		diff.Old = string(data)
+	}
+	if newOK {
+		data, err := json.Marshal(newValue)
+		if err != nil {
+			return err
+		}
+		diff.New = string(data)
+	}
+	*result = append(*result, keyDiff{KeyDiff: diff, path: path})
+	return nil
+}
+
+// appendSegment returns a copy of 'path' with 'segment' appended, so that
+// sibling values don't share the backing array of their paths.
+func appendSegment(path []keySegment, segment keySegment) []keySegment {
+	result := make([]keySegment, len(path), len(path)+1)
+	copy(result, path)
+	return append(result, segment)
+}
+
+func joinKey(key string, k string) string {
+	if key == "" {
+		return k
+	}
+	return key + "." + k
+}
@@ -0,0 +1,231 @@
+// Package diskcache implements a size-bounded LRU cache of byte slices that's
+// stored on local disk, so that its contents survive process restarts.
+package diskcache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// tmpPrefix is the prefix of files that are still being written. They're
+// removed when the cache is opened.
+const tmpPrefix = "tmp-"
+
+// Stats are statistics about a Cache's usage.
+type Stats struct {
+	Hits        int64 // Gets that found their key
+	Misses      int64 // Gets that didn't find their key
+	Corruptions int64 // entries that failed their integrity check and were removed
+	Evictions   int64 // entries removed to make room for new ones
+	Entries     int64 // entries currently in the cache
+	Bytes       int64 // size of the entries currently in the cache
+}
+
+// Cache is an LRU cache of byte slices stored in a directory on local disk.
+// Each entry is stored in its own file along with a checksum of its contents,
+// and entries that fail the check are treated as missing. Entries left in the
+// directory by a previous process are reused. It's safe for concurrent use.
+type Cache struct {
+	dir      string
+	maxBytes int64
+
+	// mu protects everything below. Entries are read and checked without
+	// holding it.
+	mu sync.Mutex
+	// lru holds the entries in the cache, most recently used first
+	lru *list.List
+	// entries maps the file name of each entry to its element in 'lru'
+	entries map[string]*list.Element
+	// version is incremented each time an entry is written
+	version int64
+	stats   Stats
+}
+
+// entry is an element of Cache.lru
+type entry struct {
+	name string
+	// size is the size of the entry's file
+	size int64
+	// version identifies the write that produced the entry's file, so that
+	// a Get that finds a corrupt file doesn't remove one that was put since
+	version int64
+}
+
+// NewCache returns a Cache that stores up to 'maxBytes' bytes in 'dir',
+// creating 'dir' if it doesn't exist.
+func NewCache(dir string, maxBytes int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("could not create cache directory %s: %v", dir, err)
+	}
+	c := &Cache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read cache directory %s: %v", dir, err)
+	}
+	// Reuse the entries written by previous processes, treating the most
+	// recently modified ones as the most recently used
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().After(infos[j].ModTime())
+	})
+	for _, info := range infos {
+		if info.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(info.Name(), tmpPrefix) {
+			os.Remove(filepath.Join(dir, info.Name()))
+			continue
+		}
+		c.entries[info.Name()] = c.lru.PushBack(&entry{
+			name: info.Name(),
+			size: info.Size(),
+		})
+		c.stats.Entries++
+		c.stats.Bytes += info.Size()
+	}
+	c.evict()
+	return c, nil
+}
+
+// Get returns the value stored under 'key', and whether it was found. The
+// entry is read and checked without holding the cache's lock, so Gets of
+// different keys don't wait for each other's disk reads.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	name := fileName(key)
+	c.mu.Lock()
+	elem, ok := c.entries[name]
+	if !ok {
+		c.stats.Misses++
+		c.mu.Unlock()
+		return nil, false
+	}
+	version := elem.Value.(*entry).version
+	c.mu.Unlock()
+
+	// Puts replace entries by renaming, so this reads either the old file or
+	// the new one, never a mix of the two
+	data, err := ioutil.ReadFile(filepath.Join(c.dir, name))
+	valid := false
+	if err == nil && len(data) >= sha256.Size {
+		sum := sha256.Sum256(data[sha256.Size:])
+		valid = bytes.Equal(sum[:], data[:sha256.Size])
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current := c.entries[name] == elem
+	if valid {
+		if current {
+			c.lru.MoveToFront(elem)
+		}
+		c.stats.Hits++
+		return data[sha256.Size:], true
+	}
+	// The entry is unreadable or corrupt, so drop it, unless it was evicted
+	// or replaced while it was being read
+	if current && elem.Value.(*entry).version == version {
+		c.remove(name)
+		c.stats.Corruptions++
+	}
+	c.stats.Misses++
+	return nil, false
+}
+
+// Put stores 'value' under 'key', evicting the least recently used entries if
+// the cache is full. Values larger than the whole cache aren't stored.
+func (c *Cache) Put(key string, value []byte) error {
+	name := fileName(key)
+	size := int64(sha256.Size + len(value))
+	if size > c.maxBytes {
+		return nil
+	}
+	// Write the entry to a temporary file and then rename it, so that a crash
+	// never leaves a partial entry behind
+	f, err := ioutil.TempFile(c.dir, tmpPrefix)
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+	sum := sha256.Sum256(value)
+	_, err = f.Write(sum[:])
+	if err == nil {
+		_, err = f.Write(value)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := os.Rename(tmpPath, filepath.Join(c.dir, name)); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	c.version++
+	if elem, ok := c.entries[name]; ok {
+		e := elem.Value.(*entry)
+		c.stats.Bytes -= e.size
+		e.size = size
+		e.version = c.version
+		c.lru.MoveToFront(elem)
+	} else {
+		c.entries[name] = c.lru.PushFront(&entry{
+			name:    name,
+			size:    size,
+			version: c.version,
+		})
+		c.stats.Entries++
+	}
+	c.stats.Bytes += size
+	c.evict()
+	return nil
+}
+
+// Stats returns statistics about the cache's usage.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// evict removes the least recently used entries until the cache fits in
+// 'maxBytes'. c.mu must be held.
+func (c *Cache) evict() {
+	for c.stats.Bytes > c.maxBytes {
+		c.remove(c.lru.Back().Value.(*entry).name)
+		c.stats.Evictions++
+	}
+}
+
+// remove removes the entry in the file 'name'. c.mu must be held.
+func (c *Cache) remove(name string) {
+	os.Remove(filepath.Join(c.dir, name))
+	e := c.lru.Remove(c.entries[name]).(*entry)
+	delete(c.entries, name)
+	c.stats.Entries--
+	c.stats.Bytes -= e.size
+}
+
+// fileName returns the name of the file that stores the entry for 'key'.
+// Keys are hashed so that any string can be used as a key.
+func fileName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,130 @@
+package diskcache
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func newTestCache(t *testing.T, maxBytes int64) (*Cache, string) {
+	dir, err := ioutil.TempDir("", "diskcache")
+	require.NoError(t, err)
+	c, err := NewCache(dir, maxBytes)
+	require.NoError(t, err)
+	return c, dir
+}
+
+func TestGetPut(t *testing.T) {
+	c, dir := newTestCache(t, 1024)
+	defer os.RemoveAll(dir)
+
+	_, ok := c.Get("foo")
+	require.False(t, ok)
+	require.NoError(t, c.Put("foo", []byte("bar")))
+	value, ok := c.Get("foo")
+	require.True(t, ok)
+	require.Equal(t, "bar", string(value))
+
+	// Overwriting an entry replaces it
+	require.NoError(t, c.Put("foo", []byte("buzz")))
+	value, ok = c.Get("foo")
+	require.True(t, ok)
+	require.Equal(t, "buzz", string(value))
+
+	stats := c.Stats()
+	require.Equal(t, int64(2), stats.Hits)
+	require.Equal(t, int64(1), stats.Misses)
+	require.Equal(t, int64(1), stats.Entries)
+}
+
+func TestEviction(t *testing.T) {
+	// Each entry takes 32 bytes for its checksum plus 68 bytes of data, so
+	// the cache holds three of them
+	c, dir := newTestCache(t, 300)
+	defer os.RemoveAll(dir)
+	value := make([]byte, 68)
+	for _, key := range []string{"a", "b", "c"} {
+		require.NoError(t, c.Put(key, value))
+	}
+	// "a" becomes the most recently used entry, so "b" is evicted
+	_, ok := c.Get("a")
+	require.True(t, ok)
+	require.NoError(t, c.Put("d", value))
+	_, ok = c.Get("b")
+	require.False(t, ok)
+	for _, key := range []string{"a", "c", "d"} {
+		_, ok := c.Get(key)
+		require.True(t, ok)
+	}
+	require.Equal(t, int64(1), c.Stats().Evictions)
+	require.Equal(t, int64(300), c.Stats().Bytes)
+
+	// Values larger than the cache are never stored
+	require.NoError(t, c.Put("e", make([]byte, 300)))
+	_, ok = c.Get("e")
+	require.False(t, ok)
+}
+
+func TestReopen(t *testing.T) {
+	c, dir := newTestCache(t, 1024)
+	defer os.RemoveAll(dir)
+	require.NoError(t, c.Put("foo", []byte("bar")))
+	// A leftover partial write is cleaned up
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, tmpPrefix+"123"), []byte("b"), 0644))
+
+	c, err := NewCache(dir, 1024)
+	require.NoError(t, err)
+	value, ok := c.Get("foo")
+	require.True(t, ok)
+	require.Equal(t, "bar", string(value))
+	require.Equal(t, int64(1), c.Stats().Entries)
+	_, err = os.Stat(filepath.Join(dir, tmpPrefix+"123"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestCorruption(t *testing.T) {
+	c, dir := newTestCache(t, 1024)
+	defer os.RemoveAll(dir)
+	require.NoError(t, c.Put("foo", []byte("bar")))
+	path := filepath.Join(dir, fileName("foo"))
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] = 'z'
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+
+	_, ok := c.Get("foo")
+	require.False(t, ok)
+	require.Equal(t, int64(1), c.Stats().Corruptions)
+	require.Equal(t, int64(0), c.Stats().Entries)
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestConcurrentGetPut(t *testing.T) {
+	c, dir := newTestCache(t, 1024)
+	defer os.RemoveAll(dir)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := fmt.Sprintf("key%d", j%10)
+				value := []byte(fmt.Sprintf("value%d", j%10))
+				if i%2 == 0 {
+					require.NoError(t, c.Put(key, value))
+				} else if data, ok := c.Get(key); ok {
+					require.Equal(t, value, data)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int64(0), c.Stats().Corruptions)
+}
@@ -1,13 +1,22 @@
-// Package dlock implements a distributed lock on top of etcd.
+// Package dlock implements a distributed lock on top of etcd, or any
+// kv.Store.
 package dlock
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/clientv3/concurrency"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 )
 
+// lockTTL is the TTL, in seconds, of the lease that holds a lock. If a node
+// dies, it still holds the lock for this long.
+const lockTTL = 15
+
 // DLock is a handle to a distributed lock.
 type DLock interface {
 	// Lock acquries the distributed lock, blocking if necessary.  If
@@ -39,7 +48,7 @@ func NewDLock(client *etcd.Client, prefix string) DLock {
 func (d *etcdImpl) Lock(ctx context.Context) (context.Context, error) {
 	// The default TTL is 60 secs which means that if a node dies, it
 	// still holds the lock for 60 secs, which is too high.
-	session, err := concurrency.NewSession(d.client, concurrency.WithContext(ctx), concurrency.WithTTL(15))
+	session, err := concurrency.NewSession(d.client, concurrency.WithContext(ctx), concurrency.WithTTL(lockTTL))
 	if err != nil {
 		return nil, err
 	}
@@ -69,3 +78,118 @@ func (d *etcdImpl) Unlock(ctx context.Context) error {
 	}
 	return d.session.Close()
 }
+
+type storeImpl struct {
+	store  kv.Store
+	prefix string
+
+	lease kv.LeaseID
+	// stop stops renewing the lease
+	stop context.CancelFunc
+}
+
+// NewStoreDLock is like NewDLock, but the lock is kept in 'store'. Locks are
+// held the same way as NewDLock's (by the oldest key under the prefix, which
+// is put with a lease), so the two kinds of lock exclude each other when
+// 'store' is etcd.
+func NewStoreDLock(store kv.Store, prefix string) DLock {
+	return &storeImpl{
+		store:  store,
+		prefix: prefix,
+	}
+}
+
+func (d *storeImpl) Lock(ctx context.Context) (_ context.Context, retErr error) {
+	lease, err := d.store.Grant(ctx, lockTTL)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			d.store.Revoke(context.Background(), lease)
+		}
+	}()
+	key := fmt.Sprintf("%s/%x", d.prefix, lease)
+	if _, err := d.store.Txn(ctx, &kv.Txn{
+		If:   []kv.Cmp{{Key: key, ModRevision: 0}},
+		Then: []kv.Op{kv.Put(key, "", lease)},
+	}); err != nil {
+		return nil, err
+	}
+	// Renew the lease until the lock is released, or until renewing fails,
+	// in which case the lock may have been lost
+	lockCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		ticker := time.NewTicker(lockTTL * time.Second / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-lockCtx.Done():
+				return
+			}
+			if err := d.store.KeepAlive(lockCtx, lease); err != nil {
+				return
+			}
+		}
+	}()
+	if err := d.waitForTurn(lockCtx, key); err != nil {
+		cancel()
+		return nil, err
+	}
+	d.lease = lease
+	d.stop = cancel
+	return lockCtx, nil
+}
+
+// waitForTurn blocks until 'key' is the oldest key under the lock's prefix.
+func (d *storeImpl) waitForTurn(ctx context.Context, key string) error {
+	for {
+		resp, err := d.store.Get(ctx, d.prefix+"/", kv.GetOptions{
+			Prefix: true,
+			Sort:   kv.SortByCreateRevisionAscend,
+			Limit:  1,
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			return fmt.Errorf("lock key %s was deleted while waiting for the lock", key)
+		}
+		holder := resp.Kvs[0]
+		if string(holder.Key) == key {
+			return nil
+		}
+		// Wait for the holder to release the lock
+		if err := func() error {
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			for resp := range d.store.Watch(watchCtx, string(holder.Key), resp.Revision+1, false) {
+				if resp.Err != nil {
+					return resp.Err
+				}
+				for _, ev := range resp.Events {
+					if ev.Type == kv.EventDelete && string(ev.Kv.Key) == string(holder.Key) {
+						return nil
+					}
+				}
+			}
+			return ctx.Err()
+		}(); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *storeImpl) Unlock(ctx context.Context) error {
+	// 'ctx' is usually the context returned by Lock, so the lease is
+	// revoked before stopping its renewal cancels that context. Revoking
+	// the lease deletes the key, which releases the lock.
+	err := d.store.Revoke(ctx, d.lease)
+	d.stop()
+	if err != nil && err != kv.ErrLeaseNotFound {
+		return err
+	}
+	return nil
+}
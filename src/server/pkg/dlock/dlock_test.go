@@ -0,0 +1,43 @@
+package dlock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
+)
+
+func TestStoreDLock(t *testing.T) {
+	store, err := kv.NewEmbedded("")
+	require.NoError(t, err)
+	defer store.Close()
+
+	lock1 := NewStoreDLock(store, "lock")
+	ctx1, err := lock1.Lock(context.Background())
+	require.NoError(t, err)
+
+	// The second lock waits for the first to be released
+	locked := make(chan error)
+	lock2 := NewStoreDLock(store, "lock")
+	go func() {
+		_, err := lock2.Lock(context.Background())
+		locked <- err
+	}()
+	select {
+	case <-locked:
+		t.Fatal("the lock was acquired twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.NoError(t, lock1.Unlock(ctx1))
+	select {
+	case err := <-locked:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lock wasn't acquired after it was released")
+	}
+	// Releasing the lock cancels its context
+	require.Equal(t, context.Canceled, ctx1.Err())
+	require.NoError(t, lock2.Unlock(context.Background()))
+}
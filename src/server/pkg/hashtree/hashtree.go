@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"io"
 	pathlib "path"
+	"sort"
+	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 )
@@ -16,17 +20,20 @@ const (
 	none         nodetype = iota // No file is present at this point in the tree
 	directory                    // The file at this point in the tree is a directory
 	file                         // ... is a regular file
+	symlink                      // ... is a symbolic link
 	unrecognized                 // ... is an an unknown type
 )
 
 func (n *NodeProto) nodetype() nodetype {
 	switch {
-	case n == nil || (n.DirNode == nil && n.FileNode == nil):
+	case n == nil || (n.DirNode == nil && n.FileNode == nil && n.SymlinkNode == nil):
 		return none
 	case n.DirNode != nil:
 		return directory
 	case n.FileNode != nil:
 		return file
+	case n.SymlinkNode != nil:
+		return symlink
 	default:
 		return unrecognized
 	}
@@ -40,6 +47,8 @@ func (n *OpenNode) nodetype() nodetype {
 		return directory
 	case n.FileNode != nil:
 		return file
+	case n.SymlinkNode != nil:
+		return symlink
 	default:
 		return unrecognized
 	}
@@ -53,36 +62,171 @@ func (n nodetype) tostring() string {
 		return "directory"
 	case file:
 		return "file"
+	case symlink:
+		return "symlink"
 	default:
 		return "unknown"
 	}
 }
 
-// Serialize serializes a HashTree so that it can be persisted. Also see
-// Deserialize(bytes).
+// Serialize serializes a HashTree so that it can be persisted, using the
+// version-2 format described in hashtree.proto. Also see Deserialize(bytes).
 func Serialize(h HashTree) ([]byte, error) {
 	tree, ok := h.(*HashTreeProto)
 	if !ok {
 		return nil, fmt.Errorf("HashTree is of the wrong concrete type")
 	}
-	return tree.Marshal()
+	var buf bytes.Buffer
+	if err := tree.serialize(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
 // Deserialize deserializes a hash tree so that it can be read or modified.
+// Both version-1 and version-2 serialized trees can be deserialized.
 func Deserialize(serialized []byte) (HashTree, error) {
-	h := &HashTreeProto{}
-	if err := h.Unmarshal(serialized); err != nil {
+	r, err := NewReader(bytes.NewReader(serialized))
+	if err != nil {
 		return nil, err
 	}
-	if h.Version != 1 {
-		return nil, errorf(Unsupported, "unsupported HashTreeProto "+
-			"version %d", h.Version)
+	// The serialization format doesn't affect the tree's hashes, so
+	// deserialized trees are always version 1
+	h := &HashTreeProto{
+		Version: 1,
+		Fs:      make(map[string]*NodeProto),
+	}
+	for {
+		path, node, err := r.Read()
+		if err == io.EOF {
+			return h, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		h.Fs[path] = node
 	}
-	return h, nil
+}
+
+// Verify checks that a finished HashTree is consistent: every node is a
+// child of its parent directory, every child of a directory exists, and the
+// hash and size of every node match its contents. If it isn't, an error with
+// code Corrupted describes the first inconsistency found.
+func Verify(h HashTree) error {
+	switch tree := h.(type) {
+	case *HashTreeProto:
+		return verifyNodes(tree, tree.Fs)
+	case *shardedHashTree:
+		// Verify one shard at a time, so that the whole tree is never in memory
+		for i := range tree.shards {
+			fs, err := tree.load(i)
+			if err != nil {
+				return err
+			}
+			if err := verifyNodes(tree, fs); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *overlayHashTree:
+		// Verify the nodes of the base that are still in the tree one shard at
+		// a time, and then the nodes that replace or add to them
+		for i := range tree.base.shards {
+			fs, err := tree.base.load(i)
+			if err != nil {
+				return err
+			}
+			visible := make(map[string]*NodeProto, len(fs))
+			for path, node := range fs {
+				if _, ok := tree.fs[path]; !ok && !hidden(tree.deleted, path) {
+					visible[path] = node
+				}
+			}
+			if err := verifyNodes(tree, visible); err != nil {
+				return err
+			}
+		}
+		return verifyNodes(tree, tree.fs)
+	default:
+		return fmt.Errorf("HashTree is of the wrong concrete type")
+	}
+}
+
+// verifyNodes verifies each node in 'fs', which are some or all of the nodes
+// in 'h', in path order
+func verifyNodes(h HashTree, fs map[string]*NodeProto) error {
+	// lookup returns the node at 'path' in 'h', or nil if there isn't one
+	lookup := func(path string) (*NodeProto, error) {
+		node, err := h.Get(path)
+		if Code(err) == PathNotFound {
+			return nil, nil
+		}
+		return node, err
+	}
+	paths := make([]string, 0, len(fs))
+	for path := range fs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		n := fs[path]
+		if path != "" {
+			parent, child := split(path)
+			parentNode, err := lookup(parent)
+			if err != nil {
+				return err
+			}
+			if parentNode.nodetype() != directory {
+				return errorf(Corrupted, "the parent of \"%s\" is not a directory", path)
+			}
+			children := parentNode.DirNode.Children
+			if i := sort.SearchStrings(children, child); i == len(children) || children[i] != child {
+				return errorf(Corrupted, "\"%s\" is not listed as a child of \"%s\"", path, parent)
+			}
+		}
+
+		// Recompute the hash of 'n' as canonicalize() does
+		hash := sha256.New()
+		switch n.nodetype() {
+		case directory:
+			var size int64
+			for i, child := range n.DirNode.Children {
+				if i > 0 && n.DirNode.Children[i-1] >= child {
+					return errorf(Corrupted, "the children of \"%s\" are not sorted", path)
+				}
+				childnode, err := lookup(join(path, child))
+				if err != nil {
+					return err
+				}
+				if childnode == nil {
+					return errorf(Corrupted, "could not find node for \"%s\", which "+
+						"is a child of \"%s\"", join(path, child), path)
+				}
+				hash.Write([]byte(fmt.Sprintf("%s:%s:", childnode.Name, childnode.Hash)))
+				size += childnode.SubtreeSize
+			}
+			if size != n.SubtreeSize {
+				return errorf(Corrupted, "size of \"%s\" is %d, but its children's "+
+					"sizes add up to %d", path, n.SubtreeSize, size)
+			}
+		case file:
+			hashObjects(hash, n.FileNode.Objects)
+		case symlink:
+			hashSymlink(hash, n.SymlinkNode)
+		default:
+			return errorf(Corrupted,
+				"malformed node at \"%s\": it's not a file, directory or symlink", path)
+		}
+		hashMode(hash, n.Mode)
+		if !bytes.Equal(hash.Sum(nil), n.Hash) {
+			return errorf(Corrupted, "hash of \"%s\" doesn't match its contents", path)
+		}
+	}
+	return nil
 }
 
 // Open makes a deep copy of the HashTree and returns the copy
-func (h *HashTreeProto) Open() OpenHashTree {
+func (h *HashTreeProto) Open() (OpenHashTree, error) {
 	// create a deep copy of 'h' with proto.Clone
 	h2 := proto.Clone(h).(*HashTreeProto)
 	// make a shallow copy of 'innerh' (effectively) and return that
@@ -93,7 +237,7 @@ func (h *HashTreeProto) Open() OpenHashTree {
 	if h3.fs == nil {
 		h3.fs = make(map[string]*NodeProto)
 	}
-	return h3
+	return h3, nil
 }
 
 func get(fs map[string]*NodeProto, path string) (*NodeProto, error) {
@@ -266,38 +410,90 @@ type hashtree struct {
 	// changed maps a path P to 'true' if P or one of its children has been
 	// modified in 'fs', and its hash needs to be updated.
 	changed map[string]bool
+
+	// base, if set, holds the nodes that aren't in 'fs', so that a large tree
+	// can be modified without loading all of it (see OpenOverlay). Nodes are
+	// copied from 'base' into 'fs' when they're modified.
+	base HashTree
+	// deleted holds the paths that have been deleted from 'base', which hide
+	// the nodes of 'base' at and under them.
+	deleted map[string]bool
+}
+
+// lookup returns the node at 'path', which may be in h.base, without copying
+// it into h.fs. The node must not be modified.
+func (h *hashtree) lookup(path string) (*NodeProto, bool, error) {
+	if h.base == nil {
+		node, ok := h.fs[path]
+		return node, ok, nil
+	}
+	return lookupOverlay(h.base, h.fs, h.deleted, path)
+}
+
+// node returns the node at 'path', copying it into h.fs if it's in h.base, so
+// that it can be modified.
+func (h *hashtree) node(path string) (*NodeProto, bool, error) {
+	if n, ok := h.fs[path]; ok || h.base == nil {
+		return n, ok, nil
+	}
+	n, ok, err := lookupOverlay(h.base, nil, h.deleted, path)
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+	n = proto.Clone(n).(*NodeProto)
+	h.fs[path] = n
+	return n, true, nil
 }
 
 // Open returns the hashtree since it's already an OpenHashTree
-func (h *hashtree) Open() OpenHashTree {
-	return h
+func (h *hashtree) Open() (OpenHashTree, error) {
+	return h, nil
 }
 
 // Get retrieves the contents of a file.
 func (h *hashtree) Get(path string) (*NodeProto, error) {
-	return get(h.fs, path)
+	if h.base == nil {
+		return get(h.fs, path)
+	}
+	return getOverlay(h.base, h.fs, h.deleted, path)
 }
 
 // List retrieves the list of files and subdirectories of the directory at
 // 'path'.
 func (h *hashtree) List(path string) ([]*NodeProto, error) {
-	return list(h.fs, path)
+	if h.base == nil {
+		return list(h.fs, path)
+	}
+	return listNodes(h, path)
 }
 
 // Glob returns a list of files and directories that match 'pattern'.
 // The nodes returned have their 'Name' field set to their full paths.
 func (h *hashtree) Glob(pattern string) ([]*NodeProto, error) {
-	return glob(h.fs, pattern)
+	if h.base == nil {
+		return glob(h.fs, pattern)
+	}
+	return globOverlay(h.base, h.fs, h.deleted, pattern)
 }
 
 // FSSize returns the size of the file system that the hashtree represents.
 func (h *hashtree) FSSize() int64 {
-	return size(h.fs)
+	if h.base == nil {
+		return size(h.fs)
+	}
+	node, err := h.Get("/")
+	if err != nil {
+		return 0
+	}
+	return node.SubtreeSize
 }
 
 // Walk implements HashTree.Walk
 func (h *hashtree) Walk(f func(string, *NodeProto) error) error {
-	return walk(h.fs, f)
+	if h.base == nil {
+		return walk(h.fs, f)
+	}
+	return walkOverlay(h.base, h.fs, h.deleted, f)
 }
 
 // Diff implements HashTree.Diff
@@ -347,7 +543,10 @@ func (h *hashtree) canonicalize(path string) error {
 	if !h.changed[path] {
 		return nil // Node is already canonical
 	}
-	n, ok := h.fs[path]
+	n, ok, err := h.node(path)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		return errorf(Internal, "no node at \"%s\"; cannot canonicalize", path)
 	}
@@ -364,7 +563,10 @@ func (h *hashtree) canonicalize(path string) error {
 			if err := h.canonicalize(childpath); err != nil {
 				return err
 			}
-			childnode, ok := h.fs[childpath]
+			childnode, ok, err := h.lookup(childpath)
+			if err != nil {
+				return err
+			}
 			if !ok {
 				return errorf(Internal, "could not find node for \"%s\" while "+
 					"updating hash of \"%s\"", join(path, child), path)
@@ -373,14 +575,14 @@ func (h *hashtree) canonicalize(path string) error {
 			hash.Write([]byte(fmt.Sprintf("%s:%s:", childnode.Name, childnode.Hash)))
 		}
 	case file:
-		// Compute n.Hash by concatenating all BlockRef hashes in n.FileNode.
-		for _, object := range n.FileNode.Objects {
-			hash.Write([]byte(object.Hash))
-		}
+		hashObjects(hash, n.FileNode.Objects)
+	case symlink:
+		hashSymlink(hash, n.SymlinkNode)
 	default:
 		return errorf(Internal,
-			"malformed node at \"%s\" is neither a file nor a directory", path)
+			"malformed node at \"%s\" is not a file, directory or symlink", path)
 	}
+	hashMode(hash, n.Mode)
 
 	// Update hash of 'n'
 	n.Hash = hash.Sum(nil)
@@ -388,6 +590,37 @@ func (h *hashtree) canonicalize(path string) error {
 	return nil
 }
 
+// hashObjects adds the objects of a file to the hash of its node, by
+// concatenating their hashes.
+func hashObjects(hash io.Writer, objects []*pfs.Object) {
+	for _, object := range objects {
+		hash.Write([]byte(object.Hash))
+	}
+}
+
+// FileNodeHash returns the hash of a file node whose content is stored in
+// 'objects' and whose mode is 'mode'. It lets callers check whether a file
+// matches a node without building a tree.
+func FileNodeHash(objects []*pfs.Object, mode uint32) []byte {
+	hash := sha256.New()
+	hashObjects(hash, objects)
+	hashMode(hash, mode)
+	return hash.Sum(nil)
+}
+
+// hashSymlink adds the target of a symlink to the hash of its node
+func hashSymlink(hash io.Writer, n *SymlinkNodeProto) {
+	hash.Write([]byte(fmt.Sprintf("symlink:%s", n.Target)))
+}
+
+// hashMode adds the mode of a node, if it's set, to the node's hash. Nodes
+// whose mode was never set are hashed as they were before modes existed.
+func hashMode(hash io.Writer, mode uint32) {
+	if mode != 0 {
+		hash.Write([]byte(fmt.Sprintf("mode:%o:", mode)))
+	}
+}
+
 // updateFn is used by 'visit'. The first parameter is the node being visited,
 // the second parameter is the path of that node, and the third parameter is the
 // child of that node from the 'path' argument to 'visit'.
@@ -414,7 +647,10 @@ func nop(*NodeProto, string, string) error {
 func (h *hashtree) visit(path string, update updateFn) error {
 	for path != "" {
 		parent, child := split(path)
-		pnode, ok := h.fs[parent]
+		pnode, ok, err := h.node(parent)
+		if err != nil {
+			return err
+		}
 		if ok && pnode.nodetype() != directory {
 			return errorf(PathConflict, "attempted to visit \"%s\", but it's not a "+
 				"directory", path)
@@ -436,13 +672,23 @@ func (h *hashtree) visit(path string, update updateFn) error {
 // been removed from h.fs (instead of updating all parents' hashesafter
 // removing each file) may save substantial time.
 func (h *hashtree) removeFromMap(path string) error {
+	if h.base != nil {
+		// Only the nodes in 'fs' are removed; the rest are hidden
+		for p := range h.fs {
+			if p == path || strings.HasPrefix(p, path+"/") {
+				delete(h.fs, p)
+			}
+		}
+		h.deleted[path] = true
+		return nil
+	}
 	n, ok := h.fs[path]
 	if !ok {
 		return nil
 	}
 
 	switch n.nodetype() {
-	case file:
+	case file, symlink:
 		delete(h.fs, path)
 	case directory:
 		for _, child := range n.DirNode.Children {
@@ -453,7 +699,7 @@ func (h *hashtree) removeFromMap(path string) error {
 		delete(h.fs, path)
 	case unrecognized:
 		return errorf(Internal,
-			"malformed node at \"%s\": it's neither a file nor a directory", path)
+			"malformed node at \"%s\": it's not a file, directory or symlink", path)
 	}
 	return nil
 }
@@ -464,6 +710,9 @@ func (h *hashtree) Finish() (HashTree, error) {
 	if err := h.canonicalize(""); err != nil {
 		return nil, err
 	}
+	if h.base != nil {
+		return h.finishOverlay(), nil
+	}
 	// Create a shallow copy of 'h'
 	innerp := &HashTreeProto{
 		Fs:      h.fs,
@@ -483,7 +732,10 @@ func (h *hashtree) PutFile(path string, objects []*pfs.Object, size int64) error
 	}
 
 	// Get/Create file node to which we'll append 'objects'
-	node, ok := h.fs[path]
+	node, ok, err := h.node(path)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		node = &NodeProto{
 			Name:     base(path),
@@ -529,7 +781,11 @@ func (h *hashtree) PutDir(path string) error {
 	}
 
 	// Create orphaned directory at 'path' (or end early if a directory is there)
-	if node, ok := h.fs[path]; ok {
+	node, ok, err := h.node(path)
+	if err != nil {
+		return err
+	}
+	if ok {
 		if node.nodetype() == directory {
 			return nil
 		} else if node.nodetype() != none {
@@ -561,21 +817,95 @@ func (h *hashtree) PutDir(path string) error {
 	return nil
 }
 
-// DeleteFile deletes a regular file or directory (along with its children).
+// PutSymlink creates a symlink pointing to 'target' (or changes its target if
+// a symlink is already there).
+func (h *hashtree) PutSymlink(path string, target string) error {
+	path = clean(path)
+
+	// Detect any path conflicts before modifying 'h'
+	if err := h.visit(path, nop); err != nil {
+		return err
+	}
+
+	node, ok, err := h.node(path)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		node = &NodeProto{
+			Name: base(path),
+		}
+		h.fs[path] = node
+	} else if node.nodetype() != symlink {
+		return errorf(PathConflict, "could not put symlink at \"%s\"; a node of "+
+			"type %s is already there", path, node.nodetype().tostring())
+	}
+	node.SymlinkNode = &SymlinkNodeProto{Target: target}
+	h.changed[path] = true
+
+	// Add 'path' to parent (if it's new) & mark nodes as 'changed' back to root
+	return h.visit(path, func(node *NodeProto, parent, child string) error {
+		if node == nil {
+			node = &NodeProto{
+				Name:    base(parent),
+				DirNode: &DirectoryNodeProto{},
+			}
+			h.fs[parent] = node
+		}
+		insertStr(&node.DirNode.Children, child)
+		h.changed[parent] = true
+		return nil
+	})
+}
+
+// SetAttrs sets the mode and modification time of the node at 'path'. A 0
+// 'mode' or nil 'mtime' leaves the corresponding attribute unchanged.
+func (h *hashtree) SetAttrs(path string, mode uint32, mtime *types.Timestamp) error {
+	path = clean(path)
+	node, ok, err := h.node(path)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorf(PathNotFound, "no node at \"%s\"", path)
+	}
+	if mtime != nil {
+		node.Mtime = mtime
+	}
+	if mode != 0 && mode != node.Mode {
+		node.Mode = mode
+		// The mode is part of the node's hash
+		h.changed[path] = true
+		return h.visit(path, func(node *NodeProto, parent, child string) error {
+			h.changed[parent] = true
+			return nil
+		})
+	}
+	return nil
+}
+
+// DeleteFile deletes a regular file, symlink or directory (along with its
+// children).
 func (h *hashtree) DeleteFile(path string) error {
 	path = clean(path)
 
 	// Remove 'path' and all nodes underneath it from h.fs
-	node, ok := h.fs[path]
+	node, ok, err := h.lookup(path)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		return errorf(PathNotFound, "no file at \"%s\"", path)
 	}
-	h.removeFromMap(path) // Deletes children recursively
 	size := node.SubtreeSize
+	h.removeFromMap(path) // Deletes children recursively
 
 	// Remove 'path' from its parent directory
 	parent, child := split(path)
-	node, ok = h.fs[parent]
+	node, ok, err = h.node(parent)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		return errorf(Internal, "delete discovered orphaned file \"%s\"", path)
 	}
@@ -605,15 +935,21 @@ func (h *hashtree) DeleteFile(path string) error {
 // GetOpen retrieves a file.
 func (h *hashtree) GetOpen(path string) (*OpenNode, error) {
 	path = clean(path)
-	np, ok := h.fs[path]
+	np, ok, err := h.node(path)
+	if err != nil {
+		return nil, err
+	}
 	if !ok {
 		return nil, errorf(PathNotFound, "no node at \"%s\"", path)
 	}
 	return &OpenNode{
-		Name:     np.Name,
-		Size:     np.SubtreeSize,
-		FileNode: np.FileNode,
-		DirNode:  np.DirNode,
+		Name:        np.Name,
+		Size:        np.SubtreeSize,
+		FileNode:    np.FileNode,
+		DirNode:     np.DirNode,
+		SymlinkNode: np.SymlinkNode,
+		Mode:        np.Mode,
+		Mtime:       np.Mtime,
 	}, nil
 }
 
@@ -621,7 +957,10 @@ func (h *hashtree) GetOpen(path string) (*OpenNode, error) {
 func (h *hashtree) mergeNode(path string, srcs []HashTree) (int64, error) {
 	path = clean(path)
 	// Get the node at path in 'h' and determine its type (i.e. file, dir)
-	destNode, ok := h.fs[path]
+	destNode, ok, err := h.node(path)
+	if err != nil {
+		return 0, err
+	}
 	if !ok {
 		destNode = &NodeProto{
 			Name:        base(path),
@@ -633,7 +972,7 @@ func (h *hashtree) mergeNode(path string, srcs []HashTree) (int64, error) {
 		h.fs[path] = destNode
 	} else if destNode.nodetype() == unrecognized {
 		return 0, errorf(Internal, "malformed node at \"%s\" in destination "+
-			"hashtree is neither a file nor a directory", path)
+			"hashtree is not a file, directory or symlink", path)
 	}
 	sizeDelta := int64(0) // We return this to propagate file additions upwards
 
@@ -671,14 +1010,25 @@ func (h *hashtree) mergeNode(path string, srcs []HashTree) (int64, error) {
 				destNode.DirNode = &DirectoryNodeProto{}
 			} else if n.nodetype() == file {
 				destNode.FileNode = &FileNodeProto{}
+			} else if n.nodetype() == symlink {
+				destNode.SymlinkNode = &SymlinkNodeProto{}
 			} else {
 				return 0, errorf(Internal, "could not merge unrecognized node type at "+
-					"\"%s\", which is neither a file nore a directory", path)
+					"\"%s\", which is not a file, directory or symlink", path)
 			}
 			pathtype = n.nodetype()
 		} else if pathtype != n.nodetype() {
 			return sizeDelta, errorf(PathConflict, "could not merge path \"%s\" "+
-				"which is a file in some hashtrees and a directory in others", path)
+				"which is a %s in some hashtrees and a %s in others", path,
+				pathtype.tostring(), n.nodetype().tostring())
+		}
+		// Later trees' attributes override earlier ones', except that the
+		// latest mtime is kept
+		if n.Mode != 0 {
+			destNode.Mode = n.Mode
+		}
+		if n.Mtime != nil && (destNode.Mtime == nil || destNode.Mtime.Compare(n.Mtime) < 0) {
+			destNode.Mtime = n.Mtime
 		}
 		switch n.nodetype() {
 		case directory:
@@ -692,17 +1042,26 @@ func (h *hashtree) mergeNode(path string, srcs []HashTree) (int64, error) {
 			destNode.FileNode.Objects = append(destNode.FileNode.Objects,
 				n.FileNode.Objects...)
 			sizeDelta += n.SubtreeSize
+		case symlink:
+			// The last tree's target wins, as if the links were created in order
+			destNode.SymlinkNode.Target = n.SymlinkNode.Target
 		default:
 			return sizeDelta, errorf(Internal, "malformed node at \"%s\" in source "+
-				"hashtree is neither a file nor a directory", path)
+				"hashtree is not a file, directory or symlink", path)
 		}
 	}
 
 	// If this is a directory, go back and merge all children encountered above
 	if pathtype == directory {
-		// Merge all children (collected in childrenToTrees)
-		for c, cSrcs := range childrenToTrees {
-			childSizeDelta, err := h.mergeNode(join(path, c), cSrcs)
+		// Merge all children (collected in childrenToTrees) in order, so that
+		// sharded 'srcs' are read one shard at a time
+		children := make([]string, 0, len(childrenToTrees))
+		for c := range childrenToTrees {
+			children = append(children, c)
+		}
+		sort.Strings(children)
+		for _, c := range children {
+			childSizeDelta, err := h.mergeNode(join(path, c), childrenToTrees[c])
 			if err != nil {
 				return sizeDelta, err
 			}
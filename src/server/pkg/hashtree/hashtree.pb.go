@@ -10,14 +10,19 @@
 	It has these top-level messages:
 		FileNodeProto
 		DirectoryNodeProto
+		SymlinkNodeProto
 		NodeProto
 		HashTreeProto
+		DeltaNodeProto
+		ShardProto
+		HashTreeIndexProto
 */
 package hashtree
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import google_protobuf "github.com/gogo/protobuf/types"
 import pfs "github.com/pachyderm/pachyderm/src/client/pfs"
 
 import io "io"
@@ -74,7 +79,27 @@ func (m *DirectoryNodeProto) GetChildren() []string {
 	return nil
 }
 
-// NodeProto is a node in the file tree (either a file or a directory)
+// SymlinkNodeProto is a node corresponding to a symbolic link (which is also a
+// leaf node).
+type SymlinkNodeProto struct {
+	// Target is the path that the link points to, exactly as it would be passed
+	// to symlink(2). It isn't checked or resolved by PFS.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *SymlinkNodeProto) Reset()                    { *m = SymlinkNodeProto{} }
+func (m *SymlinkNodeProto) String() string            { return proto.CompactTextString(m) }
+func (*SymlinkNodeProto) ProtoMessage()               {}
+func (*SymlinkNodeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{2} }
+
+func (m *SymlinkNodeProto) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// NodeProto is a node in the file tree (a file, a directory or a symlink)
 type NodeProto struct {
 	// Name is the name (not path) of the file/directory (e.g. /lib).
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -87,14 +112,23 @@ type NodeProto struct {
 	SubtreeSize int64 `protobuf:"varint,3,opt,name=subtree_size,json=subtreeSize,proto3" json:"subtree_size,omitempty"`
 	// Exactly one of the following fields must be set. The type of this node will
 	// be determined by which field is set.
-	FileNode *FileNodeProto      `protobuf:"bytes,4,opt,name=file_node,json=fileNode" json:"file_node,omitempty"`
-	DirNode  *DirectoryNodeProto `protobuf:"bytes,5,opt,name=dir_node,json=dirNode" json:"dir_node,omitempty"`
+	FileNode    *FileNodeProto      `protobuf:"bytes,4,opt,name=file_node,json=fileNode" json:"file_node,omitempty"`
+	DirNode     *DirectoryNodeProto `protobuf:"bytes,5,opt,name=dir_node,json=dirNode" json:"dir_node,omitempty"`
+	SymlinkNode *SymlinkNodeProto   `protobuf:"bytes,6,opt,name=symlink_node,json=symlinkNode" json:"symlink_node,omitempty"`
+	// Mode holds the POSIX permission bits of the node (e.g. 0755). 0 means
+	// that they were never set, and readers should use a default. Mode is part
+	// of the node's hash.
+	Mode uint32 `protobuf:"varint,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Mtime is the modification time of the node, if it was set. Unlike Mode,
+	// it isn't part of the node's hash, so that otherwise identical trees have
+	// the same hash.
+	Mtime *google_protobuf.Timestamp `protobuf:"bytes,8,opt,name=mtime" json:"mtime,omitempty"`
 }
 
 func (m *NodeProto) Reset()                    { *m = NodeProto{} }
 func (m *NodeProto) String() string            { return proto.CompactTextString(m) }
 func (*NodeProto) ProtoMessage()               {}
-func (*NodeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{2} }
+func (*NodeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{3} }
 
 func (m *NodeProto) GetName() string {
 	if m != nil {
@@ -131,6 +165,27 @@ func (m *NodeProto) GetDirNode() *DirectoryNodeProto {
 	return nil
 }
 
+func (m *NodeProto) GetSymlinkNode() *SymlinkNodeProto {
+	if m != nil {
+		return m.SymlinkNode
+	}
+	return nil
+}
+
+func (m *NodeProto) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *NodeProto) GetMtime() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Mtime
+	}
+	return nil
+}
+
 // HashTreeProto is a tree corresponding to the complete file contents of a
 // pachyderm repo at a given commit (based on a Merkle Tree). We store one
 // HashTree for every PFS commit.
@@ -138,11 +193,12 @@ type HashTreeProto struct {
 	// Version is an arbitrary version number, set by the corresponding library
 	// in hashtree.go.  This ensures that if the hash function used to create
 	// these trees is changed, we won't run into errors when deserializing old
-	// trees. The current version is 1.
+	// trees. The current version is 1. Note that this is distinct from the
+	// version of the serialization format (see "Serialization" below).
 	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	// Fs maps each node's path to the NodeProto with that node's details.
-	// See "Potential Optimizations" at the end for a compression scheme that
-	// could be useful if this map gets too large.
+	// Serialize() doesn't store this map directly; see "Serialization" at the
+	// end.
 	//
 	// Note that the key must end in "/" if an only if the value has .dir_node set
 	// (i.e. iff the path points to a directory).
@@ -152,7 +208,7 @@ type HashTreeProto struct {
 func (m *HashTreeProto) Reset()                    { *m = HashTreeProto{} }
 func (m *HashTreeProto) String() string            { return proto.CompactTextString(m) }
 func (*HashTreeProto) ProtoMessage()               {}
-func (*HashTreeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{3} }
+func (*HashTreeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{4} }
 
 func (m *HashTreeProto) GetVersion() int32 {
 	if m != nil {
@@ -168,11 +224,111 @@ func (m *HashTreeProto) GetFs() map[string]*NodeProto {
 	return nil
 }
 
+// DeltaNodeProto is a single node in a version-2 serialized HashTree. See
+// "Serialization" below.
+type DeltaNodeProto struct {
+	// shared is the number of bytes at the start of this node's path that it
+	// shares with the path of the previous node
+	Shared uint32 `protobuf:"varint,1,opt,name=shared,proto3" json:"shared,omitempty"`
+	// suffix is the rest of this node's path, after the shared prefix
+	Suffix string     `protobuf:"bytes,2,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	Node   *NodeProto `protobuf:"bytes,3,opt,name=node" json:"node,omitempty"`
+}
+
+func (m *DeltaNodeProto) Reset()                    { *m = DeltaNodeProto{} }
+func (m *DeltaNodeProto) String() string            { return proto.CompactTextString(m) }
+func (*DeltaNodeProto) ProtoMessage()               {}
+func (*DeltaNodeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{5} }
+
+func (m *DeltaNodeProto) GetShared() uint32 {
+	if m != nil {
+		return m.Shared
+	}
+	return 0
+}
+
+func (m *DeltaNodeProto) GetSuffix() string {
+	if m != nil {
+		return m.Suffix
+	}
+	return ""
+}
+
+func (m *DeltaNodeProto) GetNode() *NodeProto {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+// ShardProto is a shard of a large HashTree, which holds the nodes in a
+// contiguous range of paths. See "Sharding" below.
+type ShardProto struct {
+	// first_path is the first path in the shard. The shard holds every node
+	// whose path is at least first_path, and less than the first_path of the
+	// next shard.
+	FirstPath string `protobuf:"bytes,1,opt,name=first_path,json=firstPath,proto3" json:"first_path,omitempty"`
+	// object is the version-2 serialized tree that holds the shard's nodes
+	Object *pfs.Object `protobuf:"bytes,2,opt,name=object" json:"object,omitempty"`
+}
+
+func (m *ShardProto) Reset()                    { *m = ShardProto{} }
+func (m *ShardProto) String() string            { return proto.CompactTextString(m) }
+func (*ShardProto) ProtoMessage()               {}
+func (*ShardProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{6} }
+
+func (m *ShardProto) GetFirstPath() string {
+	if m != nil {
+		return m.FirstPath
+	}
+	return ""
+}
+
+func (m *ShardProto) GetObject() *pfs.Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+// HashTreeIndexProto is the index of a sharded HashTree. See "Sharding"
+// below.
+type HashTreeIndexProto struct {
+	// version is the version of the serialization format, which is 3 for
+	// sharded trees
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// shards are the shards of the tree, in path order
+	Shards []*ShardProto `protobuf:"bytes,2,rep,name=shards" json:"shards,omitempty"`
+}
+
+func (m *HashTreeIndexProto) Reset()                    { *m = HashTreeIndexProto{} }
+func (m *HashTreeIndexProto) String() string            { return proto.CompactTextString(m) }
+func (*HashTreeIndexProto) ProtoMessage()               {}
+func (*HashTreeIndexProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{7} }
+
+func (m *HashTreeIndexProto) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *HashTreeIndexProto) GetShards() []*ShardProto {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FileNodeProto)(nil), "FileNodeProto")
 	proto.RegisterType((*DirectoryNodeProto)(nil), "DirectoryNodeProto")
+	proto.RegisterType((*SymlinkNodeProto)(nil), "SymlinkNodeProto")
 	proto.RegisterType((*NodeProto)(nil), "NodeProto")
 	proto.RegisterType((*HashTreeProto)(nil), "HashTreeProto")
+	proto.RegisterType((*DeltaNodeProto)(nil), "DeltaNodeProto")
+	proto.RegisterType((*ShardProto)(nil), "ShardProto")
+	proto.RegisterType((*HashTreeIndexProto)(nil), "HashTreeIndexProto")
 }
 func (m *FileNodeProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -237,6 +393,30 @@ func (m *DirectoryNodeProto) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SymlinkNodeProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymlinkNodeProto) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
+	}
+	return i, nil
+}
+
 func (m *NodeProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -289,6 +469,31 @@ func (m *NodeProto) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.SymlinkNode != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.SymlinkNode.Size()))
+		n3, err := m.SymlinkNode.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Mode != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Mtime.Size()))
+		n4, err := m.Mtime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -332,12 +537,120 @@ func (m *HashTreeProto) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintHashtree(dAtA, i, uint64(v.Size()))
-				n3, err := v.MarshalTo(dAtA[i:])
+				n5, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n3
+				i += n5
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *DeltaNodeProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeltaNodeProto) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Shared != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Shared))
+	}
+	if len(m.Suffix) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.Suffix)))
+		i += copy(dAtA[i:], m.Suffix)
+	}
+	if m.Node != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Node.Size()))
+		n6, err := m.Node.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func (m *ShardProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardProto) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FirstPath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.FirstPath)))
+		i += copy(dAtA[i:], m.FirstPath)
+	}
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Object.Size()))
+		n7, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *HashTreeIndexProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HashTreeIndexProto) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Shards) > 0 {
+		for _, msg := range m.Shards {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHashtree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
+			i += n
 		}
 	}
 	return i, nil
@@ -394,6 +707,16 @@ func (m *DirectoryNodeProto) Size() (n int) {
 	return n
 }
 
+func (m *SymlinkNodeProto) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	return n
+}
+
 func (m *NodeProto) Size() (n int) {
 	var l int
 	_ = l
@@ -416,6 +739,17 @@ func (m *NodeProto) Size() (n int) {
 		l = m.DirNode.Size()
 		n += 1 + l + sovHashtree(uint64(l))
 	}
+	if m.SymlinkNode != nil {
+		l = m.SymlinkNode.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovHashtree(uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		l = m.Mtime.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
 	return n
 }
 
@@ -441,6 +775,52 @@ func (m *HashTreeProto) Size() (n int) {
 	return n
 }
 
+func (m *DeltaNodeProto) Size() (n int) {
+	var l int
+	_ = l
+	if m.Shared != 0 {
+		n += 1 + sovHashtree(uint64(m.Shared))
+	}
+	l = len(m.Suffix)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if m.Node != nil {
+		l = m.Node.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardProto) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.FirstPath)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	return n
+}
+
+func (m *HashTreeIndexProto) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovHashtree(uint64(m.Version))
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovHashtree(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovHashtree(x uint64) (n int) {
 	for {
 		n++
@@ -614,7 +994,7 @@ func (m *DirectoryNodeProto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeProto) Unmarshal(dAtA []byte) error {
+func (m *SymlinkNodeProto) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -637,15 +1017,15 @@ func (m *NodeProto) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeProto: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymlinkNodeProto: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeProto: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymlinkNodeProto: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -670,26 +1050,105 @@ func (m *NodeProto) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHashtree(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHashtree
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHashtree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
 			if byteLen < 0 {
 				return ErrInvalidLengthHashtree
@@ -788,6 +1247,91 @@ func (m *NodeProto) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SymlinkNode == nil {
+				m.SymlinkNode = &SymlinkNodeProto{}
+			}
+			if err := m.SymlinkNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mtime == nil {
+				m.Mtime = &google_protobuf.Timestamp{}
+			}
+			if err := m.Mtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHashtree(dAtA[iNdEx:])
@@ -999,6 +1543,349 @@ func (m *HashTreeProto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeltaNodeProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHashtree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeltaNodeProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeltaNodeProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
+			}
+			m.Shared = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shared |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &NodeProto{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHashtree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHashtree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &pfs.Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHashtree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashTreeIndexProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHashtree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashTreeIndexProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashTreeIndexProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ShardProto{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHashtree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHashtree(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1107,28 +1994,40 @@ var (
 func init() { proto.RegisterFile("server/pkg/hashtree/hashtree.proto", fileDescriptorHashtree) }
 
 var fileDescriptorHashtree = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0x75, 0x92, 0xd6, 0x34, 0x37, 0xad, 0x94, 0x51, 0x64, 0xe8, 0x22, 0xc4, 0x80, 0x12, 0x10,
-	0xa6, 0x52, 0x41, 0xc4, 0x9d, 0xa2, 0xc5, 0x95, 0xca, 0xe8, 0xbe, 0xa4, 0xc9, 0x8d, 0x19, 0x1b,
-	0x93, 0x32, 0x93, 0x16, 0xda, 0xef, 0x70, 0xe1, 0x7f, 0xf8, 0x13, 0x2e, 0xfd, 0x04, 0xe9, 0xfb,
-	0x91, 0xc7, 0x4c, 0xd3, 0x96, 0xf2, 0x16, 0x03, 0xe7, 0x9c, 0x7b, 0x2e, 0x9c, 0x7b, 0x06, 0x62,
-	0x8d, 0x6a, 0x8b, 0x6a, 0xba, 0x5e, 0x7d, 0x9f, 0x96, 0xa9, 0x2e, 0x5b, 0x85, 0x78, 0x06, 0x7c,
-	0xad, 0x9a, 0xb6, 0x99, 0x3c, 0xca, 0x2a, 0x89, 0x75, 0x3b, 0x5d, 0x17, 0xda, 0xbc, 0xa3, 0x1a,
-	0xbf, 0x82, 0xd1, 0x5c, 0x56, 0xf8, 0xa9, 0xc9, 0xf1, 0x8b, 0x11, 0xe8, 0x53, 0xf0, 0x9a, 0xe5,
-	0x0f, 0xcc, 0x5a, 0xcd, 0x7a, 0x91, 0x9b, 0x04, 0xb3, 0x80, 0x1b, 0xf7, 0x67, 0xab, 0x89, 0xd3,
-	0x2c, 0x7e, 0x01, 0xf4, 0xbd, 0x54, 0x98, 0xb5, 0x8d, 0xda, 0x5d, 0x96, 0x27, 0x30, 0xc8, 0x4a,
-	0x59, 0xe5, 0x0a, 0x6b, 0xe6, 0x46, 0x6e, 0xe2, 0x8b, 0x33, 0x8f, 0xff, 0x10, 0xf0, 0x2f, 0x4e,
-	0x0a, 0xbd, 0x3a, 0xfd, 0x89, 0x8c, 0x44, 0x24, 0xf1, 0x85, 0xc5, 0x46, 0x33, 0x99, 0x99, 0x13,
-	0x91, 0x64, 0x28, 0x2c, 0xa6, 0x4f, 0x60, 0xa8, 0x37, 0x4b, 0x73, 0xc6, 0x42, 0xcb, 0x3d, 0x32,
-	0x37, 0x22, 0x89, 0x2b, 0x82, 0x4e, 0xfb, 0x2a, 0xf7, 0x48, 0x9f, 0x83, 0x5f, 0xc8, 0x0a, 0x17,
-	0x75, 0x93, 0x23, 0xeb, 0x45, 0x24, 0x09, 0x66, 0x0f, 0xf8, 0xd5, 0x51, 0x62, 0x50, 0x74, 0x94,
-	0x72, 0x18, 0xe4, 0x52, 0x1d, 0xbd, 0x7d, 0xeb, 0x7d, 0xc8, 0xef, 0x1e, 0x22, 0xbc, 0x5c, 0x2a,
-	0xc3, 0xe2, 0x5f, 0x04, 0x46, 0x1f, 0x53, 0x5d, 0x7e, 0x53, 0xd8, 0x25, 0x67, 0xe0, 0x6d, 0x51,
-	0x69, 0xd9, 0xd4, 0x36, 0x7c, 0x5f, 0x9c, 0x28, 0x7d, 0x06, 0x4e, 0xa1, 0x99, 0x63, 0x5b, 0x7b,
-	0xcc, 0xaf, 0xb6, 0xf8, 0x5c, 0x7f, 0xa8, 0x5b, 0xb5, 0x13, 0x4e, 0xa1, 0x27, 0x6f, 0xc1, 0xeb,
-	0x28, 0x1d, 0x83, 0xbb, 0xc2, 0x5d, 0xd7, 0x82, 0x81, 0x34, 0x82, 0xfe, 0x36, 0xad, 0x36, 0x68,
-	0x5b, 0x08, 0x66, 0xc0, 0x2f, 0xa1, 0x8e, 0x83, 0x37, 0xce, 0x6b, 0xf2, 0x6e, 0xfc, 0xf7, 0x10,
-	0x92, 0x7f, 0x87, 0x90, 0xfc, 0x3f, 0x84, 0xe4, 0xf7, 0x4d, 0x78, 0x6f, 0x79, 0xdf, 0xfe, 0xe7,
-	0xcb, 0xdb, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0x83, 0xf2, 0x7a, 0x0b, 0x02, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0x1c, 0xe7, 0xcf, 0xd7, 0x4d, 0xd5, 0x6f, 0x40, 0x95, 0x15, 0x89, 0x60, 0x5c, 0x81,
+	0x2c, 0x90, 0x26, 0x55, 0x41, 0x08, 0xb1, 0x03, 0x95, 0x0a, 0x36, 0x50, 0x4d, 0xba, 0x2f, 0x4e,
+	0x7c, 0x1d, 0x0f, 0xf5, 0x4f, 0x34, 0x33, 0xa9, 0x9a, 0x3e, 0x07, 0x0b, 0xde, 0x82, 0xd7, 0x60,
+	0xc9, 0x23, 0xa0, 0xf2, 0x22, 0xc8, 0xe3, 0x71, 0xad, 0xd0, 0x05, 0x8b, 0x48, 0xf7, 0x9c, 0x7b,
+	0xee, 0xcd, 0xdc, 0x73, 0x0c, 0x81, 0x44, 0x71, 0x89, 0x62, 0xba, 0xba, 0x58, 0x4e, 0xd3, 0x48,
+	0xa6, 0x4a, 0x20, 0xde, 0x16, 0x74, 0x25, 0x4a, 0x55, 0x8e, 0x1f, 0x2e, 0xcb, 0x72, 0x99, 0xe1,
+	0x54, 0xa3, 0xf9, 0x3a, 0x99, 0x2a, 0x9e, 0xa3, 0x54, 0x51, 0xbe, 0x32, 0x82, 0xfb, 0x8b, 0x8c,
+	0x63, 0xa1, 0xa6, 0xab, 0x44, 0x56, 0xbf, 0x9a, 0x0d, 0x5e, 0xc2, 0xe8, 0x84, 0x67, 0xf8, 0xb1,
+	0x8c, 0xf1, 0xb4, 0x22, 0xc8, 0x63, 0x18, 0x94, 0xf3, 0x2f, 0xb8, 0x50, 0xd2, 0xeb, 0xfa, 0x76,
+	0xe8, 0x1e, 0xb9, 0xb4, 0x52, 0x7f, 0xd2, 0x1c, 0x6b, 0x7a, 0xc1, 0x21, 0x90, 0x63, 0x2e, 0x70,
+	0xa1, 0x4a, 0xb1, 0x69, 0x87, 0xc7, 0x30, 0x5c, 0xa4, 0x3c, 0x8b, 0x05, 0x16, 0x9e, 0xed, 0xdb,
+	0xa1, 0xc3, 0x6e, 0x71, 0xf0, 0x14, 0xf6, 0x66, 0x9b, 0x3c, 0xe3, 0xc5, 0x45, 0xab, 0xdf, 0x87,
+	0xbe, 0x8a, 0xc4, 0x12, 0x95, 0x67, 0xf9, 0x56, 0xe8, 0x30, 0x83, 0x82, 0xef, 0x1d, 0x70, 0x5a,
+	0x15, 0x81, 0x6e, 0x11, 0xe5, 0x68, 0x34, 0xba, 0xae, 0xb8, 0xca, 0x00, 0xaf, 0xe3, 0x5b, 0xe1,
+	0x0e, 0xd3, 0x35, 0x79, 0x04, 0x3b, 0x72, 0x3d, 0xaf, 0x3c, 0x39, 0x97, 0xfc, 0x1a, 0x3d, 0xdb,
+	0xb7, 0x42, 0x9b, 0xb9, 0x86, 0x9b, 0xf1, 0x6b, 0x24, 0xcf, 0xc0, 0x49, 0x78, 0x86, 0xe7, 0x45,
+	0x19, 0xa3, 0xd7, 0xf5, 0xad, 0xd0, 0x3d, 0xda, 0xa5, 0x5b, 0x06, 0xb0, 0x61, 0x62, 0x20, 0xa1,
+	0x30, 0x8c, 0xb9, 0xa8, 0xb5, 0x3d, 0xad, 0xbd, 0x47, 0xef, 0x1e, 0xcd, 0x06, 0x31, 0x17, 0x5a,
+	0xff, 0x02, 0x76, 0x64, 0x7d, 0x61, 0x3d, 0xd3, 0xd7, 0x33, 0xff, 0xd3, 0xbf, 0xcf, 0x66, 0xae,
+	0x6c, 0x99, 0xea, 0x92, 0xbc, 0x52, 0x0f, 0x7c, 0x2b, 0x1c, 0x31, 0x5d, 0x93, 0x43, 0xe8, 0xe5,
+	0x55, 0x7e, 0xde, 0x50, 0xaf, 0x18, 0xd3, 0x3a, 0x5c, 0xda, 0x84, 0x4b, 0xcf, 0x9a, 0x70, 0x59,
+	0x2d, 0x0c, 0xbe, 0x5a, 0x30, 0x7a, 0x1f, 0xc9, 0xf4, 0x4c, 0xa0, 0x71, 0xcd, 0x83, 0xc1, 0x25,
+	0x0a, 0xc9, 0xcb, 0x42, 0x1b, 0xd7, 0x63, 0x0d, 0x24, 0x4f, 0xa0, 0x93, 0x48, 0xaf, 0xa3, 0xd3,
+	0xdd, 0xa7, 0x5b, 0x53, 0xf4, 0x44, 0xbe, 0x2b, 0x94, 0xd8, 0xb0, 0x4e, 0x22, 0xc7, 0x6f, 0x60,
+	0x60, 0x20, 0xd9, 0x03, 0xfb, 0x02, 0x37, 0x26, 0x81, 0xaa, 0x24, 0x3e, 0xf4, 0x2e, 0xa3, 0x6c,
+	0x8d, 0x3a, 0x01, 0xf7, 0x08, 0x68, 0x7b, 0x5e, 0xdd, 0x78, 0xdd, 0x79, 0x65, 0x05, 0x9f, 0x61,
+	0xf7, 0x18, 0x33, 0x15, 0x6d, 0x45, 0x2e, 0xd3, 0x48, 0x60, 0xac, 0x97, 0x8d, 0x98, 0x41, 0x9a,
+	0x5f, 0x27, 0x09, 0xbf, 0xd2, 0x0b, 0x1d, 0x66, 0x10, 0x99, 0x40, 0x57, 0x9b, 0x69, 0xdf, 0xf9,
+	0x1b, 0xcd, 0x07, 0xa7, 0x00, 0xb3, 0x34, 0x12, 0x71, 0xbd, 0xfd, 0x01, 0x40, 0xc2, 0x85, 0x54,
+	0xe7, 0xab, 0x48, 0xa5, 0xe6, 0xb9, 0x8e, 0x66, 0x4e, 0x23, 0x95, 0x92, 0x03, 0xe8, 0xd7, 0x1f,
+	0xb0, 0x79, 0xf5, 0xd6, 0xb7, 0x6d, 0x5a, 0xc1, 0x0c, 0x48, 0xe3, 0xc9, 0x87, 0x22, 0xc6, 0xab,
+	0x7f, 0xd9, 0x79, 0x50, 0x5f, 0x14, 0x37, 0x96, 0xba, 0xb4, 0x7d, 0x10, 0x33, 0xad, 0xb7, 0x7b,
+	0x3f, 0x6e, 0x26, 0xd6, 0xcf, 0x9b, 0x89, 0xf5, 0xeb, 0x66, 0x62, 0x7d, 0xfb, 0x3d, 0xf9, 0x6f,
+	0xde, 0xd7, 0x61, 0x3e, 0xff, 0x33, 0x00, 0xad, 0x23, 0xc7, 0xc2, 0xdd, 0x03, 0x00, 0x00,
 }
@@ -4,9 +4,13 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"io"
 	"runtime"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
@@ -60,7 +64,8 @@ func equals(lTmp, rTmp OpenHashTree) bool {
 			return false
 		}
 		if !proto.Equal(lv.DirNode, rv.DirNode) ||
-			!proto.Equal(lv.FileNode, rv.FileNode) {
+			!proto.Equal(lv.FileNode, rv.FileNode) ||
+			!proto.Equal(lv.SymlinkNode, rv.SymlinkNode) {
 			return false
 		}
 	}
@@ -492,12 +497,14 @@ func TestMerge(t *testing.T) {
 	expected, err := expectedTmp.Finish()
 	require.NoError(t, err)
 
-	h := l.Open()
+	h, err := l.Open()
+	require.NoError(t, err)
 	err = h.Merge(r)
 	require.NoError(t, err)
 	requireSame(t, expected, finish(t, h))
 
-	h = r.Open()
+	h, err = r.Open()
+	require.NoError(t, err)
 	err = h.Merge(l)
 	require.NoError(t, err)
 	requireSame(t, expected, finish(t, h))
@@ -517,7 +524,8 @@ func TestMergeEmpty(t *testing.T) {
 	require.NoError(t, err)
 
 	// Merge empty tree into full tree
-	l := expected.Open()
+	l, err := expected.Open()
+	require.NoError(t, err)
 	r := NewHashTree()
 	require.NoError(t, l.Merge(finish(t, r)))
 	requireSame(t, expected, finish(t, l))
@@ -549,6 +557,37 @@ func TestWalk(t *testing.T) {
 	require.Equal(t, 0, len(expectedPaths))
 }
 
+// Test that Verify() detects corrupted trees
+func TestVerify(t *testing.T) {
+	tmp := NewHashTree()
+	tmp.PutFile("/foo", obj(`hash:"20c27"`), 1)
+	tmp.PutFile("/dir/bar", obj(`hash:"ebc57"`), 2)
+	tree, err := tmp.Finish()
+	require.NoError(t, err)
+	require.NoError(t, Verify(tree))
+	require.NoError(t, Verify(finish(t, NewHashTree())))
+
+	// Changing a file's objects without updating its hash
+	corrupt := proto.Clone(tree.(*HashTreeProto)).(*HashTreeProto)
+	corrupt.Fs["/foo"].FileNode.Objects = obj(`hash:"aaaaa"`)
+	require.Equal(t, Corrupted, Code(Verify(corrupt)))
+
+	// A directory whose size is wrong
+	corrupt = proto.Clone(tree.(*HashTreeProto)).(*HashTreeProto)
+	corrupt.Fs["/dir"].SubtreeSize = 5
+	require.Equal(t, Corrupted, Code(Verify(corrupt)))
+
+	// A missing child
+	corrupt = proto.Clone(tree.(*HashTreeProto)).(*HashTreeProto)
+	delete(corrupt.Fs, "/dir/bar")
+	require.Equal(t, Corrupted, Code(Verify(corrupt)))
+
+	// A node that isn't listed by its parent
+	corrupt = proto.Clone(tree.(*HashTreeProto)).(*HashTreeProto)
+	corrupt.Fs["/baz"] = proto.Clone(corrupt.Fs["/foo"]).(*NodeProto)
+	require.Equal(t, Corrupted, Code(Verify(corrupt)))
+}
+
 // Test that HashTree methods return the right error codes
 func TestErrorCode(t *testing.T) {
 	require.Equal(t, OK, Code(nil))
@@ -607,6 +646,319 @@ func TestSerializeError(t *testing.T) {
 	require.Equal(t, Unsupported, Code(err))
 }
 
+func TestDeserializeVersion1(t *testing.T) {
+	hTmp := NewHashTree()
+	require.NoError(t, hTmp.PutFile("/foo", obj(`hash:"20c27"`), 1))
+	require.NoError(t, hTmp.PutFile("/bar/buzz", obj(`hash:"9d432"`), 1))
+	h := finish(t, hTmp)
+
+	// Version-1 trees were serialized as a marshalled HashTreeProto
+	bts, err := h.Marshal()
+	require.NoError(t, err)
+	h2, err := Deserialize(bts)
+	require.NoError(t, err)
+	requireSame(t, h, h2)
+}
+
+func TestSerializeDeltaEncoding(t *testing.T) {
+	hTmp := NewHashTree()
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, hTmp.PutFile(fmt.Sprintf("/file/r%05d", i), obj(`hash:"20c27"`), 1))
+	}
+	h := finish(t, hTmp)
+	bts, err := Serialize(h)
+	require.NoError(t, err)
+	v1Bts, err := h.Marshal()
+	require.NoError(t, err)
+	require.True(t, len(bts) < len(v1Bts))
+
+	// Nodes are read in path order
+	r, err := NewReader(bytes.NewReader(bts))
+	require.NoError(t, err)
+	var paths []string
+	for {
+		path, node, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.True(t, proto.Equal(h.Fs[path], node))
+		paths = append(paths, path)
+	}
+	require.Equal(t, len(h.Fs), len(paths))
+	require.Equal(t, "", paths[0])
+	require.Equal(t, "/file", paths[1])
+	require.Equal(t, "/file/r00000", paths[2])
+	require.Equal(t, "/file/r00999", paths[len(paths)-1])
+
+	// Truncated trees can't be deserialized
+	_, err = Deserialize(bts[:len(bts)-1])
+	require.YesError(t, err)
+	require.Equal(t, CannotDeserialize, Code(err))
+}
+
+// shardStore is an in-memory store for the shards of sharded hashtrees,
+// which counts how many shards have been read
+type shardStore struct {
+	shards map[string][]byte
+	reads  int
+}
+
+func newShardStore() *shardStore {
+	return &shardStore{shards: make(map[string][]byte)}
+}
+
+func (s *shardStore) put(data []byte) (*pfs.Object, error) {
+	hash := fmt.Sprintf("%x", sha256.Sum256(data))
+	s.shards[hash] = data
+	return &pfs.Object{Hash: hash}, nil
+}
+
+func (s *shardStore) get(object *pfs.Object) ([]byte, error) {
+	s.reads++
+	data, ok := s.shards[object.Hash]
+	if !ok {
+		return nil, fmt.Errorf("shard %s not found", object.Hash)
+	}
+	return data, nil
+}
+
+func TestSerializeSharded(t *testing.T) {
+	hTmp := NewHashTree()
+	for _, dir := range []string{"/a", "/b", "/c"} {
+		for i := 0; i < 100; i++ {
+			require.NoError(t, hTmp.PutFile(fmt.Sprintf("%s/r%03d", dir, i), obj(`hash:"20c27"`), 1))
+		}
+	}
+	h := finish(t, hTmp)
+
+	// Small trees aren't sharded
+	store := newShardStore()
+	bts, err := SerializeSharded(h, len(h.Fs), store.put)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(store.shards))
+	h2, err := Deserialize(bts)
+	require.NoError(t, err)
+	requireSame(t, h, h2)
+
+	bts, err = SerializeSharded(h, 50, store.put)
+	require.NoError(t, err)
+	require.Equal(t, 7, len(store.shards))
+	shards, err := Shards(bts)
+	require.NoError(t, err)
+	require.Equal(t, 7, len(shards))
+
+	// The index can't be read without its shards
+	_, err = Deserialize(bts)
+	require.YesError(t, err)
+	require.Equal(t, Unsupported, Code(err))
+
+	sharded, err := DeserializeSharded(bts, store.get)
+	require.NoError(t, err)
+	require.Equal(t, 0, store.reads)
+
+	// Reading a single file or directory only loads the shards it touches
+	node, err := sharded.Get("/b/r042")
+	require.NoError(t, err)
+	require.Equal(t, h.Fs["/b/r042"].Hash, node.Hash)
+	require.Equal(t, 1, store.reads)
+	nodes, err := sharded.Glob("/c/*")
+	require.NoError(t, err)
+	require.Equal(t, 100, len(nodes))
+	require.True(t, store.reads <= 4)
+	store.reads = 0
+	nodes, err = sharded.List("/a")
+	require.NoError(t, err)
+	require.Equal(t, 100, len(nodes))
+	require.True(t, store.reads <= 4)
+	require.Equal(t, h.FSSize(), sharded.FSSize())
+
+	// Reading the whole tree gives back the original
+	var paths []string
+	require.NoError(t, sharded.Walk(func(path string, node *NodeProto) error {
+		paths = append(paths, path)
+		return nil
+	}))
+	require.Equal(t, len(h.Fs), len(paths))
+	require.NoError(t, Verify(sharded))
+	open, err := sharded.Open()
+	require.NoError(t, err)
+	requireSame(t, h, finish(t, open))
+}
+
+func TestMergeSharded(t *testing.T) {
+	l, r := NewHashTree(), NewHashTree()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, l.PutFile(fmt.Sprintf("/dir/l%03d", i), obj(`hash:"20c27"`), 1))
+		require.NoError(t, r.PutFile(fmt.Sprintf("/dir/r%03d", i), obj(`hash:"ebc57"`), 1))
+	}
+	lh, rh := finish(t, l), finish(t, r)
+
+	store := newShardStore()
+	var sharded []HashTree
+	for _, h := range []*HashTreeProto{lh, rh} {
+		bts, err := SerializeSharded(h, 10, store.put)
+		require.NoError(t, err)
+		s, err := DeserializeSharded(bts, store.get)
+		require.NoError(t, err)
+		sharded = append(sharded, s)
+	}
+
+	expected := NewHashTree()
+	require.NoError(t, expected.Merge(lh, rh))
+	actual := NewHashTree()
+	require.NoError(t, actual.Merge(sharded...))
+	requireSame(t, finish(t, expected), finish(t, actual))
+}
+
+func TestOpenOverlay(t *testing.T) {
+	hTmp := NewHashTree()
+	for _, dir := range []string{"/a", "/b", "/c"} {
+		for i := 0; i < 100; i++ {
+			require.NoError(t, hTmp.PutFile(fmt.Sprintf("%s/r%03d", dir, i), obj(`hash:"20c27"`), 1))
+		}
+	}
+	h := finish(t, hTmp)
+	store := newShardStore()
+	bts, err := SerializeSharded(h, 50, store.put)
+	require.NoError(t, err)
+	sharded, err := DeserializeSharded(bts, store.get)
+	require.NoError(t, err)
+
+	// Apply the same changes to an overlay on the sharded tree and to a full
+	// copy of it
+	overlay, err := OpenOverlay(sharded)
+	require.NoError(t, err)
+	expected, err := h.Open()
+	require.NoError(t, err)
+	for _, o := range []OpenHashTree{overlay, expected} {
+		require.NoError(t, o.PutFile("/a/r010", obj(`hash:"ebc57"`), 1))
+		require.NoError(t, o.DeleteFile("/b"))
+		require.NoError(t, o.PutFile("/b/new", obj(`hash:"ebc57"`), 1))
+		require.NoError(t, o.PutFile("/d/r000", obj(`hash:"ebc57"`), 1))
+	}
+	// Only the shards holding the changed paths were read
+	require.True(t, store.reads < len(store.shards))
+	_, err = overlay.Get("/b/r042")
+	require.Equal(t, PathNotFound, Code(err))
+	nodes, err := overlay.List("/b")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(nodes))
+
+	finished, err := overlay.Finish()
+	require.NoError(t, err)
+	expectedTree := finish(t, expected)
+	require.NoError(t, Verify(finished))
+	require.Equal(t, expectedTree.FSSize(), finished.FSSize())
+	open, err := finished.Open()
+	require.NoError(t, err)
+	requireSame(t, expectedTree, finish(t, open))
+
+	// Only the shards that changed are rewritten
+	shardsBefore := len(store.shards)
+	bts, err = SerializeSharded(finished, 50, store.put)
+	require.NoError(t, err)
+	require.True(t, len(store.shards)-shardsBefore < 7)
+	resharded, err := DeserializeSharded(bts, store.get)
+	require.NoError(t, err)
+	node, err := resharded.Get("/a/r010")
+	require.NoError(t, err)
+	require.Equal(t, expectedTree.Fs["/a/r010"].Hash, node.Hash)
+	open, err = resharded.Open()
+	require.NoError(t, err)
+	requireSame(t, expectedTree, finish(t, open))
+
+	// Overlays on overlays are folded into one
+	overlay2, err := OpenOverlay(finished)
+	require.NoError(t, err)
+	require.NoError(t, overlay2.DeleteFile("/d"))
+	require.NoError(t, expected.DeleteFile("/d"))
+	finished2, err := overlay2.Finish()
+	require.NoError(t, err)
+	open, err = finished2.Open()
+	require.NoError(t, err)
+	requireSame(t, finish(t, expected), finish(t, open))
+}
+
+func TestShardedLoadOnce(t *testing.T) {
+	hTmp := NewHashTree()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, hTmp.PutFile(fmt.Sprintf("/dir/r%03d", i), obj(`hash:"20c27"`), 1))
+	}
+	store := newShardStore()
+	bts, err := SerializeSharded(finish(t, hTmp), 10, store.put)
+	require.NoError(t, err)
+
+	// Concurrent reads of a shard wait for a single fetch of it
+	var mu sync.Mutex
+	reads := 0
+	release := make(chan struct{})
+	sharded, err := DeserializeSharded(bts, func(object *pfs.Object) ([]byte, error) {
+		mu.Lock()
+		reads++
+		mu.Unlock()
+		<-release
+		return store.shards[object.Hash], nil
+	})
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := sharded.Get("/dir/r042")
+			require.NoError(t, err)
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, 1, reads)
+}
+
+func TestSymlinksAndAttrs(t *testing.T) {
+	h := NewHashTree()
+	require.NoError(t, h.PutFile("/bin/run", obj(`hash:"20c27"`), 1))
+	require.NoError(t, h.PutSymlink("/run", "bin/run"))
+	before := finish(t, h)
+
+	// Symlinks conflict with files and directories
+	require.Equal(t, PathConflict, Code(h.PutSymlink("/bin/run", "foo")))
+	require.Equal(t, PathConflict, Code(h.PutFile("/run", obj(`hash:"ebc57"`), 1)))
+	require.Equal(t, PathConflict, Code(h.PutFile("/run/foo", obj(`hash:"ebc57"`), 1)))
+
+	// The mode is part of the hash, but the mtime isn't
+	mtime := &types.Timestamp{Seconds: 1234}
+	require.NoError(t, h.SetAttrs("/bin/run", 0, mtime))
+	require.Equal(t, before.Fs[""].Hash, finish(t, h).Fs[""].Hash)
+	require.NoError(t, h.SetAttrs("/bin/run", 0755, nil))
+	after := finish(t, h)
+	require.NotEqual(t, before.Fs[""].Hash, after.Fs[""].Hash)
+	node, err := after.Get("/bin/run")
+	require.NoError(t, err)
+	require.Equal(t, uint32(0755), node.Mode)
+	require.Equal(t, int64(1234), node.Mtime.Seconds)
+	require.Equal(t, PathNotFound, Code(h.SetAttrs("/missing", 0755, nil)))
+
+	// Changing a symlink's target changes its hash
+	require.NoError(t, h.PutSymlink("/run", "bin/other"))
+	node, err = finish(t, h).Get("/run")
+	require.NoError(t, err)
+	require.Equal(t, "bin/other", node.SymlinkNode.Target)
+	require.NotEqual(t, after.Fs["/run"].Hash, node.Hash)
+	require.NoError(t, Verify(finish(t, h)))
+
+	// Merging keeps symlinks and attributes
+	merged := NewHashTree()
+	require.NoError(t, merged.Merge(after))
+	requireSame(t, after, finish(t, merged))
+
+	// Deleting a symlink removes it
+	require.NoError(t, h.DeleteFile("/run"))
+	_, err = h.Get("/run")
+	require.Equal(t, PathNotFound, Code(err))
+}
+
 func TestListEmpty(t *testing.T) {
 	tree := NewHashTree()
 	_, err := tree.List("/")
@@ -1,6 +1,7 @@
 package hashtree
 
 import (
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 )
 
@@ -43,14 +44,20 @@ const (
 	//    points to a file.
 	// 3. Merge is forced to merge a directory into a file
 	PathConflict
+
+	// Corrupted is returned by Verify() when a HashTree's nodes are
+	// inconsistent with each other, e.g. a node's hash doesn't match its
+	// contents.
+	Corrupted
 )
 
 // HashTree is the signature of a hash tree provided by this library. To get a
 // new HashTree, create an OpenHashTree with NewHashTree(), modify it, and then
 // call Finish() on it.
 type HashTree interface {
-	// Open makes a deep copy of the HashTree and returns the copy
-	Open() OpenHashTree
+	// Open makes a deep copy of the HashTree and returns the copy. It fails
+	// only if the HashTree is sharded and a shard can't be loaded.
+	Open() (OpenHashTree, error)
 
 	// Get retrieves a file.
 	Get(path string) (*NodeProto, error)
@@ -84,8 +91,12 @@ type OpenNode struct {
 	Name string
 	Size int64
 
-	FileNode *FileNodeProto
-	DirNode  *DirectoryNodeProto
+	FileNode    *FileNodeProto
+	DirNode     *DirectoryNodeProto
+	SymlinkNode *SymlinkNodeProto
+
+	Mode  uint32
+	Mtime *types.Timestamp
 }
 
 // OpenHashTree is like HashTree, except that it can be modified. Once an
@@ -103,7 +114,16 @@ type OpenHashTree interface {
 	// PutDir creates a directory (or does nothing if one exists).
 	PutDir(path string) error
 
-	// DeleteFile deletes a regular file or directory (along with its children).
+	// PutSymlink creates a symlink pointing to 'target' (or changes its target
+	// if a symlink is already there).
+	PutSymlink(path string, target string) error
+
+	// SetAttrs sets the POSIX permission bits and modification time of the
+	// node at 'path'. A 0 'mode' or nil 'mtime' leaves that attribute as is.
+	SetAttrs(path string, mode uint32, mtime *types.Timestamp) error
+
+	// DeleteFile deletes a regular file, symlink or directory (along with its
+	// children).
 	DeleteFile(path string) error
 
 	// Merge adds all of the files and directories in each tree in 'trees' into
@@ -0,0 +1,232 @@
+package hashtree
+
+import (
+	"github.com/golang/protobuf/proto"
+)
+
+// OpenOverlay returns an OpenHashTree that modifies 'h'. If 'h' is sharded,
+// it isn't loaded: the returned tree reads the nodes of 'h' as they're needed
+// and only copies the ones it modifies, so that changing a few paths in a
+// large tree doesn't load all of its shards. When the finished tree is
+// serialized with SerializeSharded, only the shards holding changed paths are
+// rewritten. If 'h' isn't sharded, OpenOverlay is the same as h.Open().
+func OpenOverlay(h HashTree) (OpenHashTree, error) {
+	switch h.(type) {
+	case *shardedHashTree, *overlayHashTree:
+		return &hashtree{
+			fs:      make(map[string]*NodeProto),
+			changed: make(map[string]bool),
+			base:    h,
+			deleted: make(map[string]bool),
+		}, nil
+	}
+	return h.Open()
+}
+
+// hidden returns true if 'path' or one of its parents is in 'deleted'
+func hidden(deleted map[string]bool, path string) bool {
+	if len(deleted) == 0 {
+		return false
+	}
+	for {
+		if deleted[path] {
+			return true
+		}
+		if path == "" {
+			return false
+		}
+		path, _ = split(path)
+	}
+}
+
+// lookupOverlay returns the node at 'path' in 'fs' if it's there, or in
+// 'base' if it isn't hidden by 'deleted'
+func lookupOverlay(base HashTree, fs map[string]*NodeProto, deleted map[string]bool, path string) (*NodeProto, bool, error) {
+	if node, ok := fs[path]; ok {
+		return node, true, nil
+	}
+	if hidden(deleted, path) {
+		return nil, false, nil
+	}
+	node, err := base.Get(path)
+	if err != nil {
+		if Code(err) == PathNotFound {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return node, true, nil
+}
+
+// getOverlay is like get(), but for nodes that may be in 'base'
+func getOverlay(base HashTree, fs map[string]*NodeProto, deleted map[string]bool, path string) (*NodeProto, error) {
+	path = clean(path)
+	node, ok, err := lookupOverlay(base, fs, deleted, path)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorf(PathNotFound, "no node at \"%s\"", path)
+	}
+	return node, nil
+}
+
+// globOverlay is like glob(), but for nodes that may be in 'base'
+func globOverlay(base HashTree, fs map[string]*NodeProto, deleted map[string]bool, pattern string) ([]*NodeProto, error) {
+	nodes, err := base.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var result []*NodeProto
+	for _, node := range nodes {
+		// Glob sets each node's Name to its full path
+		path := clean(node.Name)
+		if _, ok := fs[path]; ok || hidden(deleted, path) {
+			continue
+		}
+		result = append(result, node)
+	}
+	fsNodes, err := glob(fs, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, fsNodes...), nil
+}
+
+// walkOverlay is like walk(), but for nodes that may be in 'base'. The nodes
+// in 'base' are visited before those in 'fs'.
+func walkOverlay(base HashTree, fs map[string]*NodeProto, deleted map[string]bool, f func(string, *NodeProto) error) error {
+	if err := base.Walk(func(path string, node *NodeProto) error {
+		p := clean(path)
+		if _, ok := fs[p]; ok || hidden(deleted, p) {
+			return nil
+		}
+		return f(path, node)
+	}); err != nil {
+		return err
+	}
+	return walk(fs, f)
+}
+
+// listNodes retrieves the children of the directory at 'path' in 'h' one at
+// a time, for trees whose nodes aren't all in one map
+func listNodes(h HashTree, path string) ([]*NodeProto, error) {
+	path = clean(path)
+	node, err := h.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	d := node.DirNode
+	if d == nil {
+		return nil, errorf(PathConflict, "the file at \"%s\" is not a directory",
+			path)
+	}
+	result := make([]*NodeProto, len(d.Children))
+	for i, child := range d.Children {
+		result[i], err = h.Get(join(path, child))
+		if err != nil {
+			if Code(err) == PathNotFound {
+				return nil, errorf(Internal, "could not find node for the child "+
+					"\"%s\" while listing \"%s\"", join(path, child), path)
+			}
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// finishOverlay returns a finished copy of 'h', which must have a base. The
+// nodes in h.fs are copied, so that 'h' can go on being modified. If h.base
+// is itself an overlay, the changes in 'h' are folded into it, so that the
+// result is always a single overlay on a sharded tree.
+func (h *hashtree) finishOverlay() HashTree {
+	result := &overlayHashTree{
+		fs:      make(map[string]*NodeProto),
+		deleted: make(map[string]bool),
+	}
+	switch base := h.base.(type) {
+	case *shardedHashTree:
+		result.base = base
+	case *overlayHashTree:
+		result.base = base.base
+		for path, node := range base.fs {
+			if !hidden(h.deleted, path) {
+				result.fs[path] = node
+			}
+		}
+		for path := range base.deleted {
+			result.deleted[path] = true
+		}
+	}
+	for path := range h.deleted {
+		result.deleted[path] = true
+	}
+	for path, node := range h.fs {
+		result.fs[path] = proto.Clone(node).(*NodeProto)
+	}
+	return result
+}
+
+// overlayHashTree is a finished HashTree made of the nodes of a sharded tree,
+// some of which have been replaced, added or deleted. It's the result of
+// finishing a tree opened with OpenOverlay.
+type overlayHashTree struct {
+	base *shardedHashTree
+	// fs holds the nodes that replace or add to the nodes of 'base'
+	fs map[string]*NodeProto
+	// deleted holds the paths whose nodes in 'base' (and the nodes under
+	// them) aren't in the tree, unless they're in 'fs'
+	deleted map[string]bool
+}
+
+// Open makes a deep copy of the HashTree, loading every shard of its base,
+// and returns the copy
+func (h *overlayHashTree) Open() (OpenHashTree, error) {
+	tree := &HashTreeProto{
+		Version: 1,
+		Fs:      make(map[string]*NodeProto),
+	}
+	if err := h.Walk(func(path string, node *NodeProto) error {
+		tree.Fs[clean(path)] = node
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return tree.Open()
+}
+
+// Get retrieves the contents of a file.
+func (h *overlayHashTree) Get(path string) (*NodeProto, error) {
+	return getOverlay(h.base, h.fs, h.deleted, path)
+}
+
+// List retrieves the list of files and subdirectories of the directory at
+// 'path'.
+func (h *overlayHashTree) List(path string) ([]*NodeProto, error) {
+	return listNodes(h, path)
+}
+
+// Glob returns a list of files and directories that match 'pattern'.
+func (h *overlayHashTree) Glob(pattern string) ([]*NodeProto, error) {
+	return globOverlay(h.base, h.fs, h.deleted, pattern)
+}
+
+// FSSize returns the size of the file system that the hashtree represents.
+func (h *overlayHashTree) FSSize() int64 {
+	node, err := h.Get("/")
+	if err != nil {
+		return 0
+	}
+	return node.SubtreeSize
+}
+
+// Walk implements HashTree.Walk. The shards of the base are loaded one at a
+// time.
+func (h *overlayHashTree) Walk(f func(string, *NodeProto) error) error {
+	return walkOverlay(h.base, h.fs, h.deleted, f)
+}
+
+// Diff implements HashTree.Diff
+func (h *overlayHashTree) Diff(old HashTree, newPath string, oldPath string, recursiveDepth int64, f func(string, *NodeProto, bool) error) error {
+	return diff(h, old, newPath, oldPath, recursiveDepth, f)
+}
@@ -0,0 +1,152 @@
+package hashtree
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// header is the start of every version-2 serialized HashTree: a marshalled
+// HashTreeProto whose only field is 'version' (field 1, a varint) set to 2.
+// See "Serialization" in hashtree.proto.
+var header = []byte{0x08, 0x02}
+
+// indexHeader is the start of the index of every sharded HashTree (a
+// marshalled HashTreeIndexProto with 'version' set to 3). See "Sharding" in
+// hashtree.proto.
+var indexHeader = []byte{0x08, 0x03}
+
+// serialize writes 'h' to 'w' in the version-2 format
+func (h *HashTreeProto) serialize(w io.Writer) error {
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(h.Fs))
+	for path := range h.Fs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var prev string
+	var size [binary.MaxVarintLen64]byte
+	for _, path := range paths {
+		shared := 0
+		for shared < len(prev) && shared < len(path) && prev[shared] == path[shared] {
+			shared++
+		}
+		data, err := (&DeltaNodeProto{
+			Shared: uint32(shared),
+			Suffix: path[shared:],
+			Node:   h.Fs[path],
+		}).Marshal()
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(size[:binary.PutUvarint(size[:], uint64(len(data)))]); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		prev = path
+	}
+	return nil
+}
+
+// Reader reads the nodes of a serialized HashTree one at a time, in path
+// order. Version-2 trees are read incrementally, so the whole serialized tree
+// never needs to be in memory. Version-1 trees are read into memory first.
+type Reader struct {
+	r *bufio.Reader
+	// path is the path of the previous node read from a version-2 tree
+	path    string
+	started bool
+
+	// fs and paths are the nodes of a version-1 tree, and their sorted paths
+	// that haven't been read yet
+	fs    map[string]*NodeProto
+	paths []string
+}
+
+// NewReader returns a Reader that reads the serialized HashTree in 'r'.
+func NewReader(r io.Reader) (*Reader, error) {
+	bufioR := bufio.NewReader(r)
+	start, err := bufioR.Peek(len(header))
+	if err == nil && bytes.Equal(start, header) {
+		if _, err := bufioR.Discard(len(header)); err != nil {
+			return nil, err
+		}
+		return &Reader{r: bufioR}, nil
+	}
+	if err == nil && bytes.Equal(start, indexHeader) {
+		return nil, errorf(Unsupported, "the HashTree is sharded, and can "+
+			"only be read with DeserializeSharded")
+	}
+
+	// This isn't a version-2 tree, so it must be a version-1 tree (a
+	// marshalled HashTreeProto)
+	data, err := ioutil.ReadAll(bufioR)
+	if err != nil {
+		return nil, err
+	}
+	h := &HashTreeProto{}
+	if err := h.Unmarshal(data); err != nil {
+		return nil, errorf(CannotDeserialize, "could not unmarshal "+
+			"HashTreeProto: %v", err)
+	}
+	if h.Version != 1 {
+		return nil, errorf(Unsupported, "unsupported HashTreeProto "+
+			"version %d", h.Version)
+	}
+	paths := make([]string, 0, len(h.Fs))
+	for path := range h.Fs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return &Reader{fs: h.Fs, paths: paths}, nil
+}
+
+// Read returns the path and node of the next node in the tree. It returns
+// io.EOF once every node has been read.
+func (r *Reader) Read() (string, *NodeProto, error) {
+	if r.r == nil {
+		if len(r.paths) == 0 {
+			return "", nil, io.EOF
+		}
+		path := r.paths[0]
+		r.paths = r.paths[1:]
+		return path, r.fs[path], nil
+	}
+
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return "", nil, io.EOF
+		}
+		return "", nil, errorf(CannotDeserialize, "could not read the size of "+
+			"the node after \"%s\": %v", r.path, err)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return "", nil, errorf(CannotDeserialize, "could not read the node "+
+			"after \"%s\": %v", r.path, err)
+	}
+	delta := &DeltaNodeProto{}
+	if err := delta.Unmarshal(data); err != nil {
+		return "", nil, errorf(CannotDeserialize, "could not unmarshal the "+
+			"node after \"%s\": %v", r.path, err)
+	}
+	if int(delta.Shared) > len(r.path) {
+		return "", nil, errorf(CannotDeserialize, "the node after \"%s\" "+
+			"shares %d bytes with it", r.path, delta.Shared)
+	}
+	path := r.path[:delta.Shared] + delta.Suffix
+	if r.started && path <= r.path {
+		return "", nil, errorf(CannotDeserialize, "node \"%s\" is out of "+
+			"order (it follows \"%s\")", path, r.path)
+	}
+	r.path, r.started = path, true
+	return path, delta.Node, nil
+}
@@ -0,0 +1,368 @@
+package hashtree
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// DefaultShardSize is the number of nodes in each shard of a tree serialized
+// by SerializeSharded, unless the caller chooses otherwise.
+const DefaultShardSize = 100000
+
+// maxLoadedShards is the number of shards that a sharded tree keeps in memory
+// at a time
+const maxLoadedShards = 8
+
+// SerializeSharded serializes a HashTree like Serialize, except that if it has
+// more than 'shardSize' nodes, it's split into shards of 'shardSize' nodes,
+// which are stored with 'putShard'. In that case, the index of the shards is
+// returned. See "Sharding" in hashtree.proto.
+func SerializeSharded(h HashTree, shardSize int, putShard func([]byte) (*pfs.Object, error)) ([]byte, error) {
+	if tree, ok := h.(*overlayHashTree); ok {
+		return tree.serializeSharded(shardSize, putShard)
+	}
+	tree, ok := h.(*HashTreeProto)
+	if !ok {
+		return nil, fmt.Errorf("HashTree is of the wrong concrete type")
+	}
+	if len(tree.Fs) <= shardSize {
+		return Serialize(tree)
+	}
+	shards, err := putShards(tree.Fs, "", shardSize, putShard)
+	if err != nil {
+		return nil, err
+	}
+	index := &HashTreeIndexProto{Version: 3, Shards: shards}
+	return index.Marshal()
+}
+
+// putShards splits the nodes in 'fs' into shards of 'shardSize' nodes (in
+// path order), stores them with 'putShard' and returns them. The first shard
+// starts at 'firstPath', and the others at their first node.
+func putShards(fs map[string]*NodeProto, firstPath string, shardSize int, putShard func([]byte) (*pfs.Object, error)) ([]*ShardProto, error) {
+	paths := make([]string, 0, len(fs))
+	for path := range fs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var result []*ShardProto
+	for len(paths) > 0 {
+		n := shardSize
+		if n > len(paths) {
+			n = len(paths)
+		}
+		shard := &HashTreeProto{
+			Version: 1,
+			Fs:      make(map[string]*NodeProto, n),
+		}
+		for _, path := range paths[:n] {
+			shard.Fs[path] = fs[path]
+		}
+		var buf bytes.Buffer
+		if err := shard.serialize(&buf); err != nil {
+			return nil, err
+		}
+		object, err := putShard(buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		if len(result) > 0 {
+			firstPath = paths[0]
+		}
+		result = append(result, &ShardProto{
+			FirstPath: firstPath,
+			Object:    object,
+		})
+		paths = paths[n:]
+	}
+	return result, nil
+}
+
+// serializeSharded serializes an overlay on a sharded tree. Only the shards
+// of the base that hold changed or deleted paths are loaded (one at a time)
+// and rewritten; the others are reused as they are. A rewritten shard that
+// has grown to more than twice 'shardSize' nodes is split, and one that has
+// no nodes left is dropped.
+func (h *overlayHashTree) serializeSharded(shardSize int, putShard func([]byte) (*pfs.Object, error)) ([]byte, error) {
+	base := h.base
+	changed := make(map[int]bool)
+	for path := range h.fs {
+		changed[base.shardFor(path)] = true
+	}
+	for path := range h.deleted {
+		for _, i := range base.shardsWithPrefix(path) {
+			changed[i] = true
+		}
+	}
+	index := &HashTreeIndexProto{Version: 3}
+	for i, shard := range base.shards {
+		if !changed[i] {
+			index.Shards = append(index.Shards, shard)
+			continue
+		}
+		fs, err := base.load(i)
+		if err != nil {
+			return nil, err
+		}
+		nodes := make(map[string]*NodeProto, len(fs))
+		for path, node := range fs {
+			if !hidden(h.deleted, path) {
+				nodes[path] = node
+			}
+		}
+		for path, node := range h.fs {
+			if base.shardFor(path) == i {
+				nodes[path] = node
+			}
+		}
+		n := shardSize
+		if len(nodes) <= 2*shardSize {
+			n = len(nodes)
+		}
+		shards, err := putShards(nodes, shard.FirstPath, n, putShard)
+		if err != nil {
+			return nil, err
+		}
+		index.Shards = append(index.Shards, shards...)
+	}
+	return index.Marshal()
+}
+
+// DeserializeSharded deserializes a HashTree serialized by SerializeSharded.
+// If the tree is sharded, the returned HashTree loads its shards with
+// 'getShard' as they're needed, rather than all at once. Otherwise, it's the
+// same as Deserialize.
+func DeserializeSharded(serialized []byte, getShard func(*pfs.Object) ([]byte, error)) (HashTree, error) {
+	if !bytes.HasPrefix(serialized, indexHeader) {
+		return Deserialize(serialized)
+	}
+	index := &HashTreeIndexProto{}
+	if err := index.Unmarshal(serialized); err != nil {
+		return nil, errorf(CannotDeserialize, "could not unmarshal "+
+			"HashTreeIndexProto: %v", err)
+	}
+	if len(index.Shards) == 0 || index.Shards[0].FirstPath != "" {
+		return nil, errorf(CannotDeserialize, "the first shard of the "+
+			"HashTree doesn't start at the root")
+	}
+	return &shardedHashTree{
+		shards:   index.Shards,
+		getShard: getShard,
+		loaded:   make(map[int]map[string]*NodeProto),
+		loading:  make(map[int]*shardLoad),
+	}, nil
+}
+
+// Shards returns the objects holding the shards of a serialized HashTree, or
+// nil if it isn't sharded.
+func Shards(serialized []byte) ([]*pfs.Object, error) {
+	if !bytes.HasPrefix(serialized, indexHeader) {
+		return nil, nil
+	}
+	index := &HashTreeIndexProto{}
+	if err := index.Unmarshal(serialized); err != nil {
+		return nil, errorf(CannotDeserialize, "could not unmarshal "+
+			"HashTreeIndexProto: %v", err)
+	}
+	var result []*pfs.Object
+	for _, shard := range index.Shards {
+		result = append(result, shard.Object)
+	}
+	return result, nil
+}
+
+// shardedHashTree is an implementation of HashTree that loads the shards of a
+// sharded tree lazily. It's safe for concurrent use.
+type shardedHashTree struct {
+	shards   []*ShardProto
+	getShard func(*pfs.Object) ([]byte, error)
+
+	// mu protects 'loaded', 'lru' and 'loading'
+	mu sync.Mutex
+	// loaded maps the index of each shard in memory to its nodes
+	loaded map[int]map[string]*NodeProto
+	// lru holds the indexes of the shards in 'loaded', least recently used
+	// first
+	lru []int
+	// loading maps the index of each shard that's being fetched to its
+	// load, so that concurrent readers of a shard fetch it once
+	loading map[int]*shardLoad
+}
+
+// shardLoad is the fetch of one shard, which readers of the shard wait on
+type shardLoad struct {
+	// done is closed when the fetch has finished
+	done chan struct{}
+	fs   map[string]*NodeProto
+	err  error
+}
+
+// shardFor returns the index of the shard that holds 'path', if it exists
+func (h *shardedHashTree) shardFor(path string) int {
+	// Find the first shard that starts after 'path'; the shard before it holds
+	// 'path'
+	return sort.Search(len(h.shards), func(i int) bool {
+		return h.shards[i].FirstPath > path
+	}) - 1
+}
+
+// load returns the nodes in shard 'i', loading it if it isn't in memory. The
+// shard is fetched without holding h.mu, so that reads of other shards aren't
+// blocked by it.
+func (h *shardedHashTree) load(i int) (map[string]*NodeProto, error) {
+	h.mu.Lock()
+	if fs, ok := h.loaded[i]; ok {
+		for j, k := range h.lru {
+			if k == i {
+				h.lru = append(append(h.lru[:j:j], h.lru[j+1:]...), i)
+				break
+			}
+		}
+		h.mu.Unlock()
+		return fs, nil
+	}
+	if l, ok := h.loading[i]; ok {
+		h.mu.Unlock()
+		<-l.done
+		return l.fs, l.err
+	}
+	l := &shardLoad{done: make(chan struct{})}
+	h.loading[i] = l
+	h.mu.Unlock()
+
+	l.fs, l.err = h.fetch(i)
+
+	h.mu.Lock()
+	delete(h.loading, i)
+	if l.err == nil {
+		if len(h.lru) >= maxLoadedShards {
+			delete(h.loaded, h.lru[0])
+			h.lru = h.lru[1:]
+		}
+		h.loaded[i] = l.fs
+		h.lru = append(h.lru, i)
+	}
+	h.mu.Unlock()
+	close(l.done)
+	return l.fs, l.err
+}
+
+// fetch reads and deserializes shard 'i'
+func (h *shardedHashTree) fetch(i int) (map[string]*NodeProto, error) {
+	data, err := h.getShard(h.shards[i].Object)
+	if err != nil {
+		return nil, err
+	}
+	shard, err := Deserialize(data)
+	if err != nil {
+		return nil, err
+	}
+	return shard.(*HashTreeProto).Fs, nil
+}
+
+// shardsWithPrefix returns the indexes of the shards that may hold paths
+// starting with 'prefix'
+func (h *shardedHashTree) shardsWithPrefix(prefix string) []int {
+	var result []int
+	// The first shard starts at "", so shardFor() always finds a shard
+	for i := h.shardFor(prefix); i < len(h.shards); i++ {
+		firstPath := h.shards[i].FirstPath
+		if firstPath > prefix && !strings.HasPrefix(firstPath, prefix) {
+			break
+		}
+		result = append(result, i)
+	}
+	return result
+}
+
+// Open makes a deep copy of the HashTree, loading every shard, and returns
+// the copy
+func (h *shardedHashTree) Open() (OpenHashTree, error) {
+	tree := &HashTreeProto{
+		Version: 1,
+		Fs:      make(map[string]*NodeProto),
+	}
+	for i := range h.shards {
+		fs, err := h.load(i)
+		if err != nil {
+			return nil, err
+		}
+		for path, node := range fs {
+			tree.Fs[path] = node
+		}
+	}
+	return tree.Open()
+}
+
+// Get retrieves the contents of a file.
+func (h *shardedHashTree) Get(path string) (*NodeProto, error) {
+	path = clean(path)
+	fs, err := h.load(h.shardFor(path))
+	if err != nil {
+		return nil, err
+	}
+	return get(fs, path)
+}
+
+// List retrieves the list of files and subdirectories of the directory at
+// 'path'.
+func (h *shardedHashTree) List(path string) ([]*NodeProto, error) {
+	return listNodes(h, path)
+}
+
+// Glob returns a list of files and directories that match 'pattern'. Only
+// the shards that may hold paths starting with the part of 'pattern' before
+// its first wildcard are loaded.
+func (h *shardedHashTree) Glob(pattern string) ([]*NodeProto, error) {
+	pattern = clean(pattern)
+	prefix := pattern
+	if i := strings.IndexAny(pattern, "*?[\\"); i >= 0 {
+		prefix = pattern[:i]
+	}
+	var result []*NodeProto
+	for _, i := range h.shardsWithPrefix(prefix) {
+		fs, err := h.load(i)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := glob(fs, pattern)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nodes...)
+	}
+	return result, nil
+}
+
+// FSSize returns the size of the file system that the hashtree represents.
+func (h *shardedHashTree) FSSize() int64 {
+	fs, err := h.load(0)
+	if err != nil {
+		return 0
+	}
+	return size(fs)
+}
+
+// Walk implements HashTree.Walk. Shards are loaded one at a time.
+func (h *shardedHashTree) Walk(f func(string, *NodeProto) error) error {
+	for i := range h.shards {
+		fs, err := h.load(i)
+		if err != nil {
+			return err
+		}
+		if err := walk(fs, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff implements HashTree.Diff
+func (h *shardedHashTree) Diff(old HashTree, newPath string, oldPath string, recursiveDepth int64, f func(string, *NodeProto, bool) error) error {
+	return diff(h, old, newPath, oldPath, recursiveDepth, f)
+}
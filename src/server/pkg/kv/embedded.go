@@ -0,0 +1,748 @@
+package kv
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// historyRevisions is the number of recent revisions that an Embedded
+	// store keeps, for reads at old revisions and for watches that start in
+	// the past. The store keeps up to twice as many between compactions.
+	historyRevisions = 10000
+	// leaseCheckInterval is how often an Embedded store deletes the keys of
+	// expired leases
+	leaseCheckInterval = time.Second
+)
+
+var errClosed = errors.New("kv: store is closed")
+
+// Embedded is a Store that runs in the process, for single-node deployments.
+// It keeps its keys in memory, and appends every change to a log file, which
+// is replayed when the store is opened. The log is rewritten as a snapshot of
+// the store's keys when it's opened and whenever its history is compacted, so
+// that it doesn't grow without bound.
+type Embedded struct {
+	mu  sync.Mutex
+	rev int64
+	// compacted is the newest revision that reads and watches can't start
+	// from anymore
+	compacted int64
+	keys      map[string]*keyHistory
+	// sortedKeys holds the keys of 'keys', in order, for prefix reads
+	sortedKeys []string
+	// history holds the events of the revisions after 'compacted'
+	history   []*revisionEvents
+	leases    map[LeaseID]*lease
+	nextLease LeaseID
+	watchers  map[*embeddedWatcher]bool
+	// path is the path of the log file, and file is the open log file, or
+	// nil if the store isn't persisted
+	path   string
+	file   *os.File
+	done   chan struct{}
+	closed bool
+}
+
+// keyHistory holds the versions of a key, oldest first. A nil KeyValue means
+// that the key was deleted.
+type keyHistory struct {
+	versions []keyVersion
+}
+
+type keyVersion struct {
+	rev int64
+	kv  *KeyValue
+}
+
+func (h *keyHistory) at(rev int64) *KeyValue {
+	i := sort.Search(len(h.versions), func(i int) bool { return h.versions[i].rev > rev })
+	if i == 0 {
+		return nil
+	}
+	return h.versions[i-1].kv
+}
+
+func (h *keyHistory) current() *KeyValue {
+	return h.versions[len(h.versions)-1].kv
+}
+
+type revisionEvents struct {
+	rev    int64
+	events []*Event
+}
+
+// logRecord is a line of the log file.
+type logRecord struct {
+	Revision int64          `json:"revision,omitempty"`
+	Events   []*Event       `json:"events,omitempty"`
+	Granted  []*leaseRecord `json:"granted,omitempty"`
+	Revoked  []LeaseID      `json:"revoked,omitempty"`
+}
+
+type lease struct {
+	ttl     int64
+	expires time.Time
+}
+
+type leaseRecord struct {
+	ID      LeaseID   `json:"id"`
+	TTL     int64     `json:"ttl,omitempty"`
+	Expires time.Time `json:"expires"`
+}
+
+// NewEmbedded opens the Embedded store that logs its changes to 'path',
+// creating it if it doesn't exist. If 'path' is empty, the store is only kept
+// in memory.
+func NewEmbedded(path string) (*Embedded, error) {
+	e := &Embedded{
+		rev:       1,
+		keys:      make(map[string]*keyHistory),
+		leases:    make(map[LeaseID]*lease),
+		nextLease: 1,
+		watchers:  make(map[*embeddedWatcher]bool),
+		path:      path,
+		done:      make(chan struct{}),
+	}
+	if path != "" {
+		if err := e.replay(path); err != nil {
+			return nil, err
+		}
+		// Rewrite the log as a single record, so that it doesn't grow
+		// across restarts
+		if err := e.snapshot(); err != nil {
+			return nil, err
+		}
+	}
+	// Events from before the store was opened aren't kept
+	e.compacted = e.rev
+	go e.expireLeases()
+	return e, nil
+}
+
+// replay applies the records in the log file at 'path'.
+func (e *Embedded) replay(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// A line without a newline is a write that was interrupted, so
+			// it was never acknowledged
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		record := &logRecord{}
+		if err := json.Unmarshal(line, record); err != nil {
+			return fmt.Errorf("could not parse %s: %v", path, err)
+		}
+		if record.Revision > e.rev {
+			e.rev = record.Revision
+		}
+		for _, ev := range record.Events {
+			if ev.Type == EventPut {
+				e.setVersion(string(ev.Kv.Key), keyVersion{rev: ev.Kv.ModRevision, kv: ev.Kv})
+			} else {
+				e.removeKey(string(ev.Kv.Key))
+			}
+		}
+		// Renewed leases are logged as granted again
+		for _, l := range record.Granted {
+			e.leases[l.ID] = &lease{ttl: l.TTL, expires: l.Expires}
+			if l.ID >= e.nextLease {
+				e.nextLease = l.ID + 1
+			}
+		}
+		for _, id := range record.Revoked {
+			delete(e.leases, id)
+		}
+	}
+}
+
+// snapshot replaces the log file with a single record of the store's keys and
+// leases, and opens it for appending. If it fails, the old log file is kept.
+func (e *Embedded) snapshot() error {
+	record := &logRecord{Revision: e.rev}
+	for _, key := range e.sortedKeys {
+		if kv := e.keys[key].current(); kv != nil {
+			record.Events = append(record.Events, &Event{Type: EventPut, Kv: kv})
+		}
+	}
+	for id, l := range e.leases {
+		record.Granted = append(record.Granted, &leaseRecord{ID: id, TTL: l.ttl, Expires: l.expires})
+	}
+	tmpPath := e.path + ".tmp"
+	if err := func() (retErr error) {
+		f, err := os.Create(tmpPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := f.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		if err := writeRecord(f, record); err != nil {
+			return err
+		}
+		return f.Sync()
+	}(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if e.file != nil {
+		if err := e.file.Close(); err != nil {
+			return err
+		}
+		e.file = nil
+	}
+	renameErr := os.Rename(tmpPath, e.path)
+	// Whether or not the rename succeeded, the log file at 'path' is
+	// complete, so appending to it is safe
+	file, err := os.OpenFile(e.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	e.file = file
+	return renameErr
+}
+
+func writeRecord(w io.Writer, record *logRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(line, '\n'))
+	return err
+}
+
+// log appends 'record' to the log file.
+func (e *Embedded) log(record *logRecord) error {
+	if e.file == nil {
+		return nil
+	}
+	if err := writeRecord(e.file, record); err != nil {
+		return err
+	}
+	return e.file.Sync()
+}
+
+// setVersion adds a version to the history of 'key'.
+func (e *Embedded) setVersion(key string, version keyVersion) {
+	h, ok := e.keys[key]
+	if !ok {
+		h = &keyHistory{}
+		e.keys[key] = h
+		i := sort.SearchStrings(e.sortedKeys, key)
+		e.sortedKeys = append(e.sortedKeys, "")
+		copy(e.sortedKeys[i+1:], e.sortedKeys[i:])
+		e.sortedKeys[i] = key
+	}
+	h.versions = append(h.versions, version)
+}
+
+// removeKey forgets 'key' and its history.
+func (e *Embedded) removeKey(key string) {
+	if _, ok := e.keys[key]; !ok {
+		return
+	}
+	delete(e.keys, key)
+	i := sort.SearchStrings(e.sortedKeys, key)
+	e.sortedKeys = append(e.sortedKeys[:i], e.sortedKeys[i+1:]...)
+}
+
+// keysInRange returns the keys that a read of 'key' covers.
+func (e *Embedded) keysInRange(key string, prefix bool) []string {
+	if !prefix {
+		if _, ok := e.keys[key]; ok {
+			return []string{key}
+		}
+		return nil
+	}
+	var keys []string
+	for i := sort.SearchStrings(e.sortedKeys, key); i < len(e.sortedKeys) && strings.HasPrefix(e.sortedKeys[i], key); i++ {
+		keys = append(keys, e.sortedKeys[i])
+	}
+	return keys
+}
+
+// Get implements Store.Get.
+func (e *Embedded) Get(ctx context.Context, key string, opts GetOptions) (*GetResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return nil, errClosed
+	}
+	return e.get(key, opts)
+}
+
+func (e *Embedded) get(key string, opts GetOptions) (*GetResponse, error) {
+	rev := opts.Revision
+	if rev == 0 {
+		rev = e.rev
+	}
+	if rev > e.rev {
+		return nil, fmt.Errorf("kv: revision %d is in the future (the current revision is %d)", rev, e.rev)
+	}
+	if rev < e.compacted {
+		return nil, ErrCompacted
+	}
+	var kvs []*KeyValue
+	for _, k := range e.keysInRange(key, opts.Prefix) {
+		if kv := e.keys[k].at(rev); kv != nil && inRevisionRange(kv, opts) {
+			kvs = append(kvs, kv)
+		}
+	}
+	resp := &GetResponse{
+		Count:    int64(len(kvs)),
+		Revision: e.rev,
+	}
+	if opts.CountOnly {
+		return resp, nil
+	}
+	switch opts.Sort {
+	case SortByModRevisionAscend:
+		sort.SliceStable(kvs, func(i, j int) bool { return kvs[i].ModRevision < kvs[j].ModRevision })
+	case SortByModRevisionDescend:
+		sort.SliceStable(kvs, func(i, j int) bool { return kvs[i].ModRevision > kvs[j].ModRevision })
+	case SortByCreateRevisionAscend:
+		sort.SliceStable(kvs, func(i, j int) bool { return kvs[i].CreateRevision < kvs[j].CreateRevision })
+	case SortByCreateRevisionDescend:
+		sort.SliceStable(kvs, func(i, j int) bool { return kvs[i].CreateRevision > kvs[j].CreateRevision })
+	}
+	if opts.Limit > 0 && int64(len(kvs)) > opts.Limit {
+		kvs = kvs[:opts.Limit]
+	}
+	resp.Kvs = kvs
+	return resp, nil
+}
+
+// inRevisionRange returns true if 'kv' is within the revision bounds of
+// 'opts'.
+func inRevisionRange(kv *KeyValue, opts GetOptions) bool {
+	return (opts.MinModRevision == 0 || kv.ModRevision >= opts.MinModRevision) &&
+		(opts.MaxModRevision == 0 || kv.ModRevision <= opts.MaxModRevision) &&
+		(opts.MinCreateRevision == 0 || kv.CreateRevision >= opts.MinCreateRevision) &&
+		(opts.MaxCreateRevision == 0 || kv.CreateRevision <= opts.MaxCreateRevision)
+}
+
+// Txn implements Store.Txn.
+func (e *Embedded) Txn(ctx context.Context, txn *Txn) (*TxnResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return nil, errClosed
+	}
+	for _, cmp := range txn.If {
+		var modRevision int64
+		if h, ok := e.keys[cmp.Key]; ok {
+			if kv := h.current(); kv != nil {
+				modRevision = kv.ModRevision
+			}
+		}
+		if modRevision != cmp.ModRevision {
+			resp := &TxnResponse{Revision: e.rev}
+			for _, key := range txn.Else {
+				getResp, err := e.get(key, GetOptions{})
+				if err != nil {
+					return nil, err
+				}
+				resp.Else = append(resp.Else, getResp)
+			}
+			return resp, nil
+		}
+	}
+	if err := e.apply(txn.Then); err != nil {
+		return nil, err
+	}
+	return &TxnResponse{
+		Succeeded: true,
+		Revision:  e.rev,
+	}, nil
+}
+
+// apply applies 'ops' at a new revision. Applying no ops doesn't create a
+// revision.
+func (e *Embedded) apply(ops []Op) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	rev := e.rev + 1
+	// staged holds the keys that the ops have changed so far, so that later
+	// ops see the changes of earlier ones
+	staged := make(map[string]*KeyValue)
+	lookup := func(key string) *KeyValue {
+		if kv, ok := staged[key]; ok {
+			return kv
+		}
+		if h, ok := e.keys[key]; ok {
+			return h.current()
+		}
+		return nil
+	}
+	var events []*Event
+	for _, op := range ops {
+		switch op.Type {
+		case OpPut:
+			if op.Lease != NoLease {
+				if _, ok := e.leases[op.Lease]; !ok {
+					return ErrLeaseNotFound
+				}
+			}
+			prev := lookup(op.Key)
+			kv := &KeyValue{
+				Key:            []byte(op.Key),
+				Value:          []byte(op.Value),
+				CreateRevision: rev,
+				ModRevision:    rev,
+				Lease:          op.Lease,
+			}
+			if prev != nil {
+				kv.CreateRevision = prev.CreateRevision
+			}
+			staged[op.Key] = kv
+			events = append(events, &Event{Type: EventPut, Kv: kv, PrevKv: prev})
+		case OpDelete:
+			keys := e.keysInRange(op.Key, op.Prefix)
+			for key := range staged {
+				if op.Prefix && strings.HasPrefix(key, op.Key) {
+					if _, ok := e.keys[key]; !ok {
+						keys = append(keys, key)
+					}
+				}
+			}
+			if !op.Prefix && len(keys) == 0 && staged[op.Key] != nil {
+				keys = []string{op.Key}
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				prev := lookup(key)
+				if prev == nil {
+					continue
+				}
+				staged[key] = nil
+				events = append(events, &Event{
+					Type:   EventDelete,
+					Kv:     &KeyValue{Key: []byte(key), ModRevision: rev},
+					PrevKv: prev,
+				})
+			}
+		}
+	}
+	if err := e.log(&logRecord{Revision: rev, Events: events}); err != nil {
+		return err
+	}
+	e.rev = rev
+	for _, ev := range events {
+		if ev.Type == EventPut {
+			e.setVersion(string(ev.Kv.Key), keyVersion{rev: rev, kv: ev.Kv})
+		} else {
+			e.setVersion(string(ev.Kv.Key), keyVersion{rev: rev})
+		}
+	}
+	revEvents := &revisionEvents{rev: rev, events: events}
+	e.history = append(e.history, revEvents)
+	for w := range e.watchers {
+		w.send(revEvents)
+	}
+	if len(e.history) > 2*historyRevisions {
+		return e.compact(e.history[len(e.history)-historyRevisions-1].rev)
+	}
+	return nil
+}
+
+// compact forgets the events and versions that reads and watches from
+// after 'rev' don't need, and snapshots the log file, since the changes
+// that it holds from before the snapshot aren't needed either.
+func (e *Embedded) compact(rev int64) error {
+	i := sort.Search(len(e.history), func(i int) bool { return e.history[i].rev > rev })
+	e.history = append([]*revisionEvents(nil), e.history[i:]...)
+	e.compacted = rev
+	for _, key := range append([]string(nil), e.sortedKeys...) {
+		h := e.keys[key]
+		i := sort.Search(len(h.versions), func(i int) bool { return h.versions[i].rev > rev })
+		if i > 0 {
+			// Keep the version that's current as of 'rev'
+			h.versions = append([]keyVersion(nil), h.versions[i-1:]...)
+		}
+		if len(h.versions) == 1 && h.versions[0].kv == nil {
+			e.removeKey(key)
+		}
+	}
+	if e.file == nil {
+		return nil
+	}
+	if err := e.snapshot(); err != nil {
+		// The change that triggered the compaction has been logged, so it
+		// still succeeds
+		log.Errorf("error snapshotting %s: %v", e.path, err)
+	}
+	return nil
+}
+
+// Grant implements Store.Grant.
+func (e *Embedded) Grant(ctx context.Context, ttl int64) (LeaseID, error) {
+	if err := ctx.Err(); err != nil {
+		return NoLease, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return NoLease, errClosed
+	}
+	id := e.nextLease
+	expires := time.Now().Add(time.Duration(ttl) * time.Second)
+	if err := e.log(&logRecord{Granted: []*leaseRecord{{ID: id, TTL: ttl, Expires: expires}}}); err != nil {
+		return NoLease, err
+	}
+	e.nextLease++
+	e.leases[id] = &lease{ttl: ttl, expires: expires}
+	return id, nil
+}
+
+// KeepAlive implements Store.KeepAlive.
+func (e *Embedded) KeepAlive(ctx context.Context, id LeaseID) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return errClosed
+	}
+	l, ok := e.leases[id]
+	if !ok {
+		return ErrLeaseNotFound
+	}
+	expires := time.Now().Add(time.Duration(l.ttl) * time.Second)
+	if err := e.log(&logRecord{Granted: []*leaseRecord{{ID: id, TTL: l.ttl, Expires: expires}}}); err != nil {
+		return err
+	}
+	l.expires = expires
+	return nil
+}
+
+// Revoke implements Store.Revoke.
+func (e *Embedded) Revoke(ctx context.Context, id LeaseID) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return errClosed
+	}
+	if _, ok := e.leases[id]; !ok {
+		return ErrLeaseNotFound
+	}
+	return e.revoke([]LeaseID{id})
+}
+
+// expireLeases deletes the keys of leases as they expire, until the store is
+// closed.
+func (e *Embedded) expireLeases() {
+	ticker := time.NewTicker(leaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.done:
+			return
+		}
+		if err := e.expireLeasesOnce(time.Now()); err != nil {
+			log.Errorf("error expiring leases: %v", err)
+		}
+	}
+}
+
+func (e *Embedded) expireLeasesOnce(now time.Time) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return nil
+	}
+	var ids []LeaseID
+	for id, l := range e.leases {
+		if now.After(l.expires) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return e.revoke(ids)
+}
+
+// revoke deletes the leases 'ids' and their keys. e.mu must be held.
+func (e *Embedded) revoke(ids []LeaseID) error {
+	expired := make(map[LeaseID]bool)
+	for _, id := range ids {
+		expired[id] = true
+	}
+	var ops []Op
+	for _, key := range e.sortedKeys {
+		if kv := e.keys[key].current(); kv != nil && expired[kv.Lease] {
+			ops = append(ops, Delete(key))
+		}
+	}
+	if err := e.apply(ops); err != nil {
+		return err
+	}
+	if err := e.log(&logRecord{Revoked: ids}); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		delete(e.leases, id)
+	}
+	return nil
+}
+
+// Watch implements Store.Watch.
+func (e *Embedded) Watch(ctx context.Context, prefix string, rev int64, prevKV bool) <-chan WatchResponse {
+	w := &embeddedWatcher{
+		prefix: prefix,
+		prevKV: prevKV,
+		notify: make(chan struct{}, 1),
+	}
+	e.mu.Lock()
+	switch {
+	case e.closed:
+		w.push(WatchResponse{Err: errClosed})
+	case rev != 0 && rev <= e.compacted:
+		w.push(WatchResponse{Revision: e.rev, Err: ErrCompacted})
+	default:
+		// Deliver the events since 'rev' before any new ones
+		if rev != 0 {
+			for _, revEvents := range e.history {
+				if revEvents.rev >= rev {
+					w.send(revEvents)
+				}
+			}
+		}
+		e.watchers[w] = true
+	}
+	e.mu.Unlock()
+
+	respCh := make(chan WatchResponse)
+	go func() {
+		defer close(respCh)
+		defer func() {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			delete(e.watchers, w)
+		}()
+		for {
+			resp, ok := w.pop()
+			if !ok {
+				select {
+				case <-w.notify:
+					continue
+				case <-ctx.Done():
+					return
+				case <-e.done:
+					return
+				}
+			}
+			select {
+			case respCh <- resp:
+			case <-ctx.Done():
+				return
+			case <-e.done:
+				return
+			}
+			if resp.Err != nil {
+				return
+			}
+		}
+	}()
+	return respCh
+}
+
+// embeddedWatcher queues the responses of a watch, so that slow watchers
+// don't hold up writes.
+type embeddedWatcher struct {
+	prefix  string
+	prevKV  bool
+	mu      sync.Mutex
+	pending []WatchResponse
+	notify  chan struct{}
+}
+
+// send queues the events of a revision that the watch covers.
+func (w *embeddedWatcher) send(revEvents *revisionEvents) {
+	resp := WatchResponse{Revision: revEvents.rev}
+	for _, ev := range revEvents.events {
+		if !strings.HasPrefix(string(ev.Kv.Key), w.prefix) {
+			continue
+		}
+		if !w.prevKV {
+			ev = &Event{Type: ev.Type, Kv: ev.Kv}
+		}
+		resp.Events = append(resp.Events, ev)
+	}
+	if len(resp.Events) > 0 {
+		w.push(resp)
+	}
+}
+
+func (w *embeddedWatcher) push(resp WatchResponse) {
+	w.mu.Lock()
+	w.pending = append(w.pending, resp)
+	w.mu.Unlock()
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *embeddedWatcher) pop() (WatchResponse, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.pending) == 0 {
+		return WatchResponse{}, false
+	}
+	resp := w.pending[0]
+	w.pending = w.pending[1:]
+	return resp, true
+}
+
+// Close closes the store, and its watches.
+func (e *Embedded) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	close(e.done)
+	if e.file != nil {
+		return e.file.Close()
+	}
+	return nil
+}
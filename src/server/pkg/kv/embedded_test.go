@@ -0,0 +1,251 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func put(t *testing.T, s Store, ops ...Op) int64 {
+	resp, err := s.Txn(context.Background(), &Txn{Then: ops})
+	require.NoError(t, err)
+	require.True(t, resp.Succeeded)
+	return resp.Revision
+}
+
+func get(t *testing.T, s Store, key string, opts GetOptions) map[string]string {
+	resp, err := s.Get(context.Background(), key, opts)
+	require.NoError(t, err)
+	result := make(map[string]string)
+	for _, kv := range resp.Kvs {
+		result[string(kv.Key)] = string(kv.Value)
+	}
+	return result
+}
+
+func TestEmbeddedGet(t *testing.T) {
+	e, err := NewEmbedded("")
+	require.NoError(t, err)
+	defer e.Close()
+
+	rev1 := put(t, e, Put("a/1", "foo", NoLease), Put("a/2", "bar", NoLease), Put("b", "buzz", NoLease))
+	rev2 := put(t, e, Put("a/1", "foo2", NoLease))
+	rev3 := put(t, e, DeletePrefix("a/"))
+	require.Equal(t, rev1+2, rev3)
+
+	require.Equal(t, map[string]string{}, get(t, e, "a/", GetOptions{Prefix: true}))
+	require.Equal(t, map[string]string{"a/1": "foo2", "a/2": "bar"}, get(t, e, "a/", GetOptions{Prefix: true, Revision: rev2}))
+	require.Equal(t, map[string]string{"a/1": "foo"}, get(t, e, "a/1", GetOptions{Revision: rev1}))
+	require.Equal(t, map[string]string{"b": "buzz"}, get(t, e, "b", GetOptions{}))
+
+	resp, err := e.Get(context.Background(), "", GetOptions{Prefix: true, Revision: rev2, Sort: SortByModRevisionDescend})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Kvs))
+	require.Equal(t, "a/1", string(resp.Kvs[0].Key))
+	require.Equal(t, rev1, resp.Kvs[0].CreateRevision)
+	require.Equal(t, rev2, resp.Kvs[0].ModRevision)
+
+	resp, err = e.Get(context.Background(), "", GetOptions{Prefix: true, Revision: rev1, CountOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resp.Count)
+	require.Equal(t, 0, len(resp.Kvs))
+
+	// Reads from before the compacted revision fail
+	e.mu.Lock()
+	e.compact(rev2)
+	e.mu.Unlock()
+	_, err = e.Get(context.Background(), "a/1", GetOptions{Revision: rev1})
+	require.Equal(t, ErrCompacted, err)
+	require.Equal(t, map[string]string{"a/1": "foo2", "a/2": "bar"}, get(t, e, "a/", GetOptions{Prefix: true, Revision: rev2}))
+}
+
+func TestEmbeddedTxn(t *testing.T) {
+	e, err := NewEmbedded("")
+	require.NoError(t, err)
+	defer e.Close()
+
+	rev := put(t, e, Put("a", "foo", NoLease))
+	// The condition doesn't hold, so the Else keys are read instead
+	resp, err := e.Txn(context.Background(), &Txn{
+		If:   []Cmp{{Key: "a", ModRevision: rev - 1}, {Key: "b"}},
+		Then: []Op{Put("b", "bar", NoLease)},
+		Else: []string{"a", "b"},
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Succeeded)
+	require.Equal(t, rev, resp.Revision)
+	require.Equal(t, 2, len(resp.Else))
+	require.Equal(t, "foo", string(resp.Else[0].Kvs[0].Value))
+	require.Equal(t, 0, len(resp.Else[1].Kvs))
+	require.Equal(t, map[string]string{}, get(t, e, "b", GetOptions{}))
+
+	resp, err = e.Txn(context.Background(), &Txn{
+		If:   []Cmp{{Key: "a", ModRevision: rev}, {Key: "b"}},
+		Then: []Op{Put("b", "bar", NoLease)},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Succeeded)
+	require.Equal(t, rev+1, resp.Revision)
+	require.Equal(t, map[string]string{"b": "bar"}, get(t, e, "b", GetOptions{}))
+
+	// Keys can't be put with leases that don't exist
+	_, err = e.Txn(context.Background(), &Txn{Then: []Op{Put("c", "buzz", 100)}})
+	require.Equal(t, ErrLeaseNotFound, err)
+}
+
+func TestEmbeddedWatch(t *testing.T) {
+	e, err := NewEmbedded("")
+	require.NoError(t, err)
+	defer e.Close()
+
+	rev := put(t, e, Put("a/1", "foo", NoLease))
+	put(t, e, Put("b", "bar", NoLease))
+	put(t, e, Put("a/1", "foo2", NoLease))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// The watch starts in the past, so it first gets the past events
+	respCh := e.Watch(ctx, "a/", rev, true)
+	resp := <-respCh
+	require.NoError(t, resp.Err)
+	require.Equal(t, 1, len(resp.Events))
+	require.Equal(t, EventPut, resp.Events[0].Type)
+	require.Equal(t, "foo", string(resp.Events[0].Kv.Value))
+	resp = <-respCh
+	require.Equal(t, "foo2", string(resp.Events[0].Kv.Value))
+	require.Equal(t, "foo", string(resp.Events[0].PrevKv.Value))
+
+	put(t, e, Put("b", "bar2", NoLease))
+	delRev := put(t, e, Delete("a/1"))
+	resp = <-respCh
+	require.Equal(t, delRev, resp.Revision)
+	require.Equal(t, EventDelete, resp.Events[0].Type)
+	require.Equal(t, "a/1", string(resp.Events[0].Kv.Key))
+	require.Equal(t, "foo2", string(resp.Events[0].PrevKv.Value))
+
+	cancel()
+	for range respCh {
+	}
+
+	// Watches that start before the compacted revision fail
+	e.mu.Lock()
+	e.compact(delRev)
+	e.mu.Unlock()
+	resp = <-e.Watch(context.Background(), "a/", rev, false)
+	require.Equal(t, ErrCompacted, resp.Err)
+}
+
+func TestEmbeddedLease(t *testing.T) {
+	e, err := NewEmbedded("")
+	require.NoError(t, err)
+	defer e.Close()
+
+	lease, err := e.Grant(context.Background(), 60)
+	require.NoError(t, err)
+	put(t, e, Put("a", "foo", lease), Put("b", "bar", NoLease))
+	require.NoError(t, e.expireLeasesOnce(time.Now()))
+	require.Equal(t, map[string]string{"a": "foo", "b": "bar"}, get(t, e, "", GetOptions{Prefix: true}))
+	require.NoError(t, e.expireLeasesOnce(time.Now().Add(time.Minute+time.Second)))
+	require.Equal(t, map[string]string{"b": "bar"}, get(t, e, "", GetOptions{Prefix: true}))
+	_, err = e.Txn(context.Background(), &Txn{Then: []Op{Put("a", "foo", lease)}})
+	require.Equal(t, ErrLeaseNotFound, err)
+	require.Equal(t, ErrLeaseNotFound, e.KeepAlive(context.Background(), lease))
+
+	// A lease that's kept alive expires a TTL after it was renewed
+	lease, err = e.Grant(context.Background(), 1)
+	require.NoError(t, err)
+	put(t, e, Put("a", "foo", lease))
+	e.leases[lease].expires = time.Now()
+	require.NoError(t, e.KeepAlive(context.Background(), lease))
+	require.NoError(t, e.expireLeasesOnce(time.Now().Add(time.Second/2)))
+	require.Equal(t, map[string]string{"a": "foo", "b": "bar"}, get(t, e, "", GetOptions{Prefix: true}))
+
+	// Revoking a lease deletes its keys
+	require.NoError(t, e.Revoke(context.Background(), lease))
+	require.Equal(t, map[string]string{"b": "bar"}, get(t, e, "", GetOptions{Prefix: true}))
+	require.Equal(t, ErrLeaseNotFound, e.Revoke(context.Background(), lease))
+}
+
+func TestEmbeddedPersistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kv")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "kv.log")
+
+	e, err := NewEmbedded(path)
+	require.NoError(t, err)
+	lease, err := e.Grant(context.Background(), 60)
+	require.NoError(t, err)
+	put(t, e, Put("a", "foo", NoLease), Put("b", "bar", lease))
+	put(t, e, Put("c", "buzz", NoLease))
+	rev := put(t, e, Delete("c"))
+	require.NoError(t, e.Close())
+
+	// A write that was interrupted is ignored
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte(`{"revision":`))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	e, err = NewEmbedded(path)
+	require.NoError(t, err)
+	defer e.Close()
+	require.Equal(t, map[string]string{"a": "foo", "b": "bar"}, get(t, e, "", GetOptions{Prefix: true}))
+	resp, err := e.Get(context.Background(), "a", GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, rev, resp.Revision)
+	// The lease survives the restart, and still expires
+	require.NoError(t, e.expireLeasesOnce(time.Now().Add(time.Minute+time.Second)))
+	require.Equal(t, map[string]string{"a": "foo"}, get(t, e, "", GetOptions{Prefix: true}))
+	require.Equal(t, rev+2, put(t, e, Put("c", "buzz", NoLease)))
+}
+
+func TestEmbeddedSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kv")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "kv.log")
+
+	e, err := NewEmbedded(path)
+	require.NoError(t, err)
+	lease, err := e.Grant(context.Background(), 60)
+	require.NoError(t, err)
+	put(t, e, Put("a", "foo", lease))
+	for i := 0; i < 10; i++ {
+		put(t, e, Put("b", "bar", NoLease))
+	}
+	rev := put(t, e, Delete("a"))
+	countLines := func() int {
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		return bytes.Count(data, []byte("\n"))
+	}
+	require.Equal(t, 14, countLines())
+
+	// Compacting the history snapshots the log, and later changes are
+	// appended to the snapshot
+	e.mu.Lock()
+	require.NoError(t, e.compact(rev))
+	e.mu.Unlock()
+	require.Equal(t, 1, countLines())
+	rev = put(t, e, Put("c", "buzz", lease))
+	require.Equal(t, 2, countLines())
+	require.NoError(t, e.Close())
+
+	e, err = NewEmbedded(path)
+	require.NoError(t, err)
+	defer e.Close()
+	require.Equal(t, map[string]string{"b": "bar", "c": "buzz"}, get(t, e, "", GetOptions{Prefix: true}))
+	resp, err := e.Get(context.Background(), "c", GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, rev, resp.Revision)
+	// The lease's TTL survives the snapshot
+	require.NoError(t, e.KeepAlive(context.Background(), lease))
+	require.Equal(t, int64(60), e.leases[lease].ttl)
+}
@@ -0,0 +1,192 @@
+package kv
+
+import (
+	"context"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+type etcdStore struct {
+	client *etcd.Client
+}
+
+// NewEtcdStore returns a Store that keeps its keys in etcd.
+func NewEtcdStore(client *etcd.Client) Store {
+	return &etcdStore{client: client}
+}
+
+func (s *etcdStore) Get(ctx context.Context, key string, opts GetOptions) (*GetResponse, error) {
+	var options []etcd.OpOption
+	if opts.Prefix {
+		options = append(options, etcd.WithPrefix())
+	}
+	if opts.Revision != 0 {
+		options = append(options, etcd.WithRev(opts.Revision))
+	}
+	switch opts.Sort {
+	case SortByModRevisionAscend:
+		options = append(options, etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+	case SortByModRevisionDescend:
+		options = append(options, etcd.WithSort(etcd.SortByModRevision, etcd.SortDescend))
+	case SortByCreateRevisionAscend:
+		options = append(options, etcd.WithSort(etcd.SortByCreateRevision, etcd.SortAscend))
+	case SortByCreateRevisionDescend:
+		options = append(options, etcd.WithSort(etcd.SortByCreateRevision, etcd.SortDescend))
+	}
+	if opts.CountOnly {
+		options = append(options, etcd.WithCountOnly())
+	}
+	if opts.Limit != 0 {
+		options = append(options, etcd.WithLimit(opts.Limit))
+	}
+	if opts.MinModRevision != 0 {
+		options = append(options, etcd.WithMinModRev(opts.MinModRevision))
+	}
+	if opts.MaxModRevision != 0 {
+		options = append(options, etcd.WithMaxModRev(opts.MaxModRevision))
+	}
+	if opts.MinCreateRevision != 0 {
+		options = append(options, etcd.WithMinCreateRev(opts.MinCreateRevision))
+	}
+	if opts.MaxCreateRevision != 0 {
+		options = append(options, etcd.WithMaxCreateRev(opts.MaxCreateRevision))
+	}
+	resp, err := s.client.Get(ctx, key, options...)
+	if err != nil {
+		return nil, err
+	}
+	return getResponseFromEtcd(resp), nil
+}
+
+func (s *etcdStore) Txn(ctx context.Context, txn *Txn) (*TxnResponse, error) {
+	var cmps []etcd.Cmp
+	for _, cmp := range txn.If {
+		cmps = append(cmps, etcd.Compare(etcd.ModRevision(cmp.Key), "=", cmp.ModRevision))
+	}
+	var ops []etcd.Op
+	for _, op := range txn.Then {
+		switch op.Type {
+		case OpPut:
+			var options []etcd.OpOption
+			if op.Lease != NoLease {
+				options = append(options, etcd.WithLease(etcd.LeaseID(op.Lease)))
+			}
+			ops = append(ops, etcd.OpPut(op.Key, op.Value, options...))
+		case OpDelete:
+			var options []etcd.OpOption
+			if op.Prefix {
+				options = append(options, etcd.WithPrefix())
+			}
+			ops = append(ops, etcd.OpDelete(op.Key, options...))
+		}
+	}
+	var gets []etcd.Op
+	for _, key := range txn.Else {
+		gets = append(gets, etcd.OpGet(key))
+	}
+	resp, err := s.client.Txn(ctx).If(cmps...).Then(ops...).Else(gets...).Commit()
+	if err != nil {
+		return nil, err
+	}
+	result := &TxnResponse{
+		Succeeded: resp.Succeeded,
+		Revision:  resp.Header.Revision,
+	}
+	if !resp.Succeeded {
+		for _, r := range resp.Responses {
+			result.Else = append(result.Else, getResponseFromEtcd((*etcd.GetResponse)(r.GetResponseRange())))
+		}
+	}
+	return result, nil
+}
+
+func (s *etcdStore) Watch(ctx context.Context, prefix string, rev int64, prevKV bool) <-chan WatchResponse {
+	options := []etcd.OpOption{etcd.WithPrefix()}
+	if rev != 0 {
+		options = append(options, etcd.WithRev(rev))
+	}
+	if prevKV {
+		options = append(options, etcd.WithPrevKV())
+	}
+	// Each watch gets its own watcher, so that closing it doesn't affect
+	// the client's other watches
+	watcher := etcd.NewWatcher(s.client)
+	rch := watcher.Watch(ctx, prefix, options...)
+	respCh := make(chan WatchResponse)
+	go func() {
+		defer close(respCh)
+		defer watcher.Close()
+		for resp := range rch {
+			watchResp := WatchResponse{
+				Revision: resp.Header.Revision,
+				Err:      resp.Err(),
+			}
+			for _, ev := range resp.Events {
+				event := &Event{
+					Kv:     kvFromEtcd(ev.Kv),
+					PrevKv: kvFromEtcd(ev.PrevKv),
+				}
+				if ev.Type == etcd.EventTypeDelete {
+					event.Type = EventDelete
+				}
+				watchResp.Events = append(watchResp.Events, event)
+			}
+			select {
+			case respCh <- watchResp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return respCh
+}
+
+func (s *etcdStore) Grant(ctx context.Context, ttl int64) (LeaseID, error) {
+	resp, err := s.client.Grant(ctx, ttl)
+	if err != nil {
+		return NoLease, err
+	}
+	return LeaseID(resp.ID), nil
+}
+
+func (s *etcdStore) KeepAlive(ctx context.Context, id LeaseID) error {
+	_, err := s.client.KeepAliveOnce(ctx, etcd.LeaseID(id))
+	if err == rpctypes.ErrLeaseNotFound {
+		return ErrLeaseNotFound
+	}
+	return err
+}
+
+func (s *etcdStore) Revoke(ctx context.Context, id LeaseID) error {
+	_, err := s.client.Revoke(ctx, etcd.LeaseID(id))
+	if err == rpctypes.ErrLeaseNotFound {
+		return ErrLeaseNotFound
+	}
+	return err
+}
+
+func getResponseFromEtcd(resp *etcd.GetResponse) *GetResponse {
+	result := &GetResponse{
+		Count:    resp.Count,
+		Revision: resp.Header.Revision,
+	}
+	for _, kv := range resp.Kvs {
+		result.Kvs = append(result.Kvs, kvFromEtcd(kv))
+	}
+	return result
+}
+
+func kvFromEtcd(kv *mvccpb.KeyValue) *KeyValue {
+	if kv == nil {
+		return nil
+	}
+	return &KeyValue{
+		Key:            kv.Key,
+		Value:          kv.Value,
+		CreateRevision: kv.CreateRevision,
+		ModRevision:    kv.ModRevision,
+		Lease:          LeaseID(kv.Lease),
+	}
+}
@@ -0,0 +1,196 @@
+// Package kv defines the key-value store that pachd keeps its metadata in.
+// The store has the parts of etcd's API that pachd relies on: reads at a
+// revision, transactions that are guarded by the revisions of the keys they
+// read, prefix watches and leases. It's implemented on top of etcd, and by an
+// embedded store, which lets a single-node pachd run without etcd.
+package kv
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrCompacted is returned when a read or a watch asks for a revision that
+// the store no longer has.
+var ErrCompacted = errors.New("kv: required revision has been compacted")
+
+// ErrLeaseNotFound is returned when a key is put with a lease that doesn't
+// exist (or that has expired).
+var ErrLeaseNotFound = errors.New("kv: requested lease not found")
+
+// LeaseID identifies a lease. Keys that are put with a lease are deleted when
+// the lease expires.
+type LeaseID int64
+
+// NoLease is the LeaseID of keys that don't have a lease.
+const NoLease LeaseID = 0
+
+// KeyValue is a key and its value, as of some revision.
+type KeyValue struct {
+	Key   []byte
+	Value []byte
+	// CreateRevision is the revision at which the key was created
+	CreateRevision int64
+	// ModRevision is the revision at which the key was last modified
+	ModRevision int64
+	Lease       LeaseID
+}
+
+// SortOrder is the order in which Get returns keys.
+type SortOrder int
+
+const (
+	// SortByKey returns keys in lexical order
+	SortByKey SortOrder = iota
+	// SortByModRevisionAscend returns the least recently modified keys first
+	SortByModRevisionAscend
+	// SortByModRevisionDescend returns the most recently modified keys first
+	SortByModRevisionDescend
+	// SortByCreateRevisionAscend returns the least recently created keys first
+	SortByCreateRevisionAscend
+	// SortByCreateRevisionDescend returns the most recently created keys first
+	SortByCreateRevisionDescend
+)
+
+// GetOptions modifies a Get.
+type GetOptions struct {
+	// Prefix reads all the keys that start with the given key
+	Prefix bool
+	// Revision reads the keys as of the given revision. If it's 0, the
+	// current revision is read.
+	Revision int64
+	Sort     SortOrder
+	// CountOnly only counts the keys, without returning them
+	CountOnly bool
+	// Limit is the maximum number of keys returned (after sorting). If it's
+	// 0, all keys are returned.
+	Limit int64
+	// MinModRevision and MaxModRevision, if they're not 0, only read the
+	// keys that were last modified within them (inclusive)
+	MinModRevision int64
+	MaxModRevision int64
+	// MinCreateRevision and MaxCreateRevision, if they're not 0, only read
+	// the keys that were created within them (inclusive)
+	MinCreateRevision int64
+	MaxCreateRevision int64
+}
+
+// GetResponse is the result of a Get.
+type GetResponse struct {
+	Kvs []*KeyValue
+	// Count is the number of keys that were read, regardless of Limit
+	Count int64
+	// Revision is the store's revision when the keys were read
+	Revision int64
+}
+
+// OpType is the type of an Op.
+type OpType int
+
+const (
+	// OpPut puts a key
+	OpPut OpType = iota
+	// OpDelete deletes a key, or all keys with a prefix
+	OpDelete
+)
+
+// Op is a write in a transaction.
+type Op struct {
+	Type  OpType
+	Key   string
+	Value string
+	// Lease is the lease of a put key
+	Lease LeaseID
+	// Prefix deletes all the keys that start with Key
+	Prefix bool
+}
+
+// Put returns an Op that puts 'key'.
+func Put(key, value string, lease LeaseID) Op {
+	return Op{Type: OpPut, Key: key, Value: value, Lease: lease}
+}
+
+// Delete returns an Op that deletes 'key'.
+func Delete(key string) Op {
+	return Op{Type: OpDelete, Key: key}
+}
+
+// DeletePrefix returns an Op that deletes all keys that start with 'prefix'.
+func DeletePrefix(prefix string) Op {
+	return Op{Type: OpDelete, Key: prefix, Prefix: true}
+}
+
+// Cmp is a condition of a transaction: it holds if Key was last modified at
+// ModRevision (0 means that the key doesn't exist).
+type Cmp struct {
+	Key         string
+	ModRevision int64
+}
+
+// Txn is a transaction. If all of its conditions hold, its writes are applied
+// atomically. Otherwise the keys in Else are read.
+type Txn struct {
+	If   []Cmp
+	Then []Op
+	Else []string
+}
+
+// TxnResponse is the result of a transaction.
+type TxnResponse struct {
+	Succeeded bool
+	// Revision is the store's revision after the transaction
+	Revision int64
+	// Else holds the reads of the transaction's Else keys, in order, if the
+	// transaction didn't succeed
+	Else []*GetResponse
+}
+
+// EventType is the type of an Event.
+type EventType int
+
+const (
+	// EventPut happens when a key is put
+	EventPut EventType = iota
+	// EventDelete happens when a key is deleted
+	EventDelete
+)
+
+// Event is a change to a key.
+type Event struct {
+	Type EventType
+	// Kv is the key after the change. Its ModRevision is the revision of the
+	// change, even if the key was deleted.
+	Kv *KeyValue
+	// PrevKv is the key before the change, if the watch asked for it and
+	// the key existed
+	PrevKv *KeyValue
+}
+
+// WatchResponse is a batch of events that a watch delivers.
+type WatchResponse struct {
+	Events []*Event
+	// Revision is the store's revision as of the events
+	Revision int64
+	Err      error
+}
+
+// Store is a key-value store with revisions, transactions, prefix watches and
+// leases.
+type Store interface {
+	// Get reads 'key', or all the keys that start with it.
+	Get(ctx context.Context, key string, opts GetOptions) (*GetResponse, error)
+	// Txn runs a transaction.
+	Txn(ctx context.Context, txn *Txn) (*TxnResponse, error)
+	// Watch delivers the changes to the keys that start with 'prefix', from
+	// revision 'rev' on (0 means from the next revision). If 'prevKV' is
+	// set, events include the key before the change. The returned channel
+	// is closed when 'ctx' is cancelled.
+	Watch(ctx context.Context, prefix string, rev int64, prevKV bool) <-chan WatchResponse
+	// Grant creates a lease that expires after 'ttl' seconds.
+	Grant(ctx context.Context, ttl int64) (LeaseID, error)
+	// KeepAlive renews lease 'id', so that it expires 'ttl' seconds (as
+	// given to Grant) from now.
+	KeepAlive(ctx context.Context, id LeaseID) error
+	// Revoke deletes lease 'id', along with the keys that were put with it.
+	Revoke(ctx context.Context, id LeaseID) error
+}
@@ -0,0 +1,191 @@
+package obj
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// localTmpPrefix is the prefix of the temporary files that objects are
+// written to before being renamed into place. Walk skips them.
+const localTmpPrefix = ".tmp-"
+
+// localClient stores objects as files under a directory on local disk (which
+// may be an NFS mount, for example).
+type localClient struct {
+	rootDir string
+}
+
+func newLocalClient(rootDir string) (*localClient, error) {
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return nil, err
+	}
+	return &localClient{rootDir: rootDir}, nil
+}
+
+// objectPath returns the path of the file that stores the object 'name'.
+// Names are always interpreted relative to the root directory, so that they
+// can't refer to files outside of it.
+func (c *localClient) objectPath(name string) string {
+	return filepath.Join(c.rootDir, filepath.Clean("/"+name))
+}
+
+// localWriter writes an object to a temporary file, and renames it into place
+// when it's closed so that readers never see a partially written object. The
+// temporary file is in the same directory as the object, so that the rename
+// doesn't cross filesystems. The file and then its directory are synced, so
+// that a written object survives a crash of the machine.
+type localWriter struct {
+	*os.File
+	path string
+}
+
+func (w *localWriter) Close() error {
+	if err := w.File.Sync(); err != nil {
+		w.abort()
+		return err
+	}
+	if err := w.File.Close(); err != nil {
+		os.Remove(w.File.Name())
+		return err
+	}
+	if err := os.Rename(w.File.Name(), w.path); err != nil {
+		os.Remove(w.File.Name())
+		return err
+	}
+	return syncDir(filepath.Dir(w.path))
+}
+
+// syncDir syncs the directory 'dir', so that the files created in or renamed
+// into it are durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		d.Close()
+		return err
+	}
+	return d.Close()
+}
+
+// abort discards everything written to 'w' instead of creating the object.
+func (w *localWriter) abort() {
+	w.File.Close()
+	os.Remove(w.File.Name())
+}
+
+func (c *localClient) Writer(name string) (io.WriteCloser, error) {
+	return c.writer(name)
+}
+
+func (c *localClient) writer(name string) (*localWriter, error) {
+	path := c.objectPath(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), localTmpPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &localWriter{File: f, path: path}, nil
+}
+
+// localReader reads part of an object's file, and closes the file when it's
+// closed.
+type localReader struct {
+	io.Reader
+	f *os.File
+}
+
+func (r *localReader) Close() error {
+	return r.f.Close()
+}
+
+func (c *localClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	f, err := os.Open(c.objectPath(name))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(int64(offset), io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if size > 0 {
+		return &localReader{io.LimitReader(f, int64(size)), f}, nil
+	}
+	return f, nil
+}
+
+func (c *localClient) Delete(name string) error {
+	return os.Remove(c.objectPath(name))
+}
+
+// Walk calls 'fn' with the name of each object whose name starts with
+// 'prefix'. As with other object stores, 'prefix' needn't end at a directory
+// boundary. Names are passed to 'fn' in the same form as 'prefix', i.e. with a
+// leading slash if 'prefix' has one.
+func (c *localClient) Walk(prefix string, fn func(name string) error) error {
+	const sep = string(filepath.Separator)
+	prefixPath := c.objectPath(prefix)
+	// Walk the directory that contains the objects matching 'prefix'
+	dir := prefixPath
+	if strings.HasSuffix(prefix, "/") {
+		if !strings.HasSuffix(prefixPath, sep) {
+			prefixPath += sep
+		}
+	} else if prefix != "" {
+		dir = filepath.Dir(prefixPath)
+	}
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			// Skip directories that can't contain objects matching 'prefix'
+			dirPath := strings.TrimSuffix(path, sep) + sep
+			if path != dir && !strings.HasPrefix(dirPath, prefixPath) && !strings.HasPrefix(prefixPath, dirPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasPrefix(info.Name(), localTmpPrefix) {
+			return nil
+		}
+		if !strings.HasPrefix(path, prefixPath) {
+			return nil
+		}
+		name, err := filepath.Rel(c.rootDir, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if strings.HasPrefix(prefix, "/") {
+			name = "/" + name
+		}
+		return fn(name)
+	})
+}
+
+func (c *localClient) Exists(name string) bool {
+	_, err := os.Stat(c.objectPath(name))
+	return err == nil
+}
+
+func (c *localClient) isRetryable(err error) bool {
+	return false
+}
+
+func (c *localClient) IsNotExist(err error) bool {
+	return os.IsNotExist(err)
+}
+
+func (c *localClient) IsIgnorable(err error) bool {
+	return false
+}
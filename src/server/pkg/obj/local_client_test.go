@@ -0,0 +1,101 @@
+package obj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func writeObject(t *testing.T, c Client, name string, data string) {
+	w, err := c.Writer(name)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+}
+
+func readObject(t *testing.T, c Client, name string, offset uint64, size uint64) string {
+	r, err := c.Reader(name, offset, size)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestLocalClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "obj")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	c, err := NewLocalClient(dir)
+	require.NoError(t, err)
+	require.NoError(t, TestIsNotExist(c))
+
+	// Objects aren't visible until their writers are closed
+	w, err := c.Writer("dir/foo")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("foobar"))
+	require.NoError(t, err)
+	require.False(t, c.Exists("dir/foo"))
+	require.NoError(t, w.Close())
+	require.True(t, c.Exists("dir/foo"))
+
+	require.Equal(t, "foobar", readObject(t, c, "dir/foo", 0, 0))
+	require.Equal(t, "bar", readObject(t, c, "dir/foo", 3, 0))
+	require.Equal(t, "ob", readObject(t, c, "dir/foo", 2, 2))
+
+	// Names can't escape the root directory
+	writeObject(t, c, "../escape", "data")
+	_, err = os.Stat(filepath.Join(dir, "escape"))
+	require.NoError(t, err)
+
+	require.NoError(t, c.Delete("dir/foo"))
+	require.False(t, c.Exists("dir/foo"))
+	_, err = c.Reader("dir/foo", 0, 0)
+	require.True(t, c.IsNotExist(err))
+	require.True(t, c.IsNotExist(c.Delete("dir/foo")))
+}
+
+func TestLocalClientWalk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "obj")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	c, err := NewLocalClient(dir)
+	require.NoError(t, err)
+	for _, name := range []string{"a/obj1", "a/obj2", "ab/obj3", "b/obj4"} {
+		writeObject(t, c, name, name)
+	}
+	// A partially written object isn't walked
+	_, err = c.Writer("a/obj5")
+	require.NoError(t, err)
+
+	walk := func(prefix string) []string {
+		var names []string
+		require.NoError(t, c.Walk(prefix, func(name string) error {
+			names = append(names, name)
+			return nil
+		}))
+		sort.Strings(names)
+		return names
+	}
+	require.Equal(t, []string{"a/obj1", "a/obj2", "ab/obj3", "b/obj4"}, walk(""))
+	// Prefixes needn't end at a directory boundary
+	require.Equal(t, []string{"a/obj1", "a/obj2", "ab/obj3"}, walk("a"))
+	require.Equal(t, []string{"a/obj1", "a/obj2"}, walk("a/"))
+	require.Equal(t, []string{"a/obj2"}, walk("a/obj2"))
+	require.Equal(t, []string{"/b/obj4"}, walk("/b"))
+	require.Equal(t, 0, len(walk("c")))
+}
+
+func TestParseLocalURL(t *testing.T) {
+	for _, urlStr := range []string{"local:///mnt/data/file", "local://mnt/data/file"} {
+		url, err := ParseURL(urlStr)
+		require.NoError(t, err)
+		require.Equal(t, "local", url.Store)
+		require.Equal(t, "mnt/data/file", url.Object)
+	}
+}
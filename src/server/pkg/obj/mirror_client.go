@@ -0,0 +1,541 @@
+package obj
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const (
+	// mirrorRepairInterval is how often a mirrorClient repairs the objects it
+	// has seen diverge between its clients.
+	mirrorRepairInterval = time.Minute
+	// mirrorFullRepairInterval is how often a mirrorClient walks all of its
+	// clients looking for objects that are missing from some of them.
+	mirrorFullRepairInterval = 6 * time.Hour
+	// mirrorTombstonePrefix is the prefix of the objects that record that an
+	// object has been deleted, but couldn't be deleted from every client.
+	mirrorTombstonePrefix = "mirror-tombstones/"
+)
+
+// mirrorClient writes every object to each of its clients, and reads objects
+// from the first client that has them. A write succeeds as long as it succeeds
+// in at least one client; the clients that it failed in are recorded, and a
+// background task copies the object to them later.
+//
+// Similarly, a delete succeeds as long as the object can be deleted from one
+// client. If it can't be deleted from the others, a tombstone object is
+// written, so that the object isn't copied back to the clients it was deleted
+// from, and the background task retries the delete until it succeeds. The
+// tombstones are stored in the clients, so they outlive the process.
+type mirrorClient struct {
+	clients []Client
+
+	mu sync.Mutex
+	// divergent maps the names of objects that may be missing (or partially
+	// written) in some clients to the indexes of those clients
+	divergent map[string]map[int]bool
+	// tombstones holds the names of the objects that have been deleted but
+	// may still be in some clients
+	tombstones map[string]bool
+
+	// tombstoneMu is held while tombstones are written or cleared, so that
+	// retrying a delete can't delete an object that's being written again
+	tombstoneMu sync.Mutex
+}
+
+func newMirrorClient(ctx context.Context, clients []Client) (*mirrorClient, error) {
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("a mirror needs at least one object store")
+	}
+	c := &mirrorClient{
+		clients:    clients,
+		divergent:  make(map[string]map[int]bool),
+		tombstones: make(map[string]bool),
+	}
+	// Find the deletes that previous processes didn't finish
+	var walked bool
+	for i, client := range clients {
+		if err := client.Walk(mirrorTombstonePrefix, func(name string) error {
+			c.tombstones[strings.TrimPrefix(name, mirrorTombstonePrefix)] = true
+			return nil
+		}); err != nil {
+			log.Errorf("error reading tombstones from mirror %d: %v", i, err)
+			continue
+		}
+		walked = true
+	}
+	if !walked {
+		return nil, fmt.Errorf("could not read tombstones from any object store in the mirror")
+	}
+	go c.repairLoop(ctx)
+	return c, nil
+}
+
+// isTombstoned returns true if 'name' has been deleted, but may still be in
+// some clients.
+func (c *mirrorClient) isTombstoned(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tombstones[name]
+}
+
+// mirrorWriter writes an object to each of a mirrorClient's clients. Writers
+// that fail are closed and set to nil. The object is marked divergent in their
+// clients once the mirrorWriter is closed, so that it isn't repaired before
+// it's been written.
+type mirrorWriter struct {
+	c       *mirrorClient
+	name    string
+	writers []io.WriteCloser
+	failed  []int
+	err     error
+}
+
+func (w *mirrorWriter) fail(i int, err error) {
+	log.Errorf("error writing %s to mirror %d: %v", w.name, i, err)
+	if w.err == nil {
+		w.err = err
+	}
+	if w.writers[i] != nil {
+		w.writers[i].Close()
+		w.writers[i] = nil
+	}
+	w.failed = append(w.failed, i)
+}
+
+// live returns the number of writers that haven't failed.
+func (w *mirrorWriter) live() int {
+	var n int
+	for _, writer := range w.writers {
+		if writer != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func (w *mirrorWriter) Write(p []byte) (int, error) {
+	for i, writer := range w.writers {
+		if writer == nil {
+			continue
+		}
+		if _, err := writer.Write(p); err != nil {
+			w.fail(i, err)
+		}
+	}
+	if w.live() == 0 {
+		return 0, w.err
+	}
+	return len(p), nil
+}
+
+func (w *mirrorWriter) Close() error {
+	var closed bool
+	for i, writer := range w.writers {
+		if writer == nil {
+			continue
+		}
+		if err := writer.Close(); err != nil {
+			w.writers[i] = nil
+			w.fail(i, err)
+			continue
+		}
+		closed = true
+	}
+	for _, i := range w.failed {
+		w.c.markDivergent(w.name, i)
+	}
+	if !closed {
+		return w.err
+	}
+	return nil
+}
+
+func (c *mirrorClient) Writer(name string) (io.WriteCloser, error) {
+	// An object that's written again after being deleted must not be deleted
+	// by the retry of the old delete
+	if c.isTombstoned(name) {
+		if err := c.clearTombstone(name); err != nil {
+			return nil, err
+		}
+	}
+	w := &mirrorWriter{
+		c:       c,
+		name:    name,
+		writers: make([]io.WriteCloser, len(c.clients)),
+	}
+	for i, client := range c.clients {
+		writer, err := client.Writer(name)
+		if err != nil {
+			w.fail(i, err)
+			continue
+		}
+		w.writers[i] = writer
+	}
+	if w.live() == 0 {
+		return nil, w.err
+	}
+	return w, nil
+}
+
+// Reader reads from the first client that has the object. Clients that the
+// object is known to have diverged in are only read from as a last resort.
+func (c *mirrorClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	c.mu.Lock()
+	divergent := c.divergent[name]
+	var order []int
+	for i := range c.clients {
+		if !divergent[i] {
+			order = append(order, i)
+		}
+	}
+	for i := range c.clients {
+		if divergent[i] {
+			order = append(order, i)
+		}
+	}
+	c.mu.Unlock()
+	var firstErr error
+	for _, i := range order {
+		r, err := c.clients[i].Reader(name, offset, size)
+		if err == nil {
+			return r, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+// Delete deletes the object from each client. It's only an error for the object
+// not to exist if it doesn't exist in any of them. If the object can't be
+// deleted from some clients, a tombstone is written and the delete is retried
+// in the background; it's only an error if the tombstone can't be written.
+func (c *mirrorClient) Delete(name string) error {
+	var deleted bool
+	var notExistErr, deleteErr error
+	for i, client := range c.clients {
+		if err := client.Delete(name); err != nil {
+			if client.IsNotExist(err) {
+				notExistErr = err
+				continue
+			}
+			log.Errorf("error deleting %s from mirror %d: %v", name, i, err)
+			if deleteErr == nil {
+				deleteErr = err
+			}
+			continue
+		}
+		deleted = true
+	}
+	c.mu.Lock()
+	delete(c.divergent, name)
+	c.mu.Unlock()
+	if deleteErr != nil {
+		if err := c.putTombstone(name); err != nil {
+			log.Errorf("error writing tombstone for %s to mirror: %v", name, err)
+			return deleteErr
+		}
+		return nil
+	}
+	if !deleted {
+		return notExistErr
+	}
+	return nil
+}
+
+// putTombstone records that 'name' has been deleted, in as many clients as
+// possible. It's only an error if it can't be recorded in any of them.
+func (c *mirrorClient) putTombstone(name string) error {
+	c.tombstoneMu.Lock()
+	defer c.tombstoneMu.Unlock()
+	var put bool
+	var putErr error
+	for _, client := range c.clients {
+		w, err := client.Writer(mirrorTombstonePrefix + name)
+		if err == nil {
+			err = w.Close()
+		}
+		if err != nil {
+			if putErr == nil {
+				putErr = err
+			}
+			continue
+		}
+		put = true
+	}
+	if !put {
+		return putErr
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tombstones[name] = true
+	return nil
+}
+
+// clearTombstone deletes the tombstone of 'name' from every client.
+func (c *mirrorClient) clearTombstone(name string) error {
+	c.tombstoneMu.Lock()
+	defer c.tombstoneMu.Unlock()
+	return c.clearTombstoneLocked(name)
+}
+
+func (c *mirrorClient) clearTombstoneLocked(name string) error {
+	for _, client := range c.clients {
+		if err := client.Delete(mirrorTombstonePrefix + name); err != nil && !client.IsNotExist(err) {
+			return err
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tombstones, name)
+	return nil
+}
+
+// retryDeletes deletes the objects that have tombstones from every client,
+// and then clears their tombstones. Objects that still can't be deleted keep
+// their tombstones.
+func (c *mirrorClient) retryDeletes() {
+	c.mu.Lock()
+	var names []string
+	for name := range c.tombstones {
+		names = append(names, name)
+	}
+	c.mu.Unlock()
+	for _, name := range names {
+		if err := c.retryDelete(name); err != nil {
+			log.Errorf("error deleting %s from mirror: %v", name, err)
+		}
+	}
+}
+
+func (c *mirrorClient) retryDelete(name string) error {
+	c.tombstoneMu.Lock()
+	defer c.tombstoneMu.Unlock()
+	// The object may have been written again since this delete was listed
+	if !c.isTombstoned(name) {
+		return nil
+	}
+	for _, client := range c.clients {
+		if err := client.Delete(name); err != nil && !client.IsNotExist(err) {
+			return err
+		}
+	}
+	return c.clearTombstoneLocked(name)
+}
+
+// Walk calls 'fn' once with the name of each object that's in at least one
+// client, apart from deleted objects and tombstones. It's only an error if
+// none of the clients can be walked.
+func (c *mirrorClient) Walk(prefix string, fn func(name string) error) error {
+	seen := make(map[string]bool)
+	var walked bool
+	var walkErr error
+	for i, client := range c.clients {
+		var fnErr error
+		if err := client.Walk(prefix, func(name string) error {
+			if seen[name] || strings.HasPrefix(name, mirrorTombstonePrefix) || c.isTombstoned(name) {
+				return nil
+			}
+			seen[name] = true
+			if err := fn(name); err != nil {
+				fnErr = err
+				return err
+			}
+			return nil
+		}); err != nil {
+			if fnErr != nil {
+				return fnErr
+			}
+			log.Errorf("error walking mirror %d: %v", i, err)
+			if walkErr == nil {
+				walkErr = err
+			}
+			continue
+		}
+		walked = true
+	}
+	if !walked {
+		return walkErr
+	}
+	return nil
+}
+
+func (c *mirrorClient) Exists(name string) bool {
+	if c.isTombstoned(name) {
+		return false
+	}
+	for _, client := range c.clients {
+		if client.Exists(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *mirrorClient) isRetryable(err error) bool {
+	for _, client := range c.clients {
+		if client.isRetryable(err) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *mirrorClient) IsNotExist(err error) bool {
+	for _, client := range c.clients {
+		if client.IsNotExist(err) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *mirrorClient) IsIgnorable(err error) bool {
+	for _, client := range c.clients {
+		if client.IsIgnorable(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// markDivergent records that the object 'name' may be missing from, or
+// partially written to, the i'th client.
+func (c *mirrorClient) markDivergent(name string, i int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.divergent[name] == nil {
+		c.divergent[name] = make(map[int]bool)
+	}
+	c.divergent[name][i] = true
+}
+
+// repairLoop periodically retries unfinished deletes and repairs divergent
+// objects until 'ctx' is done. Since divergent objects are only recorded in
+// memory, the clients are walked for them when the loop starts, in case a
+// previous process didn't repair them.
+func (c *mirrorClient) repairLoop(ctx context.Context) {
+	repairTicker := time.NewTicker(mirrorRepairInterval)
+	defer repairTicker.Stop()
+	fullRepairTicker := time.NewTicker(mirrorFullRepairInterval)
+	defer fullRepairTicker.Stop()
+	fullRepair := true
+	for {
+		c.retryDeletes()
+		if fullRepair {
+			if err := c.findDivergent(); err != nil {
+				log.Errorf("error looking for divergent objects in mirror: %v", err)
+			}
+		}
+		c.repair()
+		fullRepair = false
+		select {
+		case <-ctx.Done():
+			return
+		case <-repairTicker.C:
+		case <-fullRepairTicker.C:
+			fullRepair = true
+		}
+	}
+}
+
+// findDivergent walks each client, and marks the objects that are missing from
+// any of the others as divergent. Deleted objects and tombstones are skipped.
+func (c *mirrorClient) findDivergent() error {
+	for i, client := range c.clients {
+		if err := client.Walk("", func(name string) error {
+			if strings.HasPrefix(name, mirrorTombstonePrefix) || c.isTombstoned(name) {
+				return nil
+			}
+			for j, other := range c.clients {
+				if j != i && !other.Exists(name) {
+					c.markDivergent(name, j)
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repair copies each divergent object from a client that has it to the
+// clients that it diverged in. Objects that can't be repaired yet remain
+// divergent.
+func (c *mirrorClient) repair() {
+	c.mu.Lock()
+	divergent := c.divergent
+	c.divergent = make(map[string]map[int]bool)
+	c.mu.Unlock()
+	for name, targets := range divergent {
+		if err := c.repairObject(name, targets); err != nil {
+			log.Errorf("error repairing %s in mirror: %v", name, err)
+		}
+	}
+}
+
+func (c *mirrorClient) repairObject(name string, targets map[int]bool) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			for i := range targets {
+				c.markDivergent(name, i)
+			}
+		}
+	}()
+	var source Client
+	for i, client := range c.clients {
+		if !targets[i] && client.Exists(name) {
+			source = client
+			break
+		}
+	}
+	if source == nil || c.isTombstoned(name) {
+		// The object has been deleted, or there's no complete copy of it
+		// to repair from
+		return nil
+	}
+	for i := range targets {
+		if err := copyObject(source, c.clients[i], name); err != nil {
+			return err
+		}
+		delete(targets, i)
+	}
+	return nil
+}
+
+// copyObject copies the object 'name' from 'src' to 'dst', replacing any
+// (possibly partial) copy that 'dst' already has.
+func copyObject(src Client, dst Client, name string) (retErr error) {
+	if dst.Exists(name) {
+		if err := dst.Delete(name); err != nil && !dst.IsNotExist(err) {
+			return err
+		}
+	}
+	r, err := src.Reader(name, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	w, err := dst.Writer(name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
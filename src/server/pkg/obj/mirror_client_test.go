@@ -0,0 +1,183 @@
+package obj
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"golang.org/x/net/context"
+)
+
+// failingClient is a Client whose writes fail while 'fail' is set, and whose
+// deletes fail while 'failDelete' is set
+type failingClient struct {
+	Client
+	fail       bool
+	failDelete bool
+}
+
+func (c *failingClient) Delete(name string) error {
+	if c.failDelete {
+		return fmt.Errorf("failingClient: delete failed")
+	}
+	return c.Client.Delete(name)
+}
+
+type failingWriter struct {
+	io.WriteCloser
+}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, fmt.Errorf("failingWriter: write failed")
+}
+
+func (c *failingClient) Writer(name string) (io.WriteCloser, error) {
+	w, err := c.Client.Writer(name)
+	if err != nil || !c.fail {
+		return w, err
+	}
+	return failingWriter{w}, nil
+}
+
+func newTestMirrorClient(t *testing.T) (*mirrorClient, Client, *failingClient, func()) {
+	dir0, err := ioutil.TempDir("", "mirror")
+	require.NoError(t, err)
+	dir1, err := ioutil.TempDir("", "mirror")
+	require.NoError(t, err)
+	client0, err := NewLocalClient(dir0)
+	require.NoError(t, err)
+	local1, err := NewLocalClient(dir1)
+	require.NoError(t, err)
+	client1 := &failingClient{Client: local1}
+	ctx, cancel := context.WithCancel(context.Background())
+	c, err := newMirrorClient(ctx, []Client{client0, client1})
+	require.NoError(t, err)
+	return c, client0, client1, func() {
+		cancel()
+		os.RemoveAll(dir0)
+		os.RemoveAll(dir1)
+	}
+}
+
+func TestMirrorClient(t *testing.T) {
+	c, client0, client1, cleanup := newTestMirrorClient(t)
+	defer cleanup()
+	require.NoError(t, TestIsNotExist(c))
+
+	writeObject(t, c, "a", "aaaa")
+	require.Equal(t, "aaaa", readObject(t, client0, "a", 0, 0))
+	require.Equal(t, "aaaa", readObject(t, client1, "a", 0, 0))
+	require.Equal(t, "aa", readObject(t, c, "a", 1, 2))
+
+	// Objects are read from whichever client has them
+	writeObject(t, client1, "b", "bbbb")
+	require.True(t, c.Exists("b"))
+	require.Equal(t, "bbbb", readObject(t, c, "b", 0, 0))
+
+	// Objects are only walked once
+	var names []string
+	require.NoError(t, c.Walk("", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	sort.Strings(names)
+	require.Equal(t, []string{"a", "b"}, names)
+
+	require.NoError(t, c.Delete("a"))
+	require.False(t, client0.Exists("a"))
+	require.False(t, client1.Exists("a"))
+	require.NoError(t, c.Delete("b"))
+	require.True(t, c.IsNotExist(c.Delete("b")))
+}
+
+func TestMirrorClientRepair(t *testing.T) {
+	c, client0, client1, cleanup := newTestMirrorClient(t)
+	defer cleanup()
+
+	// Writes succeed as long as one of the clients succeeds
+	client1.fail = true
+	writeObject(t, c, "a", "aaaa")
+	require.True(t, client0.Exists("a"))
+	require.Equal(t, map[int]bool{1: true}, c.divergent["a"])
+	require.Equal(t, "aaaa", readObject(t, c, "a", 0, 0))
+
+	// Divergent objects are repaired once the client has recovered
+	c.repair()
+	require.Equal(t, map[int]bool{1: true}, c.divergent["a"])
+	client1.fail = false
+	c.repair()
+	require.Equal(t, 0, len(c.divergent))
+	require.Equal(t, "aaaa", readObject(t, client1, "a", 0, 0))
+
+	// Objects that are missing from some clients are found by walking them
+	writeObject(t, client0, "b", "bbbb")
+	writeObject(t, client1, "c", "cccc")
+	require.NoError(t, c.findDivergent())
+	c.repair()
+	require.Equal(t, "bbbb", readObject(t, client1, "b", 0, 0))
+	require.Equal(t, "cccc", readObject(t, client0, "c", 0, 0))
+}
+
+func TestMirrorClientTombstones(t *testing.T) {
+	c, client0, client1, cleanup := newTestMirrorClient(t)
+	defer cleanup()
+
+	// Deletes succeed as long as one of the clients succeeds, and the
+	// object isn't copied back to it
+	writeObject(t, c, "a", "aaaa")
+	client1.failDelete = true
+	require.NoError(t, c.Delete("a"))
+	require.False(t, client0.Exists("a"))
+	require.True(t, client1.Exists("a"))
+	require.False(t, c.Exists("a"))
+	require.NoError(t, c.findDivergent())
+	c.repair()
+	require.False(t, client0.Exists("a"))
+	var names []string
+	require.NoError(t, c.Walk("", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, 0, len(names))
+
+	// Tombstones are stored in the clients, so that they outlive the mirror
+	// client
+	require.True(t, client0.Exists(mirrorTombstonePrefix+"a"))
+
+	// The delete is retried until it succeeds
+	c.retryDeletes()
+	require.True(t, client1.Exists("a"))
+	client1.failDelete = false
+	c.retryDeletes()
+	require.False(t, client1.Exists("a"))
+	require.False(t, c.isTombstoned("a"))
+	require.False(t, client0.Exists(mirrorTombstonePrefix+"a"))
+
+	// Writing a deleted object again clears its tombstone
+	client1.failDelete = true
+	require.NoError(t, c.Delete("a"))
+	client1.failDelete = false
+	writeObject(t, c, "a", "aaaa")
+	c.retryDeletes()
+	require.Equal(t, "aaaa", readObject(t, client0, "a", 0, 0))
+	require.Equal(t, "aaaa", readObject(t, client1, "a", 0, 0))
+}
+
+func TestParseMirrorURL(t *testing.T) {
+	url, err := ParseURL("s3://us-bucket/dir/file,gs://eu-bucket/dir/file")
+	require.NoError(t, err)
+	require.Equal(t, "mirror", url.Store)
+	require.Equal(t, "dir/file", url.Object)
+	require.Equal(t, 2, len(url.Mirrors))
+	require.Equal(t, "s3", url.Mirrors[0].Store)
+	require.Equal(t, "us-bucket", url.Mirrors[0].Bucket)
+	require.Equal(t, "gs", url.Mirrors[1].Store)
+	require.Equal(t, "eu-bucket", url.Mirrors[1].Bucket)
+
+	_, err = ParseURL("s3://us-bucket/file1,gs://eu-bucket/file2")
+	require.YesError(t, err)
+}
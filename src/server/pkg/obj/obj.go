@@ -102,6 +102,31 @@ func NewMinioClient(endpoint, bucket, id, secret string, secure bool) (Client, e
 	return newMinioClient(endpoint, bucket, id, secret, secure)
 }
 
+// NewLocalClient creates a client that stores objects as files under rootDir,
+// creating rootDir if it doesn't exist. rootDir may be on a network
+// filesystem, such as NFS, that's shared by every pachd.
+func NewLocalClient(rootDir string) (Client, error) {
+	return newLocalClient(rootDir)
+}
+
+// NewTieredClient creates a client that keeps up to maxBytes of recently used
+// objects in hotDir on local disk, in front of cold. If writeBack is false,
+// writers return once cold has the object; otherwise they return once hotDir
+// has it, and it's copied to cold in the background. Write-back must only be
+// used if nothing else reads cold: other readers don't find an object until
+// it has been copied, and it's lost if hotDir is lost before then.
+func NewTieredClient(cold Client, hotDir string, maxBytes int64, writeBack bool) (Client, error) {
+	return newTieredClient(cold, hotDir, maxBytes, writeBack)
+}
+
+// NewMirrorClient creates a client that writes every object to each of
+// 'clients' and reads objects from the first of them that has them. Objects
+// that couldn't be written to some of the clients are copied to them in the
+// background, until ctx is done.
+func NewMirrorClient(ctx context.Context, clients ...Client) (Client, error) {
+	return newMirrorClient(ctx, clients)
+}
+
 // NewAmazonClient creates an amazon client with the following credentials:
 //   bucket - S3 bucket name
 //   distribution - cloudfront distribution ID
@@ -197,6 +222,19 @@ func NewClientFromURLAndSecret(ctx context.Context, url *ObjectStoreURL) (Client
 	case "wasb":
 		// In Azure, the first part of the path is the container name.
 		return NewMicrosoftClientFromSecret(url.Bucket)
+	case "local":
+		// Local URLs contain absolute paths, so the client is rooted at "/"
+		return NewLocalClient("/")
+	case "mirror":
+		var clients []Client
+		for _, mirror := range url.Mirrors {
+			client, err := NewClientFromURLAndSecret(ctx, mirror)
+			if err != nil {
+				return nil, err
+			}
+			clients = append(clients, client)
+		}
+		return NewMirrorClient(ctx, clients...)
 	}
 	return nil, fmt.Errorf("unrecognized object store: %s", url.Bucket)
 }
@@ -209,10 +247,17 @@ type ObjectStoreURL struct {
 	Bucket string
 	// The object itself.
 	Object string
+	// The object stores that a "mirror" URL refers to.
+	Mirrors []*ObjectStoreURL
 }
 
-// ParseURL parses an URL into ObjectStoreURL.
+// ParseURL parses an URL into ObjectStoreURL. A comma-separated list of URLs,
+// e.g. "s3://us-bucket/dir,gs://eu-bucket/dir", refers to the same object
+// mirrored in each of them.
 func ParseURL(urlStr string) (*ObjectStoreURL, error) {
+	if strings.Contains(urlStr, ",") {
+		return parseMirrorURL(urlStr)
+	}
 	url, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing url %v: %v", urlStr, err)
@@ -235,10 +280,33 @@ func ParseURL(urlStr string) (*ObjectStoreURL, error) {
 			Bucket: parts[0],
 			Object: strings.Trim(path.Join(parts[1:]...), "/"),
 		}, nil
+	case "local":
+		// Both local:///path and local://path refer to /path
+		return &ObjectStoreURL{
+			Store:  url.Scheme,
+			Object: strings.Trim(path.Join(url.Host, url.Path), "/"),
+		}, nil
 	}
 	return nil, fmt.Errorf("unrecognized object store: %s", url.Scheme)
 }
 
+func parseMirrorURL(urlStr string) (*ObjectStoreURL, error) {
+	result := &ObjectStoreURL{Store: "mirror"}
+	for i, mirrorStr := range strings.Split(urlStr, ",") {
+		mirror, err := ParseURL(mirrorStr)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result.Object = mirror.Object
+		} else if mirror.Object != result.Object {
+			return nil, fmt.Errorf("mirrors must refer to the same object, but %v and %v differ", result.Object, mirror.Object)
+		}
+		result.Mirrors = append(result.Mirrors, mirror)
+	}
+	return result, nil
+}
+
 // NewExponentialBackOffConfig creates an exponential back-off config with
 // longer wait times than the default.
 func NewExponentialBackOffConfig() *backoff.ExponentialBackOff {
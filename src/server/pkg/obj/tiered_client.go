@@ -0,0 +1,449 @@
+package obj
+
+import (
+	"container/list"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	log "github.com/sirupsen/logrus"
+)
+
+// errTooLarge is returned when an object can't be stored in the hot tier
+// because it's larger than the whole tier.
+var errTooLarge = errors.New("object is larger than the hot tier")
+
+// tieredClient is a Client that keeps recently used objects in a bounded hot
+// tier on local disk, in front of a cold Client such as a cloud object store.
+// Reads are served from the hot tier when possible, and objects that are read
+// from the cold tier are copied into the hot tier, evicting the least
+// recently used objects if it's full.
+//
+// In write-through mode, writes go to both tiers and Close returns once the
+// cold tier has the object. In write-back mode, Close returns once the hot
+// tier has the object, and the object is copied to the cold tier in the
+// background; it isn't evicted until it has been. Write-back is only safe if
+// this client is the only reader of the cold tier, since other readers don't
+// find an object until it's uploaded, and the object is lost if the hot tier
+// is lost (e.g. because pachd was rescheduled) before then. pachd's object
+// store is always shared with its workers, so pachd only uses write-through.
+//
+// The cold tier's readers and writers are used as is, so its retry semantics
+// (e.g. those of BackoffReadCloser and BackoffWriteCloser) still apply, and
+// errors are classified by the cold tier.
+type tieredClient struct {
+	cold      Client
+	hot       *localClient
+	maxBytes  int64
+	writeBack bool
+
+	// mu protects everything below
+	mu sync.Mutex
+	// lru holds the names of the objects in the hot tier, most recently used
+	// first
+	lru *list.List
+	// entries maps the name of each object in the hot tier to its element in
+	// 'lru'
+	entries map[string]*list.Element
+	// sizes maps the name of each object in the hot tier to its size
+	sizes map[string]int64
+	size  int64
+	// uploads maps the names of objects that are in the hot tier but not yet
+	// in the cold tier to a channel that's closed once they are
+	uploads map[string]chan struct{}
+	// tooLarge holds the names of objects that are known not to fit in the hot
+	// tier, so that they're read from the cold tier directly
+	tooLarge map[string]bool
+}
+
+func newTieredClient(cold Client, hotDir string, maxBytes int64, writeBack bool) (*tieredClient, error) {
+	hot, err := newLocalClient(hotDir)
+	if err != nil {
+		return nil, err
+	}
+	c := &tieredClient{
+		cold:      cold,
+		hot:       hot,
+		maxBytes:  maxBytes,
+		writeBack: writeBack,
+		lru:       list.New(),
+		entries:   make(map[string]*list.Element),
+		sizes:     make(map[string]int64),
+		uploads:   make(map[string]chan struct{}),
+		tooLarge:  make(map[string]bool),
+	}
+	// Reuse the objects left in the hot tier by previous processes, treating
+	// the most recently modified ones as the most recently used
+	type hotObject struct {
+		name string
+		info os.FileInfo
+	}
+	var objects []hotObject
+	if err := hot.Walk("", func(name string) error {
+		info, err := os.Stat(hot.objectPath(name))
+		if err != nil {
+			return err
+		}
+		objects = append(objects, hotObject{name, info})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].info.ModTime().After(objects[j].info.ModTime())
+	})
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, object := range objects {
+		c.entries[object.name] = c.lru.PushBack(object.name)
+		c.sizes[object.name] = object.info.Size()
+		c.size += object.info.Size()
+		// In write-back mode, a previous process may have exited before
+		// copying some objects to the cold tier
+		if writeBack && !cold.Exists(object.name) {
+			c.startUpload(object.name)
+		}
+	}
+	c.evict()
+	return c, nil
+}
+
+// tieredWriter writes an object to the hot tier and, in write-through mode,
+// to the cold tier.
+type tieredWriter struct {
+	c    *tieredClient
+	name string
+	// cold is nil in write-back mode
+	cold io.WriteCloser
+	// hot is nil if the object won't be stored in the hot tier
+	hot  *localWriter
+	size int64
+}
+
+func (w *tieredWriter) Write(p []byte) (int, error) {
+	if w.cold != nil {
+		if n, err := w.cold.Write(p); err != nil {
+			return n, err
+		}
+	}
+	w.size += int64(len(p))
+	if w.hot != nil {
+		// In write-through mode, objects that don't fit in the hot tier (or
+		// can't be written to it) are only stored in the cold tier
+		if _, err := w.hot.Write(p); (err != nil || w.size > w.c.maxBytes) && w.cold != nil {
+			w.hot.abort()
+			w.hot = nil
+			if err == nil {
+				w.c.setTooLarge(w.name, true)
+			}
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *tieredWriter) Close() error {
+	if w.cold != nil {
+		if err := w.cold.Close(); err != nil {
+			if w.hot != nil {
+				w.hot.abort()
+			}
+			return err
+		}
+		if w.hot != nil && w.hot.Close() == nil {
+			w.c.add(w.name, w.size)
+		}
+		return nil
+	}
+	// Write-back mode
+	if err := w.hot.Close(); err != nil {
+		return err
+	}
+	if w.size > w.c.maxBytes {
+		// The object can't stay in the hot tier, so copy it to the cold tier
+		// now
+		if err := w.c.copyToCold(w.name); err != nil {
+			return err
+		}
+		w.c.setTooLarge(w.name, true)
+		return w.c.hot.Delete(w.name)
+	}
+	// Start the upload before adding the object, so that it can't be evicted
+	w.c.mu.Lock()
+	defer w.c.mu.Unlock()
+	w.c.startUpload(w.name)
+	w.c.addLocked(w.name, w.size)
+	return nil
+}
+
+func (c *tieredClient) Writer(name string) (io.WriteCloser, error) {
+	// Wait for any pending upload of the object, so that it can't overwrite
+	// this write in the cold tier
+	c.waitForUpload(name)
+	// Drop any old copy of the object, in case the new one isn't stored in
+	// the hot tier
+	c.mu.Lock()
+	if _, ok := c.entries[name]; ok {
+		c.remove(name)
+	}
+	delete(c.tooLarge, name)
+	c.mu.Unlock()
+	hot, err := c.hot.writer(name)
+	if err != nil {
+		return nil, err
+	}
+	w := &tieredWriter{c: c, name: name, hot: hot}
+	if !c.writeBack {
+		w.cold, err = c.cold.Writer(name)
+		if err != nil {
+			hot.abort()
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+func (c *tieredClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	c.mu.Lock()
+	tooLarge := c.tooLarge[name]
+	c.mu.Unlock()
+	if tooLarge {
+		return c.cold.Reader(name, offset, size)
+	}
+	if c.touch(name) {
+		r, err := c.hot.Reader(name, offset, size)
+		if err == nil || !c.hot.IsNotExist(err) {
+			return r, err
+		}
+		// The object was evicted after it was touched
+	} else if err := c.fetch(name); err != nil {
+		if err == errTooLarge {
+			c.setTooLarge(name, true)
+			return c.cold.Reader(name, offset, size)
+		}
+		return nil, err
+	}
+	r, err := c.hot.Reader(name, offset, size)
+	if err != nil && c.hot.IsNotExist(err) {
+		return c.cold.Reader(name, offset, size)
+	}
+	return r, err
+}
+
+func (c *tieredClient) Delete(name string) error {
+	c.waitForUpload(name)
+	err := c.cold.Delete(name)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[name]; ok {
+		c.remove(name)
+	}
+	delete(c.tooLarge, name)
+	return err
+}
+
+func (c *tieredClient) Walk(prefix string, fn func(name string) error) error {
+	// Objects that haven't been copied to the cold tier yet must be walked
+	// too
+	pending := make(map[string]bool)
+	c.mu.Lock()
+	for name := range c.uploads {
+		if strings.HasPrefix(name, prefix) {
+			pending[name] = true
+		}
+	}
+	c.mu.Unlock()
+	if err := c.cold.Walk(prefix, func(name string) error {
+		delete(pending, name)
+		return fn(name)
+	}); err != nil {
+		return err
+	}
+	var names []string
+	for name := range pending {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := fn(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *tieredClient) Exists(name string) bool {
+	c.mu.Lock()
+	_, ok := c.entries[name]
+	c.mu.Unlock()
+	return ok || c.cold.Exists(name)
+}
+
+func (c *tieredClient) isRetryable(err error) bool {
+	return c.cold.isRetryable(err)
+}
+
+func (c *tieredClient) IsNotExist(err error) bool {
+	return c.cold.IsNotExist(err) || c.hot.IsNotExist(err)
+}
+
+func (c *tieredClient) IsIgnorable(err error) bool {
+	return c.cold.IsIgnorable(err)
+}
+
+// fetch copies the object 'name' from the cold tier to the hot tier. It
+// returns errTooLarge if the object doesn't fit in the hot tier.
+func (c *tieredClient) fetch(name string) (retErr error) {
+	r, err := c.cold.Reader(name, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	w, err := c.hot.writer(name)
+	if err != nil {
+		return err
+	}
+	size, err := io.Copy(w, io.LimitReader(r, c.maxBytes+1))
+	if err != nil {
+		w.abort()
+		return err
+	}
+	if size > c.maxBytes {
+		w.abort()
+		return errTooLarge
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	c.add(name, size)
+	return nil
+}
+
+// copyToCold copies the object 'name' from the hot tier to the cold tier.
+func (c *tieredClient) copyToCold(name string) (retErr error) {
+	r, err := c.hot.Reader(name, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := c.cold.Writer(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// startUpload copies the object 'name' to the cold tier in the background,
+// retrying until it succeeds. c.mu must be held.
+func (c *tieredClient) startUpload(name string) {
+	done := make(chan struct{})
+	c.uploads[name] = done
+	go func() {
+		defer close(done)
+		b := NewExponentialBackOffConfig()
+		b.MaxElapsedTime = 0 // never give up, or the object would be lost
+		backoff.RetryNotify(func() error {
+			return c.copyToCold(name)
+		}, b, func(err error, d time.Duration) {
+			log.Errorf("error copying %s to the cold tier; retrying in %s: %v", name, d, err)
+		})
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.uploads, name)
+		c.evict()
+	}()
+}
+
+// waitForUpload waits until the object 'name' has been copied to the cold
+// tier, if it's being copied.
+func (c *tieredClient) waitForUpload(name string) {
+	c.mu.Lock()
+	done, ok := c.uploads[name]
+	c.mu.Unlock()
+	if ok {
+		<-done
+	}
+}
+
+// add records that the object 'name', of size 'size', is in the hot tier.
+func (c *tieredClient) add(name string, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addLocked(name, size)
+}
+
+// addLocked is like add, but c.mu must be held.
+func (c *tieredClient) addLocked(name string, size int64) {
+	if elem, ok := c.entries[name]; ok {
+		c.size -= c.sizes[name]
+		c.lru.MoveToFront(elem)
+	} else {
+		c.entries[name] = c.lru.PushFront(name)
+	}
+	c.sizes[name] = size
+	c.size += size
+	c.evict()
+}
+
+// setTooLarge records whether the object 'name' is too large for the hot
+// tier.
+func (c *tieredClient) setTooLarge(name string, tooLarge bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if tooLarge {
+		c.tooLarge[name] = true
+	} else {
+		delete(c.tooLarge, name)
+	}
+}
+
+// touch marks the object 'name' as the most recently used one, and returns
+// whether it's in the hot tier.
+func (c *tieredClient) touch(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[name]
+	if ok {
+		c.lru.MoveToFront(elem)
+	}
+	return ok
+}
+
+// evict removes the least recently used objects from the hot tier until it
+// fits in 'maxBytes', skipping objects that haven't been copied to the cold
+// tier yet. c.mu must be held.
+func (c *tieredClient) evict() {
+	for elem := c.lru.Back(); elem != nil && c.size > c.maxBytes; {
+		name := elem.Value.(string)
+		elem = elem.Prev()
+		if _, ok := c.uploads[name]; ok {
+			continue
+		}
+		c.remove(name)
+	}
+}
+
+// remove removes the object 'name' from the hot tier. c.mu must be held.
+func (c *tieredClient) remove(name string) {
+	c.hot.Delete(name)
+	c.lru.Remove(c.entries[name])
+	delete(c.entries, name)
+	c.size -= c.sizes[name]
+	delete(c.sizes, name)
+}
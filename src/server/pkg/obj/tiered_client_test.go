@@ -0,0 +1,128 @@
+package obj
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// countingClient is a Client that counts the readers it creates
+type countingClient struct {
+	Client
+	reads int64
+}
+
+func (c *countingClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	atomic.AddInt64(&c.reads, 1)
+	return c.Client.Reader(name, offset, size)
+}
+
+func newTestTieredClient(t *testing.T, maxBytes int64, writeBack bool) (*tieredClient, *countingClient, func()) {
+	coldDir, err := ioutil.TempDir("", "cold")
+	require.NoError(t, err)
+	hotDir, err := ioutil.TempDir("", "hot")
+	require.NoError(t, err)
+	local, err := NewLocalClient(coldDir)
+	require.NoError(t, err)
+	cold := &countingClient{Client: local}
+	c, err := newTieredClient(cold, hotDir, maxBytes, writeBack)
+	require.NoError(t, err)
+	return c, cold, func() {
+		os.RemoveAll(coldDir)
+		os.RemoveAll(hotDir)
+	}
+}
+
+func TestTieredClient(t *testing.T) {
+	c, cold, cleanup := newTestTieredClient(t, 10, false)
+	defer cleanup()
+
+	// Writes go to both tiers
+	writeObject(t, c, "a", "aaaa")
+	require.True(t, cold.Exists("a"))
+	require.Equal(t, "aaaa", readObject(t, c, "a", 0, 0))
+	require.Equal(t, "aa", readObject(t, c, "a", 1, 2))
+	require.Equal(t, int64(0), cold.reads)
+
+	// Objects that are only in the cold tier are read into the hot tier
+	writeObject(t, cold, "b", "bbbb")
+	require.Equal(t, "bb", readObject(t, c, "b", 2, 0))
+	require.Equal(t, "bbbb", readObject(t, c, "b", 0, 0))
+	require.Equal(t, int64(1), cold.reads)
+
+	// "a" is the least recently used object, so it's evicted
+	writeObject(t, c, "c", "cccc")
+	require.Equal(t, "aaaa", readObject(t, c, "a", 0, 0))
+	require.Equal(t, int64(2), cold.reads)
+
+	// Objects larger than the hot tier are read from the cold tier
+	writeObject(t, c, "big", "0123456789abc")
+	require.Equal(t, "0123456789abc", readObject(t, c, "big", 0, 0))
+	require.Equal(t, "9a", readObject(t, c, "big", 9, 2))
+	require.Equal(t, int64(4), cold.reads)
+	// ...and aren't fetched into the hot tier more than once
+	writeObject(t, cold, "big2", "0123456789abc")
+	require.Equal(t, "0123456789abc", readObject(t, c, "big2", 0, 0))
+	require.Equal(t, "0123456789abc", readObject(t, c, "big2", 0, 0))
+	require.Equal(t, int64(7), cold.reads)
+
+	require.NoError(t, c.Delete("a"))
+	require.False(t, c.Exists("a"))
+	_, err := c.Reader("a", 0, 0)
+	require.True(t, c.IsNotExist(err))
+	require.NoError(t, TestIsNotExist(c))
+}
+
+func TestTieredClientWriteBack(t *testing.T) {
+	c, cold, cleanup := newTestTieredClient(t, 10, true)
+	defer cleanup()
+
+	writeObject(t, c, "a", "aaaa")
+	require.True(t, c.Exists("a"))
+	var names []string
+	require.NoError(t, c.Walk("", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, []string{"a"}, names)
+	require.Equal(t, "aaaa", readObject(t, c, "a", 0, 0))
+
+	// The object is copied to the cold tier in the background
+	require.NoError(t, backoffUntil(func() bool { return cold.Exists("a") }))
+	require.Equal(t, "aaaa", readObject(t, cold, "a", 0, 0))
+
+	// Objects larger than the hot tier are written to the cold tier directly
+	writeObject(t, c, "big", "0123456789abc")
+	require.True(t, cold.Exists("big"))
+
+	require.NoError(t, c.Delete("a"))
+	require.False(t, cold.Exists("a"))
+	require.False(t, c.Exists("a"))
+}
+
+func TestTieredClientReopen(t *testing.T) {
+	c, cold, cleanup := newTestTieredClient(t, 10, false)
+	defer cleanup()
+	writeObject(t, c, "a", "aaaa")
+
+	c, err := newTieredClient(cold, c.hot.rootDir, 10, false)
+	require.NoError(t, err)
+	require.Equal(t, "aaaa", readObject(t, c, "a", 0, 0))
+	require.Equal(t, int64(0), cold.reads)
+}
+
+// backoffUntil polls 'f' until it returns true, or fails after a few seconds
+func backoffUntil(f func() bool) error {
+	for i := 0; i < 100; i++ {
+		if f() {
+			return nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return io.ErrNoProgress
+}
@@ -1,14 +1,15 @@
-// Package pfsdb contains the database schema that PFS uses.
+// Package pfsdb contains the database schema that PFS uses. Its collections
+// are kept in a kv.Store, so that PFS can run on etcd or on an embedded store.
 package pfsdb
 
 import (
 	"fmt"
 	"path"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 )
 
 const (
@@ -17,6 +18,9 @@ const (
 	commitsPrefix       = "/commits"
 	branchesPrefix      = "/branches"
 	openCommitsPrefix   = "/openCommits"
+	repoQuotasPrefix    = "/repoQuotas"
+	userQuotasPrefix    = "/userQuotas"
+	userUsagePrefix     = "/userUsage"
 )
 
 var (
@@ -25,9 +29,9 @@ var (
 )
 
 // Repos returns a collection of repos
-func Repos(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Repos(store kv.Store, etcdPrefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
 		path.Join(etcdPrefix, reposPrefix),
 		[]col.Index{ProvenanceIndex},
 		&pfs.RepoInfo{},
@@ -36,9 +40,9 @@ func Repos(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 }
 
 // RepoRefCounts returns a collection of repo ref counts
-func RepoRefCounts(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func RepoRefCounts(store kv.Store, etcdPrefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
 		path.Join(etcdPrefix, repoRefCountsPrefix),
 		nil,
 		nil,
@@ -47,9 +51,9 @@ func RepoRefCounts(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 }
 
 // Commits returns a collection of commits
-func Commits(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Commits(store kv.Store, etcdPrefix string, repo string) col.Collection {
+	return col.NewStoreCollection(
+		store,
 		path.Join(etcdPrefix, commitsPrefix, repo),
 		[]col.Index{ProvenanceIndex},
 		&pfs.CommitInfo{},
@@ -58,9 +62,9 @@ func Commits(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collec
 }
 
 // Branches returns a collection of branches
-func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Branches(store kv.Store, etcdPrefix string, repo string) col.Collection {
+	return col.NewStoreCollection(
+		store,
 		path.Join(etcdPrefix, branchesPrefix, repo),
 		nil,
 		&pfs.Commit{},
@@ -74,12 +78,46 @@ func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Colle
 }
 
 // OpenCommits returns a collection of open commits
-func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func OpenCommits(store kv.Store, etcdPrefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
 		path.Join(etcdPrefix, openCommitsPrefix),
 		nil,
 		&pfs.Commit{},
 		nil,
 	)
 }
+
+// RepoQuotas returns a collection of repo quotas, keyed by repo name
+func RepoQuotas(store kv.Store, etcdPrefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(etcdPrefix, repoQuotasPrefix),
+		nil,
+		&pfs.Quota{},
+		nil,
+	)
+}
+
+// UserQuotas returns a collection of user quotas, keyed by username
+func UserQuotas(store kv.Store, etcdPrefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(etcdPrefix, userQuotasPrefix),
+		nil,
+		&pfs.Quota{},
+		nil,
+	)
+}
+
+// UserUsage returns a collection of the storage used by each user, keyed by
+// username
+func UserUsage(store kv.Store, etcdPrefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(etcdPrefix, userUsagePrefix),
+		nil,
+		&pfs.Usage{},
+		nil,
+	)
+}
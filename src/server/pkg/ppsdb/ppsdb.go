@@ -11,8 +11,11 @@ import (
 )
 
 const (
-	pipelinesPrefix = "/pipelines"
-	jobsPrefix      = "/jobs"
+	pipelinesPrefix          = "/pipelines"
+	jobsPrefix               = "/jobs"
+	gcObjectCandidatesPrefix = "/gc/objects"
+	gcTagCandidatesPrefix    = "/gc/tags"
+	gcCheckpointsPrefix      = "/gc/checkpoints"
 )
 
 var (
@@ -50,3 +53,39 @@ func Jobs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 		nil,
 	)
 }
+
+// GCObjectCandidates returns a Collection of the objects that the garbage
+// collector has found to be unreferenced, keyed by hash
+func GCObjectCandidates(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, gcObjectCandidatesPrefix),
+		[]col.Index{},
+		&pps.GCCandidate{},
+		nil,
+	)
+}
+
+// GCTagCandidates returns a Collection of the tags that the garbage collector
+// has found to be unreferenced, keyed by name
+func GCTagCandidates(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, gcTagCandidatesPrefix),
+		[]col.Index{},
+		&pps.GCCandidate{},
+		nil,
+	)
+}
+
+// GCCheckpoints returns a Collection of the checkpoints of garbage
+// collection's mark phase
+func GCCheckpoints(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, gcCheckpointsPrefix),
+		[]col.Index{},
+		&pps.GCCheckpoint{},
+		nil,
+	)
+}
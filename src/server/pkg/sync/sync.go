@@ -2,13 +2,20 @@
 package sync
 
 import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
+	"time"
 
 	pachclient "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -16,6 +23,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 
+	"github.com/gogo/protobuf/types"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -133,6 +141,51 @@ func (p *Puller) makeFile(path string, f func(io.Writer) error) (retErr error) {
 	return nil
 }
 
+// makeSymlink creates a symlink at 'path' pointing to 'target'
+func makeSymlink(path string, target string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.Symlink(target, path)
+}
+
+// setAttrs sets the permission bits and modification time of the file at
+// 'path', if they're set
+func setAttrs(path string, mode uint32, mtime *types.Timestamp) error {
+	if mode != 0 {
+		if err := os.Chmod(path, os.FileMode(mode)); err != nil {
+			return err
+		}
+	}
+	if mtime != nil {
+		t, err := types.TimestampFromProto(mtime)
+		if err != nil {
+			return err
+		}
+		if err := os.Chtimes(path, time.Now(), t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putTree mirrors the file described by 'fileInfo' into 'tree' at 'treePath'
+func putTree(tree hashtree.OpenHashTree, treePath string, fileInfo *pfs.FileInfo) error {
+	var err error
+	switch fileInfo.FileType {
+	case pfs.FileType_DIR:
+		err = tree.PutDir(treePath)
+	case pfs.FileType_SYMLINK:
+		err = tree.PutSymlink(treePath, fileInfo.SymlinkTarget)
+	default:
+		err = tree.PutFile(treePath, fileInfo.Objects, int64(fileInfo.SizeBytes))
+	}
+	if err != nil {
+		return err
+	}
+	return tree.SetAttrs(treePath, fileInfo.Mode, fileInfo.Mtime)
+}
+
 // Pull clones an entire repo at a certain commit.
 // root is the local path you want to clone to.
 // fileInfo is the file/dir we are puuling.
@@ -140,48 +193,67 @@ func (p *Puller) makeFile(path string, f func(io.Writer) error) (retErr error) {
 // lazily downloading the data as it's needed.
 // tree is a hashtree to mirror the pulled content into (it may be left nil)
 // treeRoot is the root the data is mirrored to within tree
+// Symlinks, permission bits and modification times are preserved.
 func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, file string,
 	pipes bool, concurrency int, tree hashtree.OpenHashTree, treeRoot string) error {
 	limiter := limit.New(concurrency)
 	var eg errgroup.Group
+	// dirs holds the directories whose attributes must be set once all of
+	// their contents have been written
+	var dirs []*pfs.FileInfo
+	var dirPaths []string
 	if err := client.Walk(repo, commit, file, func(fileInfo *pfs.FileInfo) error {
 		basepath, err := filepath.Rel(file, fileInfo.File.Path)
 		if err != nil {
 			return err
 		}
 		if tree != nil {
-			treePath := path.Join(treeRoot, basepath)
-			if fileInfo.FileType == pfs.FileType_DIR {
-				if err := tree.PutDir(treePath); err != nil {
-					return err
-				}
-			} else {
-				if err := tree.PutFile(treePath, fileInfo.Objects, int64(fileInfo.SizeBytes)); err != nil {
-					return err
-				}
+			if err := putTree(tree, path.Join(treeRoot, basepath), fileInfo); err != nil {
+				return err
 			}
 		}
 		path := filepath.Join(root, basepath)
-		if fileInfo.FileType == pfs.FileType_DIR {
+		switch fileInfo.FileType {
+		case pfs.FileType_DIR:
+			dirs = append(dirs, fileInfo)
+			dirPaths = append(dirPaths, path)
 			return os.MkdirAll(path, 0700)
+		case pfs.FileType_SYMLINK:
+			return makeSymlink(path, fileInfo.SymlinkTarget)
 		}
 		if pipes {
-			return p.makePipe(path, func(w io.Writer) error {
+			if err := p.makePipe(path, func(w io.Writer) error {
 				return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, w)
-			})
+			}); err != nil {
+				return err
+			}
+			return setAttrs(path, fileInfo.Mode, fileInfo.Mtime)
 		}
 		eg.Go(func() (retErr error) {
 			limiter.Acquire()
 			defer limiter.Release()
-			return p.makeFile(path, func(w io.Writer) error {
+			if err := p.makeFile(path, func(w io.Writer) error {
 				return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, w)
-			})
+			}); err != nil {
+				return err
+			}
+			return setAttrs(path, fileInfo.Mode, fileInfo.Mtime)
 		})
 		return nil
 	}); err != nil {
 		return err
 	}
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	// Set the attributes of subdirectories before their parents', in case a
+	// parent's mode doesn't allow its children to be modified
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := setAttrs(dirPaths[i], dirs[i].Mode, dirs[i].Mtime); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // PullDiff is like Pull except that it materializes a Diff of the content
@@ -206,7 +278,7 @@ func (p *Puller) PullDiff(client *pachclient.APIClient, root string, newRepo, ne
 			if newOnly {
 				treePath = path.Join(treeRoot, basepath)
 			}
-			if err := tree.PutFile(treePath, newFile.Objects, int64(newFile.SizeBytes)); err != nil {
+			if err := putTree(tree, treePath, newFile); err != nil {
 				return err
 			}
 		}
@@ -214,7 +286,11 @@ func (p *Puller) PullDiff(client *pachclient.APIClient, root string, newRepo, ne
 		if newOnly {
 			path = filepath.Join(root, basepath)
 		}
-		if pipes {
+		if newFile.FileType == pfs.FileType_SYMLINK {
+			if err := makeSymlink(path, newFile.SymlinkTarget); err != nil {
+				return err
+			}
+		} else if pipes {
 			if err := p.makePipe(path, func(w io.Writer) error {
 				return client.GetFile(newFile.File.Commit.Repo.Name, newFile.File.Commit.ID, newFile.File.Path, 0, 0, w)
 			}); err != nil {
@@ -239,12 +315,16 @@ func (p *Puller) PullDiff(client *pachclient.APIClient, root string, newRepo, ne
 			}
 			if tree != nil {
 				treePath := path.Join(treeRoot, "old", basepath)
-				if err := tree.PutFile(treePath, oldFile.Objects, int64(oldFile.SizeBytes)); err != nil {
+				if err := putTree(tree, treePath, oldFile); err != nil {
 					return err
 				}
 			}
 			path := filepath.Join(root, "old", basepath)
-			if pipes {
+			if oldFile.FileType == pfs.FileType_SYMLINK {
+				if err := makeSymlink(path, oldFile.SymlinkTarget); err != nil {
+					return err
+				}
+			} else if pipes {
 				if err := p.makePipe(path, func(w io.Writer) error {
 					return client.GetFile(oldFile.File.Commit.Repo.Name, oldFile.File.Commit.ID, oldFile.File.Path, 0, 0, w)
 				}); err != nil {
@@ -270,6 +350,9 @@ func (p *Puller) PullTree(client *pachclient.APIClient, root string, tree hashtr
 	limiter := limit.New(concurrency)
 	var eg errgroup.Group
 	if err := tree.Walk(func(path string, node *hashtree.NodeProto) error {
+		if node.SymlinkNode != nil {
+			return makeSymlink(filepath.Join(root, path), node.SymlinkNode.Target)
+		}
 		if node.FileNode != nil {
 			path := filepath.Join(root, path)
 			var hashes []string
@@ -277,16 +360,22 @@ func (p *Puller) PullTree(client *pachclient.APIClient, root string, tree hashtr
 				hashes = append(hashes, object.Hash)
 			}
 			if pipes {
-				return p.makePipe(path, func(w io.Writer) error {
+				if err := p.makePipe(path, func(w io.Writer) error {
 					return client.GetObjects(hashes, 0, 0, w)
-				})
+				}); err != nil {
+					return err
+				}
+				return setAttrs(path, node.Mode, node.Mtime)
 			}
 			limiter.Acquire()
 			eg.Go(func() (retErr error) {
 				defer limiter.Release()
-				return p.makeFile(path, func(w io.Writer) error {
+				if err := p.makeFile(path, func(w io.Writer) error {
 					return client.GetObjects(hashes, 0, 0, w)
-				})
+				}); err != nil {
+					return err
+				}
+				return setAttrs(path, node.Mode, node.Mtime)
 			})
 		}
 		return nil
@@ -338,7 +427,8 @@ func (p *Puller) CleanUp() (int64, error) {
 	return size, result
 }
 
-// Push puts files under root into an open commit.
+// Push puts files under root into an open commit. Symlinks are pushed as
+// symlinks, and files keep their permission bits and modification times.
 func Push(client *pachclient.APIClient, root string, commit *pfs.Commit, overwrite bool) error {
 	var g errgroup.Group
 	if err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
@@ -347,16 +437,6 @@ func Push(client *pachclient.APIClient, root string, commit *pfs.Commit, overwri
 				return nil
 			}
 
-			f, err := os.Open(path)
-			if err != nil {
-				return err
-			}
-			defer func() {
-				if err := f.Close(); err != nil && retErr == nil {
-					retErr = err
-				}
-			}()
-
 			relPath, err := filepath.Rel(root, path)
 			if err != nil {
 				return err
@@ -368,7 +448,25 @@ func Push(client *pachclient.APIClient, root string, commit *pfs.Commit, overwri
 				}
 			}
 
-			_, err = client.PutFile(commit.Repo.Name, commit.ID, relPath, f)
+			if (info.Mode() & os.ModeSymlink) > 0 {
+				target, err := os.Readlink(path)
+				if err != nil {
+					return err
+				}
+				return client.PutSymlink(commit.Repo.Name, commit.ID, relPath, target)
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := f.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+
+			_, err = client.PutFileWithAttrs(commit.Repo.Name, commit.ID, relPath, info.Mode(), info.ModTime(), false, f)
 			return err
 		})
 		return nil
@@ -407,3 +505,338 @@ func PushObj(pachClient pachclient.APIClient, commit *pfs.Commit, objClient obj.
 	}
 	return eg.Wait()
 }
+
+// syncConcurrency is the number of files that Upload and Download transfer
+// at once
+const syncConcurrency = 100
+
+// ChangeType is the kind of change that Upload or Download makes to a file.
+type ChangeType int
+
+const (
+	// ChangeWrite means that a file is created or overwritten
+	ChangeWrite ChangeType = iota
+	// ChangeDelete means that a file is deleted
+	ChangeDelete
+)
+
+// String returns "write" or "delete".
+func (t ChangeType) String() string {
+	if t == ChangeDelete {
+		return "delete"
+	}
+	return "write"
+}
+
+// Change is a change that Upload or Download makes to a file (or would make,
+// in a dry run).
+type Change struct {
+	Type ChangeType
+	// Path is the path of the file, relative to the directories being synced
+	Path string
+}
+
+// Upload makes the directory 'dir' in 'branch' of 'repo' match the local
+// directory 'root', writing only the files whose content, mode or symlink
+// target differ, in a single commit. If 'del' is set, files that aren't in
+// 'root' are deleted from 'dir'. If 'dryRun' is set, nothing is changed. The
+// changes are returned in the order in which they're made.
+func Upload(client *pachclient.APIClient, root string, repo, branch, dir string, del, dryRun bool) ([]*Change, error) {
+	dir = path.Join("/", dir)
+	local, err := walkLocal(root)
+	if err != nil {
+		return nil, err
+	}
+	// The branch or directory not existing yet just means there's nothing
+	// there
+	remote, err := walkRemote(client, repo, branch, dir)
+	if err != nil && !isNotFoundErr(err) {
+		return nil, err
+	}
+	var changes []*Change
+	if del {
+		for _, p := range remotePaths(remote) {
+			if _, ok := local[p]; !ok {
+				changes = append(changes, &Change{Type: ChangeDelete, Path: p})
+			}
+		}
+	}
+	for _, p := range localPaths(local) {
+		info := local[p]
+		same, err := sameFile(filepath.Join(root, p), info, uint32(info.Mode().Perm()), remote[p])
+		if err != nil {
+			return nil, err
+		}
+		if !same {
+			changes = append(changes, &Change{Type: ChangeWrite, Path: p})
+		}
+	}
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+
+	commit, err := client.StartCommit(repo, branch)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyChanges(changes, func(change *Change) (retErr error) {
+		remotePath := path.Join(dir, change.Path)
+		if _, ok := remote[change.Path]; ok {
+			if err := client.DeleteFile(repo, commit.ID, remotePath); err != nil {
+				return err
+			}
+		}
+		if change.Type == ChangeDelete {
+			return nil
+		}
+		localPath := filepath.Join(root, change.Path)
+		info := local[change.Path]
+		if (info.Mode() & os.ModeSymlink) > 0 {
+			target, err := os.Readlink(localPath)
+			if err != nil {
+				return err
+			}
+			return client.PutSymlink(repo, commit.ID, remotePath, target)
+		}
+		f, err := os.Open(localPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := f.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		_, err = client.PutFileWithAttrs(repo, commit.ID, remotePath, info.Mode(), info.ModTime(), false, f)
+		return err
+	}); err != nil {
+		// Don't leave a partially synced commit open on the branch
+		client.DeleteCommit(repo, commit.ID)
+		return nil, err
+	}
+	if err := client.FinishCommit(repo, commit.ID); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// Download makes the local directory 'root' match the directory 'dir' in
+// 'commit' of 'repo', downloading only the files whose content, mode or
+// symlink target differ. If 'del' is set, files that aren't in 'dir' are
+// deleted from 'root'. If 'dryRun' is set, nothing is changed. The changes
+// are returned in the order in which they're made.
+func Download(client *pachclient.APIClient, root string, repo, commit, dir string, del, dryRun bool) ([]*Change, error) {
+	dir = path.Join("/", dir)
+	// Resolve 'commit' once, so that all files come from the same commit even
+	// if it's a branch that moves during the sync
+	commitInfo, err := client.InspectCommit(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	commit = commitInfo.Commit.ID
+	remote, err := walkRemote(client, repo, commit, dir)
+	if err != nil {
+		return nil, err
+	}
+	local, err := walkLocal(root)
+	if err != nil {
+		return nil, err
+	}
+	var changes []*Change
+	if del {
+		for _, p := range localPaths(local) {
+			if _, ok := remote[p]; !ok {
+				changes = append(changes, &Change{Type: ChangeDelete, Path: p})
+			}
+		}
+	}
+	for _, p := range remotePaths(remote) {
+		fileInfo := remote[p]
+		same := false
+		if info, ok := local[p]; ok {
+			// Local modes only matter if the file in PFS has one
+			var mode uint32
+			if fileInfo.Mode != 0 {
+				mode = uint32(info.Mode().Perm())
+			}
+			if same, err = sameFile(filepath.Join(root, p), info, mode, fileInfo); err != nil {
+				return nil, err
+			}
+		}
+		if !same {
+			changes = append(changes, &Change{Type: ChangeWrite, Path: p})
+		}
+	}
+	if dryRun {
+		return changes, nil
+	}
+
+	if err := applyChanges(changes, func(change *Change) (retErr error) {
+		localPath := filepath.Join(root, change.Path)
+		if _, ok := local[change.Path]; ok {
+			if err := os.Remove(localPath); err != nil {
+				return err
+			}
+		}
+		if change.Type == ChangeDelete {
+			return nil
+		}
+		fileInfo := remote[change.Path]
+		if fileInfo.FileType == pfs.FileType_SYMLINK {
+			return makeSymlink(localPath, fileInfo.SymlinkTarget)
+		}
+		if err := os.MkdirAll(filepath.Dir(localPath), 0700); err != nil {
+			return err
+		}
+		if err := func() (retErr error) {
+			f, err := os.Create(localPath)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := f.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, f)
+		}(); err != nil {
+			return err
+		}
+		return setAttrs(localPath, fileInfo.Mode, fileInfo.Mtime)
+	}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// applyChanges calls 'f' on each of 'changes' in parallel. All deletions are
+// made before any writes, so that a deleted file can't conflict with a
+// written file's directory.
+func applyChanges(changes []*Change, f func(*Change) error) error {
+	for _, changeType := range []ChangeType{ChangeDelete, ChangeWrite} {
+		limiter := limit.New(syncConcurrency)
+		var eg errgroup.Group
+		for _, change := range changes {
+			if change.Type != changeType {
+				continue
+			}
+			change := change
+			limiter.Acquire()
+			eg.Go(func() error {
+				defer limiter.Release()
+				return f(change)
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkLocal returns the files and symlinks under 'root', keyed by their
+// slash-separated paths relative to 'root'. A 'root' that doesn't exist is
+// treated as empty.
+func walkLocal(root string) (map[string]os.FileInfo, error) {
+	result := make(map[string]os.FileInfo)
+	if _, err := os.Lstat(root); os.IsNotExist(err) {
+		return result, nil
+	}
+	if err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		result[filepath.ToSlash(relPath)] = info
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// walkRemote returns the files and symlinks under the directory 'dir' in
+// 'commit' of 'repo', keyed by their paths relative to 'dir'.
+func walkRemote(client *pachclient.APIClient, repo, commit, dir string) (map[string]*pfs.FileInfo, error) {
+	result := make(map[string]*pfs.FileInfo)
+	if err := client.Walk(repo, commit, dir, func(fileInfo *pfs.FileInfo) error {
+		if fileInfo.File.Path == dir && fileInfo.FileType != pfs.FileType_DIR {
+			return fmt.Errorf("%s is not a directory", dir)
+		}
+		if fileInfo.FileType == pfs.FileType_DIR {
+			return nil
+		}
+		relPath := strings.TrimPrefix(strings.TrimPrefix(fileInfo.File.Path, dir), "/")
+		result[relPath] = fileInfo
+		return nil
+	}); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// sameFile returns whether the local file at 'localPath' matches the file in
+// PFS described by 'fileInfo', which may be nil. Regular files match if the
+// hashtree node they'd be pushed as, with mode 'mode', has the same hash as
+// the node in PFS, and symlinks match if they have the same target.
+func sameFile(localPath string, info os.FileInfo, mode uint32, fileInfo *pfs.FileInfo) (bool, error) {
+	if fileInfo == nil {
+		return false, nil
+	}
+	if (info.Mode() & os.ModeSymlink) > 0 {
+		if fileInfo.FileType != pfs.FileType_SYMLINK {
+			return false, nil
+		}
+		target, err := os.Readlink(localPath)
+		if err != nil {
+			return false, err
+		}
+		return target == fileInfo.SymlinkTarget, nil
+	}
+	if fileInfo.FileType != pfs.FileType_FILE || fileInfo.SizeBytes != uint64(info.Size()) {
+		return false, nil
+	}
+	// Files are pushed as a single object, whose hash is the SHA-512 of the
+	// file's content
+	f, err := os.Open(localPath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	hash := sha512.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return false, err
+	}
+	object := &pfs.Object{Hash: hex.EncodeToString(hash.Sum(nil))}
+	return bytes.Equal(hashtree.FileNodeHash([]*pfs.Object{object}, mode), fileInfo.Hash), nil
+}
+
+// localPaths returns the paths in a result of walkLocal, sorted
+func localPaths(files map[string]os.FileInfo) []string {
+	var result []string
+	for p := range files {
+		result = append(result, p)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// remotePaths returns the paths in a result of walkRemote, sorted
+func remotePaths(files map[string]*pfs.FileInfo) []string {
+	var result []string
+	for p := range files {
+		result = append(result, p)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isNotFoundErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
@@ -0,0 +1,181 @@
+// Package testpachd runs an in-process pachd, for unit tests of code that uses
+// client.APIClient. It serves the PFS and object APIs on an ephemeral port,
+// with auth deactivated, and stores its data in a temporary directory.
+//
+//	p, err := testpachd.New()
+//	if err != nil {
+//		...
+//	}
+//	defer p.Close()
+//	c := p.Client()
+package testpachd
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"google.golang.org/grpc"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/client/version"
+	versionpb "github.com/pachyderm/pachyderm/src/client/version/versionpb"
+	authtesting "github.com/pachyderm/pachyderm/src/server/auth/testing"
+	pfs_server "github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
+)
+
+// Pachd is an in-process pachd.
+type Pachd struct {
+	client         *client.APIClient
+	grpcServer     *grpc.Server
+	apiServer      pfs_server.APIServer
+	blockAPIServer pfs_server.BlockAPIServer
+	// prefix is the prefix of the pachd's keys in its metadata store
+	prefix string
+	// dir holds the pachd's data
+	dir string
+	// closeStore releases the pachd's metadata store
+	closeStore func() error
+}
+
+// New starts a pachd that keeps its metadata in an embedded store (see
+// kv.NewEmbedded), so it doesn't need etcd.
+func New() (_ *Pachd, retErr error) {
+	dir, err := ioutil.TempDir("", "testpachd")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+	store, err := kv.NewEmbedded(filepath.Join(dir, "kv.log"))
+	if err != nil {
+		return nil, err
+	}
+	p, err := newPachd(dir, store, uuid.NewWithoutDashes())
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	p.closeStore = store.Close
+	return p, nil
+}
+
+// NewWithEtcd starts a pachd that uses the etcd server at 'etcdAddress'. Each
+// pachd stores its metadata under its own prefix, which Close deletes, so
+// tests can share an etcd server.
+func NewWithEtcd(etcdAddress string) (_ *Pachd, retErr error) {
+	dir, err := ioutil.TempDir("", "testpachd")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{etcdAddress},
+		DialOptions: client.EtcdDialOptions(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	store := kv.NewEtcdStore(etcdClient)
+	prefix := uuid.NewWithoutDashes()
+	p, err := newPachd(dir, store, prefix)
+	if err != nil {
+		etcdClient.Close()
+		return nil, err
+	}
+	p.closeStore = func() error {
+		_, err := store.Txn(context.Background(), &kv.Txn{
+			Then: []kv.Op{kv.DeletePrefix(prefix + "/")},
+		})
+		if closeErr := etcdClient.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+	return p, nil
+}
+
+func newPachd(dir string, store kv.Store, prefix string) (*Pachd, error) {
+	// Listen first, since the PFS server needs to know its own address to
+	// reach the object API
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, err
+	}
+	address := listener.Addr().String()
+	blockAPIServer, err := pfs_server.NewLocalBlockAPIServer(filepath.Join(dir, "pach"))
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	apiServer, err := pfs_server.NewAPIServerWithStore(address, store, prefix, 0, "", 0)
+	if err != nil {
+		listener.Close()
+		blockAPIServer.Close()
+		return nil, err
+	}
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(grpcutil.MaxMsgSize),
+		grpc.MaxSendMsgSize(grpcutil.MaxMsgSize),
+	)
+	pfs.RegisterAPIServer(grpcServer, apiServer)
+	pfs.RegisterObjectAPIServer(grpcServer, blockAPIServer)
+	auth.RegisterAPIServer(grpcServer, &authtesting.InactiveAPIServer{}) // PFS server uses auth API
+	versionpb.RegisterAPIServer(grpcServer, version.NewAPIServer(version.Version, version.APIServerOptions{}))
+	go grpcServer.Serve(listener)
+
+	c, err := client.NewFromAddress(address)
+	if err != nil {
+		grpcServer.Stop()
+		apiServer.Close()
+		blockAPIServer.Close()
+		return nil, err
+	}
+	return &Pachd{
+		client:         c,
+		grpcServer:     grpcServer,
+		apiServer:      apiServer,
+		blockAPIServer: blockAPIServer,
+		prefix:         prefix,
+		dir:            dir,
+	}, nil
+}
+
+// Client returns a client that's connected to the pachd.
+func (p *Pachd) Client() *client.APIClient {
+	return p.client
+}
+
+// Close stops the pachd and deletes its data.
+func (p *Pachd) Close() error {
+	err := p.client.Close()
+	p.grpcServer.Stop()
+	if closeErr := p.apiServer.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := p.blockAPIServer.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := p.closeStore(); err == nil {
+		err = closeErr
+	}
+	if rmErr := os.RemoveAll(p.dir); err == nil {
+		err = rmErr
+	}
+	return err
+}
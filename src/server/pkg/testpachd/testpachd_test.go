@@ -0,0 +1,66 @@
+package testpachd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	etcd "github.com/coreos/etcd/clientv3"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func testPachd(t *testing.T, p *Pachd) {
+	c := p.Client()
+	require.NoError(t, c.CreateRepo("repo"))
+	_, err := c.StartCommit("repo", "master")
+	require.NoError(t, err)
+	_, err = c.PutFile("repo", "master", "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit("repo", "master"))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile("repo", "master", "file", 0, 0, &buf))
+	require.Equal(t, "foo", buf.String())
+	repoInfos, err := c.ListRepo(nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoInfos))
+}
+
+func TestNewWithEtcd(t *testing.T) {
+	// The pfs tests also expect an etcd server at "localhost:32379"
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{"localhost:32379"},
+		DialOptions: client.EtcdDialOptions(),
+	})
+	require.NoError(t, err)
+	defer etcdClient.Close()
+	countKeys := func(p *Pachd) int64 {
+		resp, err := etcdClient.Get(context.Background(), p.prefix+"/", etcd.WithPrefix(), etcd.WithCountOnly())
+		require.NoError(t, err)
+		return resp.Count
+	}
+
+	p1, err := NewWithEtcd("localhost:32379")
+	require.NoError(t, err)
+	p2, err := NewWithEtcd("localhost:32379")
+	require.NoError(t, err)
+	// Each pachd has its own repos, even though they share etcd
+	testPachd(t, p1)
+	testPachd(t, p2)
+	// Closing a pachd deletes its metadata, and only its metadata
+	require.True(t, countKeys(p1) > 0)
+	require.NoError(t, p1.Close())
+	require.Equal(t, int64(0), countKeys(p1))
+	require.True(t, countKeys(p2) > 0)
+	require.NoError(t, p2.Close())
+	require.Equal(t, int64(0), countKeys(p2))
+}
+
+func TestNew(t *testing.T) {
+	p, err := New()
+	require.NoError(t, err)
+	testPachd(t, p)
+	require.NoError(t, p.Close())
+}
@@ -1,4 +1,5 @@
-// Package watch implements better watch semantics on top of etcd.
+// Package watch implements better watch semantics on top of etcd (or any
+// kv.Store).
 // See this issue for the reasoning behind the package:
 // https://github.com/coreos/etcd/issues/7362
 package watch
@@ -8,6 +9,8 @@ import (
 
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/kv"
 )
 
 // EventType is the type of event
@@ -84,37 +87,46 @@ func (s byModRev) Less(i, j int) bool {
 
 // NewWatcher watches a given etcd prefix for events.
 func NewWatcher(ctx context.Context, client *etcd.Client, prefix string) (Watcher, error) {
-	return newWatcher(ctx, client, prefix, false)
+	return newWatcher(ctx, kv.NewEtcdStore(client), prefix, false)
 }
 
 // NewWatcherWithPrev is like NewWatcher, except that the returned events
 // include the previous version of the values.
 func NewWatcherWithPrev(ctx context.Context, client *etcd.Client, prefix string) (Watcher, error) {
-	return newWatcher(ctx, client, prefix, true)
+	return newWatcher(ctx, kv.NewEtcdStore(client), prefix, true)
+}
+
+// NewStoreWatcher is like NewWatcher, except that it watches a prefix in any
+// kv.Store.
+func NewStoreWatcher(ctx context.Context, store kv.Store, prefix string) (Watcher, error) {
+	return newWatcher(ctx, store, prefix, false)
 }
 
-func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPrev bool) (Watcher, error) {
+// NewStoreWatcherWithPrev is like NewWatcherWithPrev, except that it watches
+// a prefix in any kv.Store.
+func NewStoreWatcherWithPrev(ctx context.Context, store kv.Store, prefix string) (Watcher, error) {
+	return newWatcher(ctx, store, prefix, true)
+}
+
+func newWatcher(ctx context.Context, store kv.Store, prefix string, withPrev bool) (Watcher, error) {
 	eventCh := make(chan *Event)
 	done := make(chan struct{})
 	// Firstly we list the collection to get the current items
 	// Sort them by ascending order because that's how the items would have
 	// been returned if we watched them from the beginning.
-	resp, err := client.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+	resp, err := store.Get(ctx, prefix, kv.GetOptions{Prefix: true, Sort: kv.SortByModRevisionAscend})
 	if err != nil {
 		return nil, err
 	}
 
-	nextRevision := resp.Header.Revision + 1
-	etcdWatcher := etcd.NewWatcher(client)
+	nextRevision := resp.Revision + 1
+	// watchCtx stops the underlying watch when the watcher is closed
+	watchCtx, cancel := context.WithCancel(ctx)
 	// Now we issue a watch that uses the revision timestamp returned by the
 	// Get request earlier.  That way even if some items are added between
 	// when we list the collection and when we start watching the collection,
 	// we won't miss any items.
-	options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
-	if withPrev {
-		options = append(options, etcd.WithPrevKV())
-	}
-	rch := etcdWatcher.Watch(ctx, prefix, options...)
+	rch := store.Watch(watchCtx, prefix, nextRevision, withPrev)
 
 	go func() (retErr error) {
 		defer func() {
@@ -128,18 +140,18 @@ func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPre
 				}
 			}
 			close(eventCh)
-			etcdWatcher.Close()
+			cancel()
 		}()
-		for _, etcdKv := range resp.Kvs {
+		for _, item := range resp.Kvs {
 			eventCh <- &Event{
-				Key:   etcdKv.Key,
-				Value: etcdKv.Value,
+				Key:   item.Key,
+				Value: item.Value,
 				Type:  EventPut,
-				Rev:   etcdKv.ModRevision,
+				Rev:   item.ModRevision,
 			}
 		}
 		for {
-			var resp etcd.WatchResponse
+			var resp kv.WatchResponse
 			var ok bool
 			select {
 			case resp, ok = <-rch:
@@ -147,27 +159,26 @@ func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPre
 				return nil
 			}
 			if !ok {
-				if err := etcdWatcher.Close(); err != nil {
+				if err := watchCtx.Err(); err != nil {
 					return err
 				}
-				etcdWatcher = etcd.NewWatcher(client)
-				rch = etcdWatcher.Watch(ctx, prefix, etcd.WithPrefix(), etcd.WithRev(nextRevision))
+				rch = store.Watch(watchCtx, prefix, nextRevision, withPrev)
 				continue
 			}
-			if err := resp.Err(); err != nil {
-				return err
+			if resp.Err != nil {
+				return resp.Err
 			}
-			for _, etcdEv := range resp.Events {
+			for _, kvEv := range resp.Events {
 				ev := &Event{
-					Key:   etcdEv.Kv.Key,
-					Value: etcdEv.Kv.Value,
-					Rev:   etcdEv.Kv.ModRevision,
+					Key:   kvEv.Kv.Key,
+					Value: kvEv.Kv.Value,
+					Rev:   kvEv.Kv.ModRevision,
 				}
-				if etcdEv.PrevKv != nil {
-					ev.PrevKey = etcdEv.PrevKv.Key
-					ev.PrevValue = etcdEv.PrevKv.Value
+				if kvEv.PrevKv != nil {
+					ev.PrevKey = kvEv.PrevKv.Key
+					ev.PrevValue = kvEv.PrevKv.Value
 				}
-				if etcdEv.Type == etcd.EventTypePut {
+				if kvEv.Type == kv.EventPut {
 					ev.Type = EventPut
 				} else {
 					ev.Type = EventDelete
@@ -178,7 +189,7 @@ func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPre
 					return nil
 				}
 			}
-			nextRevision = resp.Header.Revision + 1
+			nextRevision = resp.Revision + 1
 		}
 	}()
 
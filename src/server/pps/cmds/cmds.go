@@ -89,6 +89,8 @@ The increase the throughput of a job increase the Shard paremeter.
 	rawFlag(inspectJob)
 
 	var pipelineName string
+	var pageSize int64
+	var pageToken string
 	listJob := &cobra.Command{
 		Use:   "list-job [-p pipeline-name] [commits]",
 		Short: "Return info about jobs.",
@@ -107,6 +109,10 @@ $ pachctl list-job foo/XXX bar/YYY
 
 # return all jobs in pipeline foo and whose input commits include bar/YYY
 $ pachctl list-job -p foo bar/YYY
+
+# return the 100 newest jobs, and then the 100 jobs before them
+$ pachctl list-job --page-size 100
+$ pachctl list-job --page-size 100 --page-token XXX
 ` + codeend,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine(metrics, "user")
@@ -119,10 +125,21 @@ $ pachctl list-job -p foo bar/YYY
 				return err
 			}
 
-			jobInfos, err := client.ListJob(pipelineName, commits)
+			var jobInfos []*ppsclient.JobInfo
+			var nextPageToken string
+			if pageSize > 0 || pageToken != "" {
+				jobInfos, nextPageToken, err = client.ListJobPage(pipelineName, commits, pageSize, pageToken)
+			} else {
+				jobInfos, err = client.ListJob(pipelineName, commits)
+			}
 			if err != nil {
 				return sanitizeErr(err)
 			}
+			defer func() {
+				if nextPageToken != "" {
+					fmt.Fprintf(os.Stderr, "There are more jobs; list them with --page-token=%s\n", nextPageToken)
+				}
+			}()
 
 			// Display newest jobs first
 			sort.Sort(sort.Reverse(ByCreationTime(jobInfos)))
@@ -145,6 +162,8 @@ $ pachctl list-job -p foo bar/YYY
 		}),
 	}
 	listJob.Flags().StringVarP(&pipelineName, "pipeline", "p", "", "Limit to jobs made by pipeline.")
+	listJob.Flags().Int64Var(&pageSize, "page-size", 0, "List at most this many jobs, newest first; if set to zero, list all jobs.")
+	listJob.Flags().StringVar(&pageToken, "page-token", "", "List the jobs after the previous page, as printed by the previous list-job.")
 	rawFlag(listJob)
 
 	deleteJob := &cobra.Command{
@@ -205,7 +224,6 @@ $ pachctl list-job -p foo bar/YYY
 			return nil
 		}),
 	}
-	var pageSize int64
 	var page int64
 	listDatum := &cobra.Command{
 		Use:   "list-datum job-id",
@@ -193,6 +193,46 @@ Job Counts:
 	return nil
 }
 
+// PrintRepoStorageHeader prints a repo storage header.
+func PrintRepoStorageHeader(w io.Writer) {
+	fmt.Fprint(w, "REPO\tSIZE\tRECLAIMABLE\t\n")
+}
+
+// PrintRepoStorage pretty-prints the storage used by a repo.
+func PrintRepoStorage(w io.Writer, repoStorage *ppsclient.RepoStorage) {
+	fmt.Fprintf(w, "%s\t", repoStorage.Repo.Name)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(repoStorage.SizeBytes)))
+	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(repoStorage.ExclusiveBytes)))
+}
+
+// PrintCommitStorageHeader prints a commit storage header.
+func PrintCommitStorageHeader(w io.Writer) {
+	fmt.Fprint(w, "REPO\tCOMMIT\tSIZE\tEXCLUSIVE\tSHARED\t\n")
+}
+
+// PrintCommitStorage pretty-prints the storage used by a commit.
+func PrintCommitStorage(w io.Writer, commitStorage *ppsclient.CommitStorage) {
+	fmt.Fprintf(w, "%s\t", commitStorage.Commit.Repo.Name)
+	fmt.Fprintf(w, "%s\t", commitStorage.Commit.ID)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(commitStorage.SizeBytes)))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(commitStorage.ExclusiveBytes)))
+	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(commitStorage.SharedBytes)))
+}
+
+// PrintPipelineStorageHeader prints a pipeline storage header.
+func PrintPipelineStorageHeader(w io.Writer) {
+	fmt.Fprint(w, "PIPELINE\tTAGS\tSIZE\tRECLAIMABLE\t\n")
+}
+
+// PrintPipelineStorage pretty-prints the storage used by a pipeline's datum
+// tags.
+func PrintPipelineStorage(w io.Writer, pipelineStorage *ppsclient.PipelineStorage) {
+	fmt.Fprintf(w, "%s\t", pipelineStorage.Pipeline.Name)
+	fmt.Fprintf(w, "%d\t", pipelineStorage.Tags)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(pipelineStorage.SizeBytes)))
+	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(pipelineStorage.ExclusiveBytes)))
+}
+
 // PrintDatumInfoHeader prints a file info header.
 func PrintDatumInfoHeader(w io.Writer) {
 	fmt.Fprint(w, "ID\tSTATUS\tTIME\t\n")
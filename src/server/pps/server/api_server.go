@@ -11,18 +11,18 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
-	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
-	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
@@ -36,6 +36,8 @@ import (
 	"github.com/gogo/protobuf/types"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"golang.org/x/sync/errgroup"
 
@@ -108,11 +110,20 @@ type apiServer struct {
 	workerImagePullPolicy string
 	storageRoot           string
 	storageBackend        string
+	storageMirrorURL      string
 	storageHostPath       string
-	reporter              *metrics.Reporter
+	// workerHotTierBytes is the size of the hot tier that worker sidecars
+	// keep in front of the object store; if empty, they don't keep one
+	workerHotTierBytes string
+	reporter           *metrics.Reporter
 	// collections
 	pipelines col.Collection
 	jobs      col.Collection
+	// The objects and tags that garbage collection has found to be
+	// unreferenced
+	gcObjectCandidates col.Collection
+	gcTagCandidates    col.Collection
+	gcCheckpoints      col.Collection
 }
 
 func merge(from, to map[string]bool) {
@@ -426,7 +437,7 @@ func (a *apiServer) ListJob(ctx context.Context, request *pps.ListJobRequest) (r
 	defer func(start time.Time) {
 		if response != nil && len(response.JobInfo) > client.MaxListItemsLog {
 			logrus.Infof("Response contains %d objects; logging the first %d", len(response.JobInfo), client.MaxListItemsLog)
-			a.Log(request, &pps.JobInfos{response.JobInfo[:client.MaxListItemsLog]}, retErr, time.Since(start))
+			a.Log(request, &pps.JobInfos{JobInfo: response.JobInfo[:client.MaxListItemsLog]}, retErr, time.Since(start))
 		} else {
 			a.Log(request, response, retErr, time.Since(start))
 		}
@@ -434,8 +445,29 @@ func (a *apiServer) ListJob(ctx context.Context, request *pps.ListJobRequest) (r
 
 	jobs := a.jobs.ReadOnly(ctx)
 	var iter col.Iterator
+	// pages is set if the jobs are listed in pages, and gives the token of
+	// each job that's listed
+	var pages col.ListIterator
 	var err error
-	if request.Pipeline != nil {
+	if request.PageSize > 0 || request.PageToken != "" {
+		// Pages are sorted by creation time, which, unlike modification
+		// time, doesn't change as jobs run
+		opts := col.ListOptions{
+			Target:    col.SortByCreateRevision,
+			PageToken: request.PageToken,
+		}
+		if request.PageSize > 0 {
+			// Read one more job than the page holds, to find out if there's
+			// a next page
+			opts.Limit = request.PageSize + 1
+		}
+		if request.Pipeline != nil {
+			opts.Index = &ppsdb.JobsPipelineIndex
+			opts.IndexVal = request.Pipeline
+		}
+		pages, err = jobs.ListWithOptions(opts)
+		iter = pages
+	} else if request.Pipeline != nil {
 		iter, err = jobs.GetByIndex(ppsdb.JobsPipelineIndex, request.Pipeline)
 	} else {
 		iter, err = jobs.List()
@@ -445,6 +477,8 @@ func (a *apiServer) ListJob(ctx context.Context, request *pps.ListJobRequest) (r
 	}
 
 	var jobInfos []*pps.JobInfo
+	// lastToken continues the listing after the last job in jobInfos
+	var lastToken string
 	for {
 		var jobID string
 		var jobInfo pps.JobInfo
@@ -455,13 +489,22 @@ func (a *apiServer) ListJob(ctx context.Context, request *pps.ListJobRequest) (r
 		if !ok {
 			break
 		}
+		if request.PageSize > 0 && int64(len(jobInfos)) == request.PageSize {
+			return &pps.JobInfos{
+				JobInfo:       jobInfos,
+				NextPageToken: lastToken,
+			}, nil
+		}
 		if jobInfo.Input == nil {
 			jobInfo.Input = translateJobInputs(jobInfo.Inputs)
 		}
 		jobInfos = append(jobInfos, &jobInfo)
+		if pages != nil {
+			lastToken = pages.PageToken()
+		}
 	}
 
-	return &pps.JobInfos{jobInfos}, nil
+	return &pps.JobInfos{JobInfo: jobInfos}, nil
 }
 
 func (a *apiServer) DeleteJob(ctx context.Context, request *pps.DeleteJobRequest) (response *types.Empty, retErr error) {
@@ -1272,7 +1315,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if err := a.authorizeModifyPipeline(ctx, modification, pipelineInfo); err != nil {
 		return nil, err
 	}
-	capabilityResp, err := authClient.GetCapability(auth.In2Out(ctx), &auth.GetCapabilityRequest{})
+	capabilityResp, err := authClient.GetCapability(auth.In2Out(ctx), &auth.GetCapabilityRequest{
+		Pipeline: pipelineInfo.Pipeline.Name,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("error getting capability for the user: %v", err)
 	}
@@ -1675,6 +1720,14 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 	return &types.Empty{}, err
 }
 
+// GarbageCollect deletes the objects and tags that aren't referenced by any
+// commit or pipeline. Active objects are marked in a Bloom filter, so that
+// the memory it takes is bounded, and unreferenced objects and tags are only
+// deleted once they've been unreferenced for request.GracePeriod, so that it
+// can run while data is being added. Objects that are put again while garbage
+// collection runs, and objects that were found to be unreferenced after the
+// oldest open commit was started, aren't deleted. The mark phase is
+// checkpointed, so that a run that doesn't finish is resumed by the next one.
 func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageCollectRequest) (response *pps.GarbageCollectResponse, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -1686,47 +1739,64 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 	pfsClient := pachClient.PfsAPIClient
 	objClient := pachClient.ObjectAPIClient
 
-	// The set of objects that are in use.
-	activeObjects := make(map[string]bool)
-	var activeObjectsMu sync.Mutex
+	var gracePeriod time.Duration
+	if request.GracePeriod != nil {
+		gracePeriod, err = types.DurationFromProto(request.GracePeriod)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Compaction moves objects into new blocks and deletes the old ones, so
+	// it mustn't run while objects are being deleted
+	lock := dlock.NewDLock(a.etcdClient, client.CompactionLockKey)
+	ctx, err = lock.Lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	// The objects and tags that were already unreferenced, which we load
+	// before marking anything so that objects that are written while we
+	// mark are never deleted by this run
+	objectSweeper, err := newGCSweeper(ctx, a.gcObjectCandidates, gracePeriod)
+	if err != nil {
+		return nil, err
+	}
+	tagSweeper, err := newGCSweeper(ctx, a.gcTagCandidates, gracePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	// The set of objects that are in use. If the last run's mark phase
+	// didn't finish, it's resumed.
+	activeObjects := newBloomFilter(gcFilterBits, gcFilterHashes)
+	checkpoint, err := a.loadGCCheckpoint(ctx, objClient, activeObjects)
+	if err != nil {
+		return nil, err
+	}
+	markStarted, err := types.TimestampFromProto(checkpoint.Started)
+	if err != nil {
+		return nil, err
+	}
+	markedRepos := make(map[string]bool)
+	for _, repo := range checkpoint.Repos {
+		markedRepos[repo] = true
+	}
 	// A helper function for adding active objects in a thread-safe way
 	addActiveObjects := func(objects ...*pfs.Object) {
-		activeObjectsMu.Lock()
-		defer activeObjectsMu.Unlock()
 		for _, object := range objects {
 			if object != nil {
-				activeObjects[object.Hash] = true
+				activeObjects.add(object.Hash)
 			}
 		}
 	}
 	// A helper function for adding objects that are actually hash trees,
 	// which in turn contain active objects.
 	addActiveTree := func(object *pfs.Object) error {
-		if object == nil {
-			return nil
-		}
-		addActiveObjects(object)
-		getObjectClient, err := objClient.GetObject(ctx, object)
-		if err != nil {
-			return fmt.Errorf("error getting commit tree: %v", err)
-		}
-
-		var buf bytes.Buffer
-		if err := grpcutil.WriteFromStreamingBytesClient(getObjectClient, &buf); err != nil {
-			return fmt.Errorf("error reading commit tree: %v", err)
-		}
-
-		tree, err := hashtree.Deserialize(buf.Bytes())
-		if err != nil {
-			return err
-		}
-
-		return tree.Walk(func(path string, node *hashtree.NodeProto) error {
-			if node.FileNode != nil {
-				addActiveObjects(node.FileNode.Objects...)
-			}
-			return nil
-		})
+		return walkTreeObjects(ctx, objClient, object, addActiveObjects)
 	}
 
 	// Get all repos
@@ -1735,9 +1805,14 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 		return nil, err
 	}
 
-	// Get all commit trees
+	// Get all commit trees. Open commits don't have trees yet, so instead
+	// the objects that they may reference are protected (see
+	// gcSweeper.protectedSince).
+	var oldestOpenCommit time.Time
 	limiter := limit.New(100)
 	var eg errgroup.Group
+	var marking []string
+	lastCheckpoint := time.Now()
 	for _, repo := range repoInfos.RepoInfo {
 		repo := repo
 		commitInfos, err := pfsClient.ListCommit(ctx, &pfs.ListCommitRequest{
@@ -1748,16 +1823,47 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 		}
 		for _, commit := range commitInfos.CommitInfo {
 			commit := commit
+			if commit.Finished == nil {
+				started, err := types.TimestampFromProto(commit.Started)
+				if err != nil {
+					return nil, err
+				}
+				if oldestOpenCommit.IsZero() || started.Before(oldestOpenCommit) {
+					oldestOpenCommit = started
+				}
+				continue
+			}
+			if markedRepos[repo.Repo.Name] {
+				continue
+			}
 			limiter.Acquire()
 			eg.Go(func() error {
 				defer limiter.Release()
 				return addActiveTree(commit.Tree)
 			})
 		}
+		if markedRepos[repo.Repo.Name] {
+			continue
+		}
+		marking = append(marking, repo.Repo.Name)
+		if time.Since(lastCheckpoint) > gcCheckpointInterval {
+			if err := eg.Wait(); err != nil {
+				return nil, err
+			}
+			checkpoint.Repos = append(checkpoint.Repos, marking...)
+			marking = nil
+			if err := a.saveGCCheckpoint(ctx, pachClient, checkpoint, activeObjects); err != nil {
+				return nil, err
+			}
+			lastCheckpoint = time.Now()
+		}
 	}
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
+	objectSweeper.protectedSince = oldestOpenCommit
+	// The checkpoint's filter is in use until this run finishes
+	addActiveObjects(checkpoint.Filter)
 
 	// Get all objects referenced by pipeline tags
 	pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
@@ -1765,9 +1871,10 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 		return nil, err
 	}
 
-	// The set of tags that are active
-	activeTags := make(map[string]bool)
+	// The prefixes of the tags that are active
+	var activeTagPrefixes []string
 	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		activeTagPrefixes = append(activeTagPrefixes, client.DatumTagPrefix(pipelineInfo.Salt))
 		tags, err := objClient.ListTags(ctx, &pfs.ListTagsRequest{
 			Prefix:        client.DatumTagPrefix(pipelineInfo.Salt),
 			IncludeObject: true,
@@ -1781,7 +1888,6 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 			if err != nil {
 				return nil, err
 			}
-			activeTags[resp.Tag] = true
 			limiter.Acquire()
 			eg.Go(func() error {
 				defer limiter.Release()
@@ -1792,7 +1898,29 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
+	isActiveTag := func(tag string) bool {
+		for _, prefix := range activeTagPrefixes {
+			if strings.HasPrefix(tag, prefix) {
+				return true
+			}
+		}
+		return false
+	}
 
+	response = &pps.GarbageCollectResponse{}
+	// Objects that are put again while they're being swept aren't deleted
+	var barrier *gcBarrier
+	if !request.DryRun {
+		barrier, err = newGCBarrier(ctx, a.etcdClient)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := barrier.close(ctx); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+	}
 	// Iterate through all objects.  If they are not active, delete them.
 	objects, err := objClient.ListObjects(ctx, &pfs.ListObjectsRequest{})
 	if err != nil {
@@ -1802,10 +1930,57 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 	var objectsToDelete []*pfs.Object
 	deleteObjectsIfMoreThan := func(n int) error {
 		if len(objectsToDelete) > n {
-			if _, err := objClient.DeleteObjects(ctx, &pfs.DeleteObjectsRequest{
-				Objects: objectsToDelete,
-			}); err != nil {
-				return fmt.Errorf("error deleting objects: %v", err)
+			batch := objectsToDelete
+			if barrier != nil {
+				var err error
+				objectsToDelete, _, err = barrier.deleting(ctx, batch)
+				if err != nil {
+					return fmt.Errorf("error marking objects for deletion: %v", err)
+				}
+			}
+			if request.DryRun {
+				// Nothing is deleted, so report how much space the objects
+				// take up
+				var found, bytes int64
+				var eg errgroup.Group
+				for _, object := range objectsToDelete {
+					object := object
+					limiter.Acquire()
+					eg.Go(func() error {
+						defer limiter.Release()
+						objectInfo, err := objClient.InspectObject(ctx, object)
+						if err != nil {
+							if grpc.Code(err) == codes.NotFound {
+								return nil
+							}
+							return err
+						}
+						atomic.AddInt64(&found, 1)
+						if objectInfo.BlockRef != nil && objectInfo.BlockRef.Range != nil {
+							atomic.AddInt64(&bytes, int64(objectInfo.BlockRef.Range.Upper-objectInfo.BlockRef.Range.Lower))
+						}
+						return nil
+					})
+				}
+				if err := eg.Wait(); err != nil {
+					return fmt.Errorf("error inspecting objects: %v", err)
+				}
+				response.ObjectsDeleted += found
+				response.BytesReclaimed += bytes
+			} else if len(objectsToDelete) > 0 {
+				resp, err := objClient.DeleteObjects(ctx, &pfs.DeleteObjectsRequest{
+					Objects: objectsToDelete,
+				})
+				if err != nil {
+					return fmt.Errorf("error deleting objects: %v", err)
+				}
+				response.ObjectsDeleted += int64(len(resp.Objects))
+				response.BytesReclaimed += int64(resp.Bytes)
+			}
+			if barrier != nil {
+				if err := barrier.done(ctx, batch); err != nil {
+					return err
+				}
 			}
 			objectsToDelete = []*pfs.Object{}
 		}
@@ -1815,7 +1990,9 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 		if err != nil {
 			return nil, fmt.Errorf("error receiving objects from ListObjects: %v", err)
 		}
-		if !activeObjects[object.Hash] {
+		if activeObjects.has(object.Hash) {
+			objectSweeper.referenced(object.Hash)
+		} else if objectSweeper.unreferenced(object.Hash) {
 			objectsToDelete = append(objectsToDelete, object)
 		}
 		// Delete objects in batches
@@ -1826,6 +2003,7 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 	if err := deleteObjectsIfMoreThan(0); err != nil {
 		return nil, err
 	}
+	response.ObjectsPending = objectSweeper.pending
 
 	// Iterate through all tags.  If they are not active, delete them
 	tags, err := objClient.ListTags(ctx, &pfs.ListTagsRequest{})
@@ -1835,11 +2013,14 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 	var tagsToDelete []string
 	deleteTagsIfMoreThan := func(n int) error {
 		if len(tagsToDelete) > n {
-			if _, err := objClient.DeleteTags(ctx, &pfs.DeleteTagsRequest{
-				Tags: tagsToDelete,
-			}); err != nil {
-				return fmt.Errorf("error deleting tags: %v", err)
+			if !request.DryRun {
+				if _, err := objClient.DeleteTags(ctx, &pfs.DeleteTagsRequest{
+					Tags: tagsToDelete,
+				}); err != nil {
+					return fmt.Errorf("error deleting tags: %v", err)
+				}
 			}
+			response.TagsDeleted += int64(len(tagsToDelete))
 			tagsToDelete = []string{}
 		}
 		return nil
@@ -1848,7 +2029,9 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 		if err != nil {
 			return nil, fmt.Errorf("error receiving tags from ListTags: %v", err)
 		}
-		if !activeTags[resp.Tag] {
+		if isActiveTag(resp.Tag) {
+			tagSweeper.referenced(resp.Tag)
+		} else if tagSweeper.unreferenced(resp.Tag) {
 			tagsToDelete = append(tagsToDelete, resp.Tag)
 		}
 		if err := deleteTagsIfMoreThan(100); err != nil {
@@ -1858,12 +2041,316 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 	if err := deleteTagsIfMoreThan(0); err != nil {
 		return nil, err
 	}
+	response.TagsPending = tagSweeper.pending
 
+	// The mark phase has finished, so it doesn't need to be resumed
+	if err := a.clearGCCheckpoint(ctx); err != nil {
+		return nil, err
+	}
+	if request.DryRun {
+		return response, nil
+	}
+	// Persist the candidates for the next run
+	if err := objectSweeper.finish(ctx, a.etcdClient); err != nil {
+		return nil, err
+	}
+	if err := tagSweeper.finish(ctx, a.etcdClient); err != nil {
+		return nil, err
+	}
+	// Everything that was referenced before marking started, or before the
+	// oldest open commit was started, has been marked, so the objects that
+	// were touched before then needn't be protected any more
+	touchedBefore := markStarted
+	if !oldestOpenCommit.IsZero() && oldestOpenCommit.Before(touchedBefore) {
+		touchedBefore = oldestOpenCommit
+	}
+	if err := clearTouched(ctx, a.etcdClient, touchedBefore); err != nil {
+		return nil, err
+	}
 	if err := a.incrementGCGeneration(ctx); err != nil {
 		return nil, err
 	}
 
-	return &pps.GarbageCollectResponse{}, nil
+	return response, nil
+}
+
+// loadGCCheckpoint reads the checkpoint of the last run's mark phase, if
+// there is one, and sets 'activeObjects' to the objects that it marked.
+// Otherwise, it returns a new checkpoint.
+func (a *apiServer) loadGCCheckpoint(ctx context.Context, objClient pfs.ObjectAPIClient, activeObjects *bloomFilter) (*pps.GCCheckpoint, error) {
+	checkpoint := &pps.GCCheckpoint{}
+	err := a.gcCheckpoints.ReadOnly(ctx).Get(gcCheckpointKey, checkpoint)
+	if err == nil {
+		var data []byte
+		data, err = readObject(ctx, objClient, checkpoint.Filter)
+		if err == nil {
+			err = activeObjects.unmarshal(data)
+		}
+		if err == nil {
+			return checkpoint, nil
+		}
+		logrus.Errorf("could not resume garbage collection from its checkpoint: %v; starting over", err)
+	} else if !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	checkpoint = &pps.GCCheckpoint{}
+	checkpoint.Started, err = types.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+// saveGCCheckpoint stores 'activeObjects' and records 'checkpoint', so that
+// the mark phase can be resumed from it.
+func (a *apiServer) saveGCCheckpoint(ctx context.Context, pachClient *client.APIClient, checkpoint *pps.GCCheckpoint, activeObjects *bloomFilter) error {
+	filter, _, err := pachClient.PutObject(bytes.NewReader(activeObjects.marshal()))
+	if err != nil {
+		return err
+	}
+	checkpoint.Filter = filter
+	_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		return a.gcCheckpoints.ReadWrite(stm).Put(gcCheckpointKey, checkpoint)
+	})
+	return err
+}
+
+// clearGCCheckpoint deletes the checkpoint of the mark phase, if there is one.
+func (a *apiServer) clearGCCheckpoint(ctx context.Context) error {
+	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if err := a.gcCheckpoints.ReadWrite(stm).Delete(gcCheckpointKey); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return nil
+	})
+	return err
+}
+
+// objectUsage records what references an object, for StorageReport.
+type objectUsage struct {
+	size int64
+	// commits is the number of commits that reference the object
+	commits int
+	// repo and pipeline are the repo and pipeline that (one of) the commits
+	// and tags that reference the object belong to
+	repo              string
+	multipleRepos     bool
+	pipeline          string
+	multiplePipelines bool
+}
+
+// StorageReport attributes the objects in object storage to the repos,
+// commits and pipelines that reference them.
+func (a *apiServer) StorageReport(ctx context.Context, request *pps.StorageReportRequest) (response *pps.StorageReportResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	pfsClient := pachClient.PfsAPIClient
+	objClient := pachClient.ObjectAPIClient
+
+	usage := make(map[string]*objectUsage)
+	var usageMu sync.Mutex
+	// treeObjects returns the distinct objects that make up 'tree', and that
+	// its files refer to
+	treeObjects := func(tree *pfs.Object) ([]string, error) {
+		objects := make(map[string]bool)
+		if err := walkTreeObjects(ctx, objClient, tree, func(treeObjects ...*pfs.Object) {
+			for _, object := range treeObjects {
+				if object != nil {
+					objects[object.Hash] = true
+				}
+			}
+		}); err != nil {
+			return nil, err
+		}
+		var result []string
+		for object := range objects {
+			result = append(result, object)
+		}
+		return result, nil
+	}
+	getUsage := func(object string) *objectUsage {
+		u, ok := usage[object]
+		if !ok {
+			u = &objectUsage{}
+			usage[object] = u
+		}
+		return u
+	}
+
+	// Find the objects that each commit references
+	repoInfos, err := pfsClient.ListRepo(ctx, &pfs.ListRepoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	limiter := limit.New(100)
+	var eg errgroup.Group
+	response = &pps.StorageReportResponse{}
+	// commitObjects holds the objects that each of the commits in 'response'
+	// references, in the same order
+	var commitObjects [][][]string
+	for _, repoInfo := range repoInfos.RepoInfo {
+		repo := repoInfo.Repo
+		commitInfos, err := pfsClient.ListCommit(ctx, &pfs.ListCommitRequest{
+			Repo: repo,
+		})
+		if err != nil {
+			return nil, err
+		}
+		repoStorage := &pps.RepoStorage{Repo: repo}
+		repoObjects := make([][]string, len(commitInfos.CommitInfo))
+		for i, commitInfo := range commitInfos.CommitInfo {
+			i, commitInfo := i, commitInfo
+			repoStorage.Commits = append(repoStorage.Commits, &pps.CommitStorage{Commit: commitInfo.Commit})
+			limiter.Acquire()
+			eg.Go(func() error {
+				defer limiter.Release()
+				objects, err := treeObjects(commitInfo.Tree)
+				if err != nil {
+					return err
+				}
+				usageMu.Lock()
+				defer usageMu.Unlock()
+				repoObjects[i] = objects
+				for _, object := range objects {
+					u := getUsage(object)
+					u.commits++
+					if u.repo == "" {
+						u.repo = repo.Name
+					} else if u.repo != repo.Name {
+						u.multipleRepos = true
+					}
+				}
+				return nil
+			})
+		}
+		response.Repos = append(response.Repos, repoStorage)
+		commitObjects = append(commitObjects, repoObjects)
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Find the objects that each pipeline's datum tags reference
+	pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
+	if err != nil {
+		return nil, err
+	}
+	pipelineObjects := make([]map[string]bool, len(pipelineInfos.PipelineInfo))
+	for i, pipelineInfo := range pipelineInfos.PipelineInfo {
+		pipeline := pipelineInfo.Pipeline
+		pipelineStorage := &pps.PipelineStorage{Pipeline: pipeline}
+		response.Pipelines = append(response.Pipelines, pipelineStorage)
+		objects := make(map[string]bool)
+		pipelineObjects[i] = objects
+		tags, err := objClient.ListTags(ctx, &pfs.ListTagsRequest{
+			Prefix:        client.DatumTagPrefix(pipelineInfo.Salt),
+			IncludeObject: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing tagged objects: %v", err)
+		}
+		for resp, err := tags.Recv(); err != io.EOF; resp, err = tags.Recv() {
+			resp := resp
+			if err != nil {
+				return nil, err
+			}
+			if resp.Object == nil {
+				pipelineStorage.Tags++
+				continue
+			}
+			limiter.Acquire()
+			eg.Go(func() error {
+				defer limiter.Release()
+				tagObjects, err := treeObjects(resp.Object)
+				if err != nil {
+					return err
+				}
+				usageMu.Lock()
+				defer usageMu.Unlock()
+				for _, object := range tagObjects {
+					if objects[object] {
+						continue
+					}
+					objects[object] = true
+					u := getUsage(object)
+					if u.pipeline == "" {
+						u.pipeline = pipeline.Name
+					} else if u.pipeline != pipeline.Name {
+						u.multiplePipelines = true
+					}
+				}
+				return nil
+			})
+		}
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Find out how big each object is
+	for object, u := range usage {
+		object, u := object, u
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			objectInfo, err := objClient.InspectObject(ctx, &pfs.Object{Hash: object})
+			if err != nil {
+				if strings.Contains(err.Error(), "not found") {
+					return nil
+				}
+				return err
+			}
+			if objectInfo.BlockRef != nil && objectInfo.BlockRef.Range != nil {
+				u.size = int64(objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Add up the sizes of the objects. Objects are exclusive to a commit,
+	// repo or pipeline if nothing else references them.
+	for i, repoStorage := range response.Repos {
+		repoObjects := make(map[string]bool)
+		for j, commitStorage := range repoStorage.Commits {
+			for _, object := range commitObjects[i][j] {
+				u := usage[object]
+				commitStorage.SizeBytes += u.size
+				if u.commits == 1 && u.pipeline == "" {
+					commitStorage.ExclusiveBytes += u.size
+				}
+				if repoObjects[object] {
+					continue
+				}
+				repoObjects[object] = true
+				repoStorage.SizeBytes += u.size
+				if !u.multipleRepos && u.pipeline == "" {
+					repoStorage.ExclusiveBytes += u.size
+				}
+			}
+			commitStorage.SharedBytes = commitStorage.SizeBytes - commitStorage.ExclusiveBytes
+		}
+	}
+	for i, pipelineStorage := range response.Pipelines {
+		for object := range pipelineObjects[i] {
+			u := usage[object]
+			pipelineStorage.SizeBytes += u.size
+			if u.commits == 0 && !u.multiplePipelines {
+				pipelineStorage.ExclusiveBytes += u.size
+			}
+		}
+	}
+	for _, u := range usage {
+		response.SizeBytes += u.size
+	}
+	return response, nil
 }
 
 // incrementGCGeneration increments the GC generation number in etcd
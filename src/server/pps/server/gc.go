@@ -0,0 +1,414 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"golang.org/x/net/context"
+)
+
+const (
+	// gcFilterBits and gcFilterHashes size the Bloom filter that garbage
+	// collection marks active objects in. 2^28 bits (32MB) keeps the false
+	// positive rate under 1% for up to ~25 million objects.
+	gcFilterBits   = 1 << 28
+	gcFilterHashes = 7
+	// maxGCCandidates bounds the number of unreferenced objects (and tags)
+	// that garbage collection keeps track of, so that a single run does a
+	// bounded amount of work. Anything beyond it is found by a later run.
+	maxGCCandidates = 100000
+	// gcCandidateBatchSize is the number of candidates that are written to
+	// etcd per transaction.
+	gcCandidateBatchSize = 100
+	// gcCheckpointInterval is how often garbage collection checkpoints its
+	// mark phase.
+	gcCheckpointInterval = 5 * time.Minute
+	// gcCheckpointKey is the key of the mark phase's checkpoint.
+	gcCheckpointKey = "mark"
+	// gcDeletingTTL is the TTL, in seconds, of the lease that the keys which
+	// garbage collection writes for the objects it's deleting are attached
+	// to, so that they go away if garbage collection dies.
+	gcDeletingTTL = 30
+)
+
+// bloomFilter is a thread-safe Bloom filter of strings. Garbage collection
+// uses it to hold the set of active objects in a fixed amount of memory; a
+// false positive only means that some garbage survives until a later run.
+type bloomFilter struct {
+	mu     sync.Mutex
+	bits   []uint64
+	hashes uint64
+}
+
+func newBloomFilter(bits uint64, hashes uint64) *bloomFilter {
+	return &bloomFilter{
+		bits:   make([]uint64, (bits+63)/64),
+		hashes: hashes,
+	}
+}
+
+// locations calls 'fn' with the index of each of the bits that represent 'key',
+// until it returns false. It uses double hashing to derive all of them from a
+// single hash.
+func (f *bloomFilter) locations(key string, fn func(i uint64) bool) bool {
+	sum := sha256.Sum256([]byte(key))
+	h1 := binary.BigEndian.Uint64(sum[0:8])
+	h2 := binary.BigEndian.Uint64(sum[8:16])
+	n := uint64(len(f.bits)) * 64
+	for i := uint64(0); i < f.hashes; i++ {
+		if !fn((h1 + i*h2) % n) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *bloomFilter) add(key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.locations(key, func(i uint64) bool {
+		f.bits[i/64] |= 1 << (i % 64)
+		return true
+	})
+}
+
+// has returns true if 'key' may have been added to 'f', and false if it
+// definitely hasn't been.
+func (f *bloomFilter) has(key string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.locations(key, func(i uint64) bool {
+		return f.bits[i/64]&(1<<(i%64)) != 0
+	})
+}
+
+// marshal returns the bits of 'f'.
+func (f *bloomFilter) marshal() []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data := make([]byte, 8*len(f.bits))
+	for i, word := range f.bits {
+		binary.BigEndian.PutUint64(data[8*i:], word)
+	}
+	return data
+}
+
+// unmarshal sets the bits of 'f' to 'data', which must have been returned by
+// marshal() for a filter of the same size.
+func (f *bloomFilter) unmarshal(data []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(data) != 8*len(f.bits) {
+		return fmt.Errorf("Bloom filter has %d bytes, but expected %d", len(data), 8*len(f.bits))
+	}
+	for i := range f.bits {
+		f.bits[i] = binary.BigEndian.Uint64(data[8*i:])
+	}
+	return nil
+}
+
+// readObject reads the whole of 'object'.
+func readObject(ctx context.Context, objClient pfs.ObjectAPIClient, object *pfs.Object) ([]byte, error) {
+	getObjectClient, err := objClient.GetObject(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := grpcutil.WriteFromStreamingBytesClient(getObjectClient, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// walkTreeObjects calls 'f' with the objects that make up the hash tree
+// stored in 'tree' (including 'tree' itself), and with the objects that its
+// files refer to.
+func walkTreeObjects(ctx context.Context, objClient pfs.ObjectAPIClient, tree *pfs.Object, f func(objects ...*pfs.Object)) error {
+	if tree == nil {
+		return nil
+	}
+	f(tree)
+	getObject := func(object *pfs.Object) ([]byte, error) {
+		data, err := readObject(ctx, objClient, object)
+		if err != nil {
+			return nil, fmt.Errorf("error reading commit tree: %v", err)
+		}
+		return data, nil
+	}
+	data, err := getObject(tree)
+	if err != nil {
+		return err
+	}
+
+	// The shards of a sharded tree are objects too
+	shards, err := hashtree.Shards(data)
+	if err != nil {
+		return err
+	}
+	f(shards...)
+
+	t, err := hashtree.DeserializeSharded(data, getObject)
+	if err != nil {
+		return err
+	}
+	return t.Walk(func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode != nil {
+			f(node.FileNode.Objects...)
+		}
+		return nil
+	})
+}
+
+// gcSweeper decides which unreferenced objects (or tags) garbage collection
+// deletes. Unreferenced objects become candidates for deletion, which are
+// persisted in etcd, and are deleted once they've been unreferenced for the
+// grace period.
+type gcSweeper struct {
+	candidates  col.Collection
+	now         time.Time
+	gracePeriod time.Duration
+	// since holds the existing candidates, and when they were first found to
+	// be unreferenced
+	since map[string]time.Time
+	seen  map[string]bool
+	// added and removed are the changes to the candidates
+	added   []string
+	removed []string
+	pending int64
+	// protectedSince, if it's set, is when the oldest open commit was
+	// started. Candidates that were found after it may be referenced by that
+	// commit, so they aren't deleted.
+	protectedSince time.Time
+}
+
+func newGCSweeper(ctx context.Context, candidates col.Collection, gracePeriod time.Duration) (*gcSweeper, error) {
+	s := &gcSweeper{
+		candidates:  candidates,
+		now:         time.Now(),
+		gracePeriod: gracePeriod,
+		since:       make(map[string]time.Time),
+		seen:        make(map[string]bool),
+	}
+	iter, err := candidates.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	var key string
+	candidate := &pps.GCCandidate{}
+	for {
+		ok, err := iter.Next(&key, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		since, err := types.TimestampFromProto(candidate.UnreferencedSince)
+		if err != nil {
+			return nil, err
+		}
+		s.since[key] = since
+	}
+	return s, nil
+}
+
+// referenced records that 'key' is in use.
+func (s *gcSweeper) referenced(key string) {
+	s.forget(key)
+}
+
+// forget removes 'key' from the candidates, if it's one of them.
+func (s *gcSweeper) forget(key string) {
+	if _, ok := s.since[key]; ok {
+		s.seen[key] = true
+		s.removed = append(s.removed, key)
+	}
+}
+
+// unreferenced records that 'key' isn't in use, and returns true if it
+// should be deleted.
+func (s *gcSweeper) unreferenced(key string) bool {
+	if s.gracePeriod == 0 {
+		s.forget(key)
+		return true
+	}
+	since, ok := s.since[key]
+	if !ok {
+		if len(s.since)+len(s.added) < maxGCCandidates {
+			s.added = append(s.added, key)
+		}
+		s.pending++
+		return false
+	}
+	if s.now.Sub(since) < s.gracePeriod ||
+		(!s.protectedSince.IsZero() && !since.Before(s.protectedSince)) {
+		s.seen[key] = true
+		s.pending++
+		return false
+	}
+	s.forget(key)
+	return true
+}
+
+// finish persists the changes to the candidates. Candidates that no longer
+// exist are removed too.
+func (s *gcSweeper) finish(ctx context.Context, etcdClient *etcd.Client) error {
+	for key := range s.since {
+		if !s.seen[key] {
+			s.removed = append(s.removed, key)
+		}
+	}
+	// New candidates are timestamped now rather than when the run started,
+	// since objects that were written during the run are among them
+	candidate := &pps.GCCandidate{}
+	var err error
+	candidate.UnreferencedSince, err = types.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	for len(s.added) > 0 || len(s.removed) > 0 {
+		var added, removed []string
+		added, s.added = splitBatch(s.added, gcCandidateBatchSize)
+		removed, s.removed = splitBatch(s.removed, gcCandidateBatchSize-len(added))
+		if _, err := col.NewSTM(ctx, etcdClient, func(stm col.STM) error {
+			candidates := s.candidates.ReadWrite(stm)
+			for _, key := range added {
+				if err := candidates.Put(key, candidate); err != nil {
+					return err
+				}
+			}
+			for _, key := range removed {
+				if err := candidates.Delete(key); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitBatch splits the first 'n' elements off of 'keys'.
+func splitBatch(keys []string, n int) ([]string, []string) {
+	if len(keys) < n {
+		n = len(keys)
+	}
+	return keys[:n], keys[n:]
+}
+
+// gcBarrier keeps garbage collection from deleting objects that are put
+// again, and so deduplicated, while it runs. Before a batch of objects is
+// deleted, a key is written for each of them under client.GCDeletingPrefix,
+// and then the objects that have been touched (see client.GCTouchedPrefix)
+// are left out of the batch. The keys are attached to a lease that's kept
+// alive until the barrier is closed.
+type gcBarrier struct {
+	etcdClient *etcd.Client
+	lease      etcd.LeaseID
+	cancel     func()
+}
+
+func newGCBarrier(ctx context.Context, etcdClient *etcd.Client) (*gcBarrier, error) {
+	resp, err := etcdClient.Grant(ctx, gcDeletingTTL)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	keepAlive, err := etcdClient.KeepAlive(ctx, resp.ID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	go func() {
+		for range keepAlive {
+		}
+	}()
+	return &gcBarrier{
+		etcdClient: etcdClient,
+		lease:      resp.ID,
+		cancel:     cancel,
+	}, nil
+}
+
+// deleting records that 'objects' are about to be deleted, and returns the
+// ones that may be deleted, and the ones that have been touched and must not
+// be.
+func (b *gcBarrier) deleting(ctx context.Context, objects []*pfs.Object) ([]*pfs.Object, []*pfs.Object, error) {
+	var puts, gets []etcd.Op
+	for _, object := range objects {
+		puts = append(puts, etcd.OpPut(path.Join(client.GCDeletingPrefix, object.Hash), "", etcd.WithLease(b.lease)))
+		gets = append(gets, etcd.OpGet(path.Join(client.GCTouchedPrefix, object.Hash), etcd.WithCountOnly()))
+	}
+	if _, err := b.etcdClient.Txn(ctx).Then(puts...).Commit(); err != nil {
+		return nil, nil, err
+	}
+	resp, err := b.etcdClient.Txn(ctx).Then(gets...).Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+	var deletable, touched []*pfs.Object
+	for i, r := range resp.Responses {
+		if r.GetResponseRange().Count > 0 {
+			touched = append(touched, objects[i])
+		} else {
+			deletable = append(deletable, objects[i])
+		}
+	}
+	return deletable, touched, nil
+}
+
+// done removes the keys that deleting() wrote for 'objects'.
+func (b *gcBarrier) done(ctx context.Context, objects []*pfs.Object) error {
+	var deletes []etcd.Op
+	for _, object := range objects {
+		deletes = append(deletes, etcd.OpDelete(path.Join(client.GCDeletingPrefix, object.Hash)))
+	}
+	_, err := b.etcdClient.Txn(ctx).Then(deletes...).Commit()
+	return err
+}
+
+// close revokes the barrier's lease, which removes any keys that are left.
+func (b *gcBarrier) close(ctx context.Context) error {
+	b.cancel()
+	_, err := b.etcdClient.Revoke(ctx, b.lease)
+	return err
+}
+
+// clearTouched removes the touches that were recorded before 'before'. Since
+// garbage collection marked everything that was referenced by then, they're
+// no longer needed. Touches that are renewed meanwhile are kept.
+func clearTouched(ctx context.Context, etcdClient *etcd.Client, before time.Time) error {
+	resp, err := etcdClient.Get(ctx, client.GCTouchedPrefix, etcd.WithPrefix())
+	if err != nil {
+		return err
+	}
+	for _, kv := range resp.Kvs {
+		touched, err := strconv.ParseInt(string(kv.Value), 10, 64)
+		if err == nil && touched >= before.UnixNano() {
+			continue
+		}
+		key := string(kv.Key)
+		if _, err := etcdClient.Txn(ctx).
+			If(etcd.Compare(etcd.ModRevision(key), "=", kv.ModRevision)).
+			Then(etcd.OpDelete(key)).Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -23,7 +23,9 @@ func NewAPIServer(
 	workerImagePullPolicy string,
 	storageRoot string,
 	storageBackend string,
+	storageMirrorURL string,
 	storageHostPath string,
+	workerHotTierBytes string,
 	reporter *metrics.Reporter,
 ) (ppsclient.APIServer, error) {
 	etcdClient, err := etcd.New(etcd.Config{
@@ -46,10 +48,15 @@ func NewAPIServer(
 		workerImagePullPolicy: workerImagePullPolicy,
 		storageRoot:           storageRoot,
 		storageBackend:        storageBackend,
+		storageMirrorURL:      storageMirrorURL,
 		storageHostPath:       storageHostPath,
+		workerHotTierBytes:    workerHotTierBytes,
 		reporter:              reporter,
 		pipelines:             ppsdb.Pipelines(etcdClient, etcdPrefix),
 		jobs:                  ppsdb.Jobs(etcdClient, etcdPrefix),
+		gcObjectCandidates:    ppsdb.GCObjectCandidates(etcdClient, etcdPrefix),
+		gcTagCandidates:       ppsdb.GCTagCandidates(etcdClient, etcdPrefix),
+		gcCheckpoints:         ppsdb.GCCheckpoints(etcdClient, etcdPrefix),
 	}
 	go apiServer.master()
 	return apiServer, nil
@@ -69,6 +69,26 @@ func (a *apiServer) workerPodSpec(options *workerOptions) api.PodSpec {
 			},
 		}
 	}
+	if a.workerHotTierBytes != "" {
+		hotTierVolumeName := "pach-hot-tier"
+		options.volumes = append(options.volumes, api.Volume{
+			Name: hotTierVolumeName,
+			VolumeSource: api.VolumeSource{
+				EmptyDir: &api.EmptyDirVolumeSource{},
+			},
+		})
+		sidecarVolumeMounts = append(sidecarVolumeMounts, api.VolumeMount{
+			Name:      hotTierVolumeName,
+			MountPath: "/" + hotTierVolumeName,
+		})
+		sidecarEnv = append(sidecarEnv, api.EnvVar{
+			Name:  "STORAGE_HOT_TIER_DIR",
+			Value: "/" + hotTierVolumeName,
+		}, api.EnvVar{
+			Name:  "STORAGE_HOT_TIER_BYTES",
+			Value: a.workerHotTierBytes,
+		})
+	}
 	userVolumeMounts := options.volumeMounts
 	secretVolume, secretMount, err := assets.GetSecretVolumeAndMount(a.storageBackend)
 	if err == nil {
@@ -77,6 +97,20 @@ func (a *apiServer) workerPodSpec(options *workerOptions) api.PodSpec {
 		sidecarVolumeMounts = append(sidecarVolumeMounts, secretMount)
 		userVolumeMounts = append(userVolumeMounts, secretMount)
 	}
+	// Mirrored storage needs the secrets of each of the mirrors
+	if a.storageMirrorURL != "" {
+		sidecarEnv = append(sidecarEnv, api.EnvVar{
+			Name:  "STORAGE_MIRROR_URL",
+			Value: a.storageMirrorURL,
+		})
+		secretVolumes, secretMounts, err := assets.GetMirrorSecretVolumesAndMounts(a.storageMirrorURL)
+		if err == nil {
+			options.volumes = append(options.volumes, secretVolumes...)
+			options.volumeMounts = append(options.volumeMounts, secretMounts...)
+			sidecarVolumeMounts = append(sidecarVolumeMounts, secretMounts...)
+			userVolumeMounts = append(userVolumeMounts, secretMounts...)
+		}
+	}
 	podSpec := api.PodSpec{
 		InitContainers: []api.Container{
 			{
@@ -685,6 +685,9 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 				},
 				Branch: "stats",
 				Tree:   statsObject,
+				// Without the auth system, pachd can't tell that the
+				// commit is the pipeline's
+				Author: pfs.PipelineAuthor(jobInfo.Pipeline.Name),
 			})
 			if err != nil {
 				return err
@@ -723,6 +726,9 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 			Branch:     jobInfo.OutputBranch,
 			Provenance: provenance,
 			Tree:       object,
+			// Without the auth system, pachd can't tell that the commit is
+			// the pipeline's
+			Author: pfs.PipelineAuthor(jobInfo.Pipeline.Name),
 		})
 		if err != nil {
 			return err
@@ -929,11 +935,15 @@ func (a *APIServer) putTree(ctx context.Context, tree hashtree.OpenHashTree) (*p
 		return nil, err
 	}
 
-	data, err := hashtree.Serialize(finishedTree)
+	pachClient := a.pachClient.WithCtx(ctx)
+	data, err := hashtree.SerializeSharded(finishedTree, hashtree.DefaultShardSize, func(shard []byte) (*pfs.Object, error) {
+		object, _, err := pachClient.PutObject(bytes.NewReader(shard))
+		return object, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	object, _, err := a.pachClient.WithCtx(ctx).PutObject(bytes.NewReader(data))
+	object, _, err := pachClient.PutObject(bytes.NewReader(data))
 	return object, err
 }
 